@@ -0,0 +1,89 @@
+package access
+
+import (
+	"github.com/katallaxie/fiber-goth/adapters"
+)
+
+// Wildcard allows all actions on a resource.
+const Wildcard = "*"
+
+const (
+	// ResourceOrganization is the organization itself.
+	ResourceOrganization = "organization"
+	// ResourceMember are the members of an organization.
+	ResourceMember = "member"
+	// ResourceInvitation are the invitations of an organization.
+	ResourceInvitation = "invitation"
+	// ResourceTeam are the teams of an organization.
+	ResourceTeam = "team"
+)
+
+const (
+	// ActionCreate creates a resource.
+	ActionCreate = "create"
+	// ActionUpdate updates a resource.
+	ActionUpdate = "update"
+	// ActionDelete deletes a resource.
+	ActionDelete = "delete"
+	// ActionCancel cancels a resource.
+	ActionCancel = "cancel"
+)
+
+// Statements maps resources to the actions that are allowed on them.
+type Statements map[string][]string
+
+// Allows returns true if the action is allowed on the resource.
+func (s Statements) Allows(resource, action string) bool {
+	for _, a := range s[resource] {
+		if a == action || a == Wildcard {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Policy maps the roles of members to their statements.
+type Policy map[adapters.Role]Statements
+
+// DefaultPolicy is the default policy with the owner, admin and member roles.
+var DefaultPolicy = Policy{
+	adapters.RoleOwner: {
+		ResourceOrganization: {ActionUpdate, ActionDelete},
+		ResourceMember:       {ActionCreate, ActionUpdate, ActionDelete},
+		ResourceInvitation:   {ActionCreate, ActionCancel},
+		ResourceTeam:         {ActionCreate, ActionUpdate, ActionDelete},
+	},
+	adapters.RoleAdmin: {
+		ResourceOrganization: {ActionUpdate},
+		ResourceMember:       {ActionCreate, ActionUpdate, ActionDelete},
+		ResourceInvitation:   {ActionCreate, ActionCancel},
+		ResourceTeam:         {ActionCreate, ActionUpdate, ActionDelete},
+	},
+	adapters.RoleMember: {},
+}
+
+// HasRole returns true if the role is defined by the policy.
+func (p Policy) HasRole(role adapters.Role) bool {
+	_, ok := p[role]
+
+	return ok
+}
+
+// Can returns true if the role is allowed to perform the action on the resource.
+func (p Policy) Can(role adapters.Role, resource, action string) bool {
+	return p[role].Allows(resource, action)
+}
+
+// HasPermission returns true if the role is allowed to perform all actions on the resources.
+func (p Policy) HasPermission(role adapters.Role, permissions Statements) bool {
+	for resource, actions := range permissions {
+		for _, action := range actions {
+			if !p.Can(role, resource, action) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,136 @@
+package adapters
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func init() {
+	gob.Register(&GothPersonalAccessToken{})
+}
+
+// PersonalAccessTokenPrefix is the prefix of personal access tokens, which tells them apart from session tokens.
+const PersonalAccessTokenPrefix = "pat_"
+
+// personalAccessTokenPrefixLen is the length of the visible prefix of personal access tokens, e.g. "pat_abcd1234".
+const personalAccessTokenPrefixLen = len(PersonalAccessTokenPrefix) + 8
+
+// GothPersonalAccessToken is a token of a user with fine-grained scopes, e.g. for scripts and tools of developers.
+type GothPersonalAccessToken struct {
+	// ID is the unique identifier of the personal access token.
+	ID uuid.UUID `json:"id" gorm:"primaryKey;unique;type:uuid;column:id;default:gen_random_uuid()"`
+	// Name is the name of the personal access token.
+	Name string `json:"name" validate:"required,max=255"`
+	// Prefix is the visible prefix of the token to recognize it.
+	Prefix string `json:"prefix"`
+	// TokenHash is the hash of the token.
+	TokenHash string `json:"-" gorm:"uniqueIndex"`
+	// UserID is the user ID of the personal access token.
+	UserID uuid.UUID `json:"user_id" gorm:"index"`
+	// User is the user of the personal access token.
+	User GothUser `json:"user" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	// OrganizationID is the organization ID the token is limited to, the user has to be a member of it.
+	OrganizationID *uuid.UUID `json:"organization_id" gorm:"index"`
+	// Scope is the comma-separated scopes of the personal access token.
+	Scope string `json:"scope"`
+	// ExpiresAt is the expiry time of the personal access token, tokens without expiry do not expire.
+	ExpiresAt *time.Time `json:"expires_at"`
+	// LastUsedAt is the time the personal access token has last been used.
+	LastUsedAt *time.Time `json:"last_used_at"`
+	// CreatedAt is the creation time of the personal access token.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is the update time of the personal access token.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewPersonalAccessToken returns a new personal access token of the user with the scopes.
+// The token is only stored as hash, it is returned once to the user.
+func NewPersonalAccessToken(userID uuid.UUID, name string, scopes ...string) (string, GothPersonalAccessToken) {
+	token := PersonalAccessTokenPrefix + strings.ToLower(rand.Text())
+
+	return token, GothPersonalAccessToken{
+		Name:      name,
+		Prefix:    token[:personalAccessTokenPrefixLen],
+		TokenHash: HashPersonalAccessToken(token),
+		UserID:    userID,
+		Scope:     strings.Join(scopes, ","),
+	}
+}
+
+// HashPersonalAccessToken returns the hash of a personal access token to look it up.
+func HashPersonalAccessToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// IsValid returns true if the personal access token has not expired.
+func (t *GothPersonalAccessToken) IsValid() bool {
+	return t.ExpiresAt == nil || t.ExpiresAt.After(time.Now())
+}
+
+// Scopes returns the scopes of the personal access token.
+func (t *GothPersonalAccessToken) Scopes() []string {
+	if t.Scope == "" {
+		return []string{}
+	}
+
+	return strings.Split(t.Scope, ",")
+}
+
+// HasScope returns true if the personal access token has the scope.
+func (t *GothPersonalAccessToken) HasScope(scope string) bool {
+	return slices.Contains(t.Scopes(), scope)
+}
+
+// PersonalAccessTokenAdapter is an interface that defines the methods for the personal access tokens of users.
+// Adapters implement it in addition to the Adapter interface to support personal access tokens.
+type PersonalAccessTokenAdapter interface {
+	// CreatePersonalAccessToken creates a personal access token.
+	CreatePersonalAccessToken(ctx context.Context, token GothPersonalAccessToken) (GothPersonalAccessToken, error)
+	// GetPersonalAccessToken retrieves a personal access token by the hash of the token.
+	GetPersonalAccessToken(ctx context.Context, tokenHash string) (GothPersonalAccessToken, error)
+	// UpdatePersonalAccessToken updates the last use of a personal access token.
+	UpdatePersonalAccessToken(ctx context.Context, token GothPersonalAccessToken) (GothPersonalAccessToken, error)
+	// ListPersonalAccessTokens lists the personal access tokens of a user.
+	ListPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]GothPersonalAccessToken, error)
+	// DeletePersonalAccessToken deletes a personal access token of a user.
+	DeletePersonalAccessToken(ctx context.Context, id, userID uuid.UUID) error
+}
+
+var _ PersonalAccessTokenAdapter = (*UnimplementedPersonalAccessTokenAdapter)(nil)
+
+// UnimplementedPersonalAccessTokenAdapter is a personal access token adapter that does not implement any of the methods.
+type UnimplementedPersonalAccessTokenAdapter struct{}
+
+// CreatePersonalAccessToken creates a personal access token.
+func (a *UnimplementedPersonalAccessTokenAdapter) CreatePersonalAccessToken(_ context.Context, _ GothPersonalAccessToken) (GothPersonalAccessToken, error) {
+	return GothPersonalAccessToken{}, ErrUnimplemented
+}
+
+// GetPersonalAccessToken retrieves a personal access token by the hash of the token.
+func (a *UnimplementedPersonalAccessTokenAdapter) GetPersonalAccessToken(_ context.Context, _ string) (GothPersonalAccessToken, error) {
+	return GothPersonalAccessToken{}, ErrUnimplemented
+}
+
+// UpdatePersonalAccessToken updates a personal access token.
+func (a *UnimplementedPersonalAccessTokenAdapter) UpdatePersonalAccessToken(_ context.Context, _ GothPersonalAccessToken) (GothPersonalAccessToken, error) {
+	return GothPersonalAccessToken{}, ErrUnimplemented
+}
+
+// ListPersonalAccessTokens lists the personal access tokens of a user.
+func (a *UnimplementedPersonalAccessTokenAdapter) ListPersonalAccessTokens(_ context.Context, _ uuid.UUID) ([]GothPersonalAccessToken, error) {
+	return nil, ErrUnimplemented
+}
+
+// DeletePersonalAccessToken deletes a personal access token of a user.
+func (a *UnimplementedPersonalAccessTokenAdapter) DeletePersonalAccessToken(_ context.Context, _, _ uuid.UUID) error {
+	return ErrUnimplemented
+}
@@ -0,0 +1,128 @@
+package adapters
+
+import (
+	"context"
+	"encoding/gob"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func init() {
+	gob.Register(&GothAPIKey{})
+}
+
+// GothAPIKey is a long-lived key for machine access of a user or an organization.
+type GothAPIKey struct {
+	// ID is the unique identifier of the API key.
+	ID uuid.UUID `json:"id" gorm:"primaryKey;unique;type:uuid;column:id;default:gen_random_uuid()"`
+	// Name is the name of the API key.
+	Name string `json:"name" validate:"required,max=255"`
+	// Prefix is the visible prefix of the key to recognize it.
+	Prefix string `json:"prefix"`
+	// KeyHash is the hash of the key.
+	KeyHash string `json:"-" gorm:"uniqueIndex"`
+	// UserID is the user ID of the API key, requests with the key are made as the user.
+	UserID uuid.UUID `json:"user_id" gorm:"index"`
+	// User is the user of the API key.
+	User GothUser `json:"user" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	// OrganizationID is the organization ID of the API key, if the key belongs to an organization.
+	OrganizationID *uuid.UUID `json:"organization_id" gorm:"index"`
+	// Scope is the comma-separated scopes of the API key.
+	Scope string `json:"scope"`
+	// RateLimit is the number of requests allowed within the rate limit window, 0 is unlimited.
+	RateLimit int `json:"rate_limit"`
+	// RateLimitWindow is the window of the rate limit.
+	RateLimitWindow time.Duration `json:"rate_limit_window"`
+	// RequestCount is the number of requests within the current rate limit window.
+	RequestCount int `json:"request_count"`
+	// WindowStartedAt is the start of the current rate limit window.
+	WindowStartedAt *time.Time `json:"window_started_at"`
+	// ExpiresAt is the expiry time of the API key, keys without expiry do not expire.
+	ExpiresAt *time.Time `json:"expires_at"`
+	// LastUsedAt is the time the API key has last been used.
+	LastUsedAt *time.Time `json:"last_used_at"`
+	// CreatedAt is the creation time of the API key.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is the update time of the API key.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IsValid returns true if the API key has not expired.
+func (k *GothAPIKey) IsValid() bool {
+	return k.ExpiresAt == nil || k.ExpiresAt.After(time.Now())
+}
+
+// Scopes returns the scopes of the API key.
+func (k *GothAPIKey) Scopes() []string {
+	if k.Scope == "" {
+		return []string{}
+	}
+
+	return strings.Split(k.Scope, ",")
+}
+
+// HasScope returns true if the API key has the scope.
+func (k *GothAPIKey) HasScope(scope string) bool {
+	return slices.Contains(k.Scopes(), scope)
+}
+
+// RateLimited returns true if the API key has exceeded its rate limit in the current window.
+func (k *GothAPIKey) RateLimited() bool {
+	return k.RateLimit > 0 && k.RequestCount > k.RateLimit
+}
+
+// APIKeyAdapter is an interface that defines the methods for the API keys of users and organizations.
+// Adapters implement it in addition to the Adapter interface to support API keys.
+type APIKeyAdapter interface {
+	// CreateAPIKey creates an API key.
+	CreateAPIKey(ctx context.Context, key GothAPIKey) (GothAPIKey, error)
+	// GetAPIKey retrieves an API key by the hash of the key.
+	GetAPIKey(ctx context.Context, keyHash string) (GothAPIKey, error)
+	// UseAPIKey records a request with an API key.
+	// The requests are counted atomically within the rate limit window of the key, and the updated key is returned.
+	UseAPIKey(ctx context.Context, key GothAPIKey) (GothAPIKey, error)
+	// ListAPIKeys lists the API keys of a user.
+	ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]GothAPIKey, error)
+	// ListOrganizationAPIKeys lists the API keys of an organization.
+	ListOrganizationAPIKeys(ctx context.Context, orgID uuid.UUID) ([]GothAPIKey, error)
+	// DeleteAPIKey deletes an API key.
+	DeleteAPIKey(ctx context.Context, id uuid.UUID) error
+}
+
+var _ APIKeyAdapter = (*UnimplementedAPIKeyAdapter)(nil)
+
+// UnimplementedAPIKeyAdapter is an API key adapter that does not implement any of the methods.
+type UnimplementedAPIKeyAdapter struct{}
+
+// CreateAPIKey creates an API key.
+func (a *UnimplementedAPIKeyAdapter) CreateAPIKey(_ context.Context, _ GothAPIKey) (GothAPIKey, error) {
+	return GothAPIKey{}, ErrUnimplemented
+}
+
+// GetAPIKey retrieves an API key by the hash of the key.
+func (a *UnimplementedAPIKeyAdapter) GetAPIKey(_ context.Context, _ string) (GothAPIKey, error) {
+	return GothAPIKey{}, ErrUnimplemented
+}
+
+// UseAPIKey records a request with an API key.
+func (a *UnimplementedAPIKeyAdapter) UseAPIKey(_ context.Context, _ GothAPIKey) (GothAPIKey, error) {
+	return GothAPIKey{}, ErrUnimplemented
+}
+
+// ListAPIKeys lists the API keys of a user.
+func (a *UnimplementedAPIKeyAdapter) ListAPIKeys(_ context.Context, _ uuid.UUID) ([]GothAPIKey, error) {
+	return nil, ErrUnimplemented
+}
+
+// ListOrganizationAPIKeys lists the API keys of an organization.
+func (a *UnimplementedAPIKeyAdapter) ListOrganizationAPIKeys(_ context.Context, _ uuid.UUID) ([]GothAPIKey, error) {
+	return nil, ErrUnimplemented
+}
+
+// DeleteAPIKey deletes an API key.
+func (a *UnimplementedAPIKeyAdapter) DeleteAPIKey(_ context.Context, _ uuid.UUID) error {
+	return ErrUnimplemented
+}
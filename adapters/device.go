@@ -0,0 +1,89 @@
+package adapters
+
+import (
+	"context"
+	"encoding/gob"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func init() {
+	gob.Register(&GothTrustedDevice{})
+}
+
+// GothTrustedDevice is a device of a user that skips the second factor until it expires.
+type GothTrustedDevice struct {
+	// ID is the unique identifier of the trusted device.
+	ID uuid.UUID `json:"id" gorm:"primaryKey;unique;type:uuid;column:id;default:gen_random_uuid()"`
+	// UserID is the user ID of the trusted device.
+	UserID uuid.UUID `json:"user_id" gorm:"index"`
+	// User is the user of the trusted device.
+	User GothUser `json:"user" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	// TokenHash is the hash of the token in the cookie of the trusted device.
+	TokenHash string `json:"-"`
+	// IPAddress is the IP address the device has been trusted from.
+	IPAddress string `json:"ip_address"`
+	// UserAgent is the user agent of the trusted device.
+	UserAgent string `json:"user_agent"`
+	// ExpiresAt is the expiry time of the trusted device.
+	ExpiresAt time.Time `json:"expires_at"`
+	// LastUsedAt is the time the trusted device last skipped the second factor.
+	LastUsedAt *time.Time `json:"last_used_at"`
+	// CreatedAt is the creation time of the trusted device.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is the update time of the trusted device.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TrustedDeviceAdapter is an interface that defines the methods for the trusted devices of users.
+// Adapters implement it in addition to the Adapter interface to support trusted devices.
+type TrustedDeviceAdapter interface {
+	// CreateTrustedDevice creates a trusted device.
+	CreateTrustedDevice(ctx context.Context, device GothTrustedDevice) (GothTrustedDevice, error)
+	// GetTrustedDevice retrieves a trusted device by ID.
+	GetTrustedDevice(ctx context.Context, id uuid.UUID) (GothTrustedDevice, error)
+	// UpdateTrustedDevice updates the last use of a trusted device.
+	UpdateTrustedDevice(ctx context.Context, device GothTrustedDevice) (GothTrustedDevice, error)
+	// ListTrustedDevices lists the unexpired trusted devices of a user.
+	ListTrustedDevices(ctx context.Context, userID uuid.UUID) ([]GothTrustedDevice, error)
+	// DeleteTrustedDevice deletes a trusted device of a user.
+	DeleteTrustedDevice(ctx context.Context, id, userID uuid.UUID) error
+	// DeleteTrustedDevices deletes all trusted devices of a user.
+	DeleteTrustedDevices(ctx context.Context, userID uuid.UUID) error
+}
+
+var _ TrustedDeviceAdapter = (*UnimplementedTrustedDeviceAdapter)(nil)
+
+// UnimplementedTrustedDeviceAdapter is a trusted device adapter that does not implement any of the methods.
+type UnimplementedTrustedDeviceAdapter struct{}
+
+// CreateTrustedDevice creates a trusted device.
+func (a *UnimplementedTrustedDeviceAdapter) CreateTrustedDevice(_ context.Context, _ GothTrustedDevice) (GothTrustedDevice, error) {
+	return GothTrustedDevice{}, ErrUnimplemented
+}
+
+// GetTrustedDevice retrieves a trusted device by ID.
+func (a *UnimplementedTrustedDeviceAdapter) GetTrustedDevice(_ context.Context, _ uuid.UUID) (GothTrustedDevice, error) {
+	return GothTrustedDevice{}, ErrUnimplemented
+}
+
+// UpdateTrustedDevice updates a trusted device.
+func (a *UnimplementedTrustedDeviceAdapter) UpdateTrustedDevice(_ context.Context, _ GothTrustedDevice) (GothTrustedDevice, error) {
+	return GothTrustedDevice{}, ErrUnimplemented
+}
+
+// ListTrustedDevices lists the trusted devices of a user.
+func (a *UnimplementedTrustedDeviceAdapter) ListTrustedDevices(_ context.Context, _ uuid.UUID) ([]GothTrustedDevice, error) {
+	return nil, ErrUnimplemented
+}
+
+// DeleteTrustedDevice deletes a trusted device.
+func (a *UnimplementedTrustedDeviceAdapter) DeleteTrustedDevice(_ context.Context, _, _ uuid.UUID) error {
+	return ErrUnimplemented
+}
+
+// DeleteTrustedDevices deletes all trusted devices of a user.
+func (a *UnimplementedTrustedDeviceAdapter) DeleteTrustedDevices(_ context.Context, _ uuid.UUID) error {
+	return ErrUnimplemented
+}
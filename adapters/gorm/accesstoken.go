@@ -0,0 +1,68 @@
+package adapters
+
+import (
+	"context"
+
+	goth "github.com/katallaxie/fiber-goth"
+	"github.com/katallaxie/fiber-goth/adapters"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+)
+
+// CreatePersonalAccessToken is a helper function to create a personal access token.
+func (a *gormAdapter) CreatePersonalAccessToken(ctx context.Context, token adapters.GothPersonalAccessToken) (adapters.GothPersonalAccessToken, error) {
+	err := a.db.WithContext(ctx).Omit(clause.Associations).Create(&token).Error
+	if err != nil {
+		return adapters.GothPersonalAccessToken{}, goth.ErrBadRequest
+	}
+
+	return token, nil
+}
+
+// GetPersonalAccessToken is a helper function to retrieve a personal access token by the hash of the token.
+func (a *gormAdapter) GetPersonalAccessToken(ctx context.Context, tokenHash string) (adapters.GothPersonalAccessToken, error) {
+	var token adapters.GothPersonalAccessToken
+	err := a.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&token).Error
+	if err != nil {
+		return adapters.GothPersonalAccessToken{}, goth.ErrMissingPersonalAccessToken
+	}
+
+	return token, nil
+}
+
+// UpdatePersonalAccessToken is a helper function to update the last use of a personal access token.
+func (a *gormAdapter) UpdatePersonalAccessToken(ctx context.Context, token adapters.GothPersonalAccessToken) (adapters.GothPersonalAccessToken, error) {
+	err := a.db.WithContext(ctx).Model(&adapters.GothPersonalAccessToken{}).Omit(clause.Associations).Where("id = ?", token.ID).
+		Select("last_used_at").Updates(&token).Error
+	if err != nil {
+		return adapters.GothPersonalAccessToken{}, goth.ErrBadRequest
+	}
+
+	return token, nil
+}
+
+// ListPersonalAccessTokens is a helper function to list the personal access tokens of a user.
+func (a *gormAdapter) ListPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]adapters.GothPersonalAccessToken, error) {
+	var tokens []adapters.GothPersonalAccessToken
+	err := a.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at").Find(&tokens).Error
+	if err != nil {
+		return nil, goth.ErrBadRequest
+	}
+
+	return tokens, nil
+}
+
+// DeletePersonalAccessToken is a helper function to delete a personal access token of a user.
+func (a *gormAdapter) DeletePersonalAccessToken(ctx context.Context, id, userID uuid.UUID) error {
+	res := a.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).Delete(&adapters.GothPersonalAccessToken{})
+	if res.Error != nil {
+		return goth.ErrBadRequest
+	}
+
+	if res.RowsAffected == 0 {
+		return goth.ErrMissingPersonalAccessToken
+	}
+
+	return nil
+}
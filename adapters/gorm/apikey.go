@@ -0,0 +1,102 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	goth "github.com/katallaxie/fiber-goth"
+	"github.com/katallaxie/fiber-goth/adapters"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateAPIKey is a helper function to create an API key.
+func (a *gormAdapter) CreateAPIKey(ctx context.Context, key adapters.GothAPIKey) (adapters.GothAPIKey, error) {
+	err := a.db.WithContext(ctx).Omit(clause.Associations).Create(&key).Error
+	if err != nil {
+		return adapters.GothAPIKey{}, goth.ErrBadRequest
+	}
+
+	return key, nil
+}
+
+// GetAPIKey is a helper function to retrieve an API key by the hash of the key.
+func (a *gormAdapter) GetAPIKey(ctx context.Context, keyHash string) (adapters.GothAPIKey, error) {
+	var key adapters.GothAPIKey
+	err := a.db.WithContext(ctx).Where("key_hash = ?", keyHash).First(&key).Error
+	if err != nil {
+		return adapters.GothAPIKey{}, goth.ErrMissingAPIKey
+	}
+
+	return key, nil
+}
+
+// UseAPIKey is a helper function to record a request with an API key.
+// The request count is reset when the rate limit window of the key has passed, and the updated key is returned.
+func (a *gormAdapter) UseAPIKey(ctx context.Context, key adapters.GothAPIKey) (adapters.GothAPIKey, error) {
+	now := time.Now()
+	windowStart := now.Add(-key.RateLimitWindow)
+
+	var updated adapters.GothAPIKey
+
+	// The key is read again in the transaction, as not all databases support RETURNING.
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&adapters.GothAPIKey{}).Where("id = ?", key.ID).Updates(map[string]any{
+			"last_used_at":      now,
+			"request_count":     gorm.Expr("CASE WHEN window_started_at IS NULL OR window_started_at <= ? THEN 1 ELSE request_count + 1 END", windowStart),
+			"window_started_at": gorm.Expr("CASE WHEN window_started_at IS NULL OR window_started_at <= ? THEN ? ELSE window_started_at END", windowStart, now),
+		})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return goth.ErrMissingAPIKey
+		}
+
+		return tx.Where("id = ?", key.ID).First(&updated).Error
+	})
+	if err != nil {
+		return adapters.GothAPIKey{}, goth.ErrMissingAPIKey
+	}
+
+	return updated, nil
+}
+
+// ListAPIKeys is a helper function to list the API keys of a user.
+func (a *gormAdapter) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]adapters.GothAPIKey, error) {
+	var keys []adapters.GothAPIKey
+	err := a.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at").Find(&keys).Error
+	if err != nil {
+		return nil, goth.ErrBadRequest
+	}
+
+	return keys, nil
+}
+
+// ListOrganizationAPIKeys is a helper function to list the API keys of an organization.
+func (a *gormAdapter) ListOrganizationAPIKeys(ctx context.Context, orgID uuid.UUID) ([]adapters.GothAPIKey, error) {
+	var keys []adapters.GothAPIKey
+	err := a.db.WithContext(ctx).Where("organization_id = ?", orgID).Order("created_at").Find(&keys).Error
+	if err != nil {
+		return nil, goth.ErrBadRequest
+	}
+
+	return keys, nil
+}
+
+// DeleteAPIKey is a helper function to delete an API key.
+func (a *gormAdapter) DeleteAPIKey(ctx context.Context, id uuid.UUID) error {
+	res := a.db.WithContext(ctx).Where("id = ?", id).Delete(&adapters.GothAPIKey{})
+	if res.Error != nil {
+		return goth.ErrBadRequest
+	}
+
+	if res.RowsAffected == 0 {
+		return goth.ErrMissingAPIKey
+	}
+
+	return nil
+}
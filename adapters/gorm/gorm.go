@@ -2,6 +2,8 @@ package adapters
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
 	goth "github.com/katallaxie/fiber-goth"
@@ -19,10 +21,29 @@ func RunMigrations(db *gorm.DB) error {
 		&adapters.GothUser{},
 		&adapters.GothSession{},
 		&adapters.GothVerificationToken{},
+		&adapters.GothOrganization{},
+		&adapters.GothMember{},
+		&adapters.GothTeam{},
+		&adapters.GothTeamMember{},
+		&adapters.GothInvitation{},
+		&adapters.GothWebAuthnCredential{},
+		&adapters.GothTwoFactor{},
+		&adapters.GothBackupCode{},
+		&adapters.GothTrustedDevice{},
+		&adapters.GothPersonalAccessToken{},
+		&adapters.GothAPIKey{},
 	)
 }
 
-var _ adapters.Adapter = (*gormAdapter)(nil)
+var (
+	_ adapters.Adapter                    = (*gormAdapter)(nil)
+	_ adapters.OrganizationAdapter        = (*gormAdapter)(nil)
+	_ adapters.WebAuthnAdapter            = (*gormAdapter)(nil)
+	_ adapters.TwoFactorAdapter           = (*gormAdapter)(nil)
+	_ adapters.TrustedDeviceAdapter       = (*gormAdapter)(nil)
+	_ adapters.PersonalAccessTokenAdapter = (*gormAdapter)(nil)
+	_ adapters.APIKeyAdapter              = (*gormAdapter)(nil)
+)
 
 type gormAdapter struct {
 	db *gorm.DB
@@ -66,10 +87,34 @@ func (a *gormAdapter) GetUser(ctx context.Context, id uuid.UUID) (adapters.GothU
 	return user, nil
 }
 
+// GetUserByEmail is a helper function to retrieve a user by email.
+func (a *gormAdapter) GetUserByEmail(ctx context.Context, email string) (adapters.GothUser, error) {
+	var user adapters.GothUser
+	err := a.db.WithContext(ctx).Where("email = ?", email).First(&user).Error
+	if err != nil {
+		return adapters.GothUser{}, goth.ErrMissingUser
+	}
+
+	return user, nil
+}
+
+// GetUserByAccount is a helper function to retrieve the user of the account of a provider.
+func (a *gormAdapter) GetUserByAccount(ctx context.Context, provider, providerAccountID string) (adapters.GothUser, error) {
+	accounts := a.db.Model(&adapters.GothAccount{}).Select("user_id").Where("provider = ? AND provider_account_id = ?", provider, providerAccountID)
+
+	var user adapters.GothUser
+	err := a.db.WithContext(ctx).Where("id IN (?)", accounts).First(&user).Error
+	if err != nil {
+		return adapters.GothUser{}, goth.ErrMissingUser
+	}
+
+	return user, nil
+}
+
 const defaultExpiry = 24 * time.Hour
 
 // CreateSession is a helper function to create a new session.
-func (a *gormAdapter) CreateSession(ctx context.Context, userID uuid.UUID, expires time.Time) (adapters.GothSession, error) {
+func (a *gormAdapter) CreateSession(ctx context.Context, userID uuid.UUID, expires time.Time, opts ...adapters.SessionOpt) (adapters.GothSession, error) {
 	session := adapters.GothSession{
 		UserID:       userID,
 		SessionToken: uuid.NewString(),
@@ -80,6 +125,10 @@ func (a *gormAdapter) CreateSession(ctx context.Context, userID uuid.UUID, expir
 		},
 	}
 
+	for _, opt := range opts {
+		opt(&session)
+	}
+
 	err := a.db.Session(&gorm.Session{FullSaveAssociations: true}).WithContext(ctx).Create(&session).Error
 	if err != nil {
 		return adapters.GothSession{}, goth.ErrBadSession
@@ -98,6 +147,32 @@ func (a *gormAdapter) DeleteSession(ctx context.Context, sessionToken string) er
 	return nil
 }
 
+// ListSessions is a helper function to list all active sessions of a user.
+func (a *gormAdapter) ListSessions(ctx context.Context, userID uuid.UUID) ([]adapters.GothSession, error) {
+	var sessions []adapters.GothSession
+	err := a.db.WithContext(ctx).Where("user_id = ? AND expires_at > ?", userID, time.Now()).Order("created_at desc").Find(&sessions).Error
+	if err != nil {
+		return nil, goth.ErrBadRequest
+	}
+
+	return sessions, nil
+}
+
+// DeleteSessionsByUser is a helper function to delete all sessions of a user except the given session tokens.
+func (a *gormAdapter) DeleteSessionsByUser(ctx context.Context, userID uuid.UUID, except ...string) error {
+	tx := a.db.WithContext(ctx).Where("user_id = ?", userID)
+	if len(except) > 0 {
+		tx = tx.Where("session_token NOT IN ?", except)
+	}
+
+	err := tx.Delete(&adapters.GothSession{}).Error
+	if err != nil {
+		return goth.ErrBadRequest
+	}
+
+	return nil
+}
+
 // RefreshSession is a helper function to refresh a session.
 func (a *gormAdapter) RefreshSession(ctx context.Context, session adapters.GothSession) (adapters.GothSession, error) {
 	err := a.db.WithContext(ctx).Model(&adapters.GothSession{}).Where("session_token = ?", session.SessionToken).Updates(&session).Error
@@ -109,8 +184,73 @@ func (a *gormAdapter) RefreshSession(ctx context.Context, session adapters.GothS
 }
 
 // DeleteUser is a helper function to delete a user by ID.
+// The accounts, memberships, sessions, CSRF tokens, verification tokens, passkeys, second factor, trusted devices, API keys and personal access tokens of the user are deleted as well.
 func (a *gormAdapter) DeleteUser(ctx context.Context, id uuid.UUID) error {
-	err := a.db.WithContext(ctx).Where("id = ?", id).Delete(&adapters.GothUser{}).Error
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user adapters.GothUser
+		if err := tx.Where("id = ?", id).First(&user).Error; err != nil {
+			return err
+		}
+
+		var csrfTokenIDs []uuid.UUID
+		if err := tx.Unscoped().Model(&adapters.GothSession{}).Where("user_id = ?", id).Pluck("csrf_token_id", &csrfTokenIDs).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("user_id = ?", id).Delete(&adapters.GothSession{}).Error; err != nil {
+			return err
+		}
+
+		if len(csrfTokenIDs) > 0 {
+			if err := tx.Unscoped().Where("id IN ?", csrfTokenIDs).Delete(&adapters.GothCsrfToken{}).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Unscoped().Where("user_id = ?", id).Delete(&adapters.GothAccount{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("user_id = ?", id).Delete(&adapters.GothMember{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("user_id = ?", id).Delete(&adapters.GothTeamMember{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("user_id = ?", id).Delete(&adapters.GothWebAuthnCredential{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("user_id = ?", id).Delete(&adapters.GothTwoFactor{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("user_id = ?", id).Delete(&adapters.GothBackupCode{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("user_id = ?", id).Delete(&adapters.GothTrustedDevice{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("user_id = ?", id).Delete(&adapters.GothPersonalAccessToken{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("user_id = ?", id).Delete(&adapters.GothAPIKey{}).Error; err != nil {
+			return err
+		}
+
+		// The identifiers of the providers are prefixed, e.g. "email-otp:<email>" or "delete-user:<id>".
+		tokens := tx.Unscoped().Where(`identifier = ? OR identifier LIKE ? ESCAPE '!' OR identifier LIKE ? ESCAPE '!'`, user.Email, "%:"+escapeLike(user.Email), "%:"+escapeLike(id.String()))
+		if err := tokens.Delete(&adapters.GothVerificationToken{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Delete(&user).Error
+	})
 	if err != nil {
 		return goth.ErrBadRequest
 	}
@@ -118,12 +258,214 @@ func (a *gormAdapter) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// CreateVerificationToken is a helper function to create a new verification token.
+func (a *gormAdapter) CreateVerificationToken(ctx context.Context, verficationToken adapters.GothVerificationToken) (adapters.GothVerificationToken, error) {
+	err := a.db.WithContext(ctx).Create(&verficationToken).Error
+	if err != nil {
+		return adapters.GothVerificationToken{}, goth.ErrBadRequest
+	}
+
+	return verficationToken, nil
+}
+
+// UseVerficationToken is a helper function to use a verification token.
+// The token is deleted on use and expired tokens are rejected.
+func (a *gormAdapter) UseVerficationToken(ctx context.Context, identifier, token string) (adapters.GothVerificationToken, error) {
+	var verficationToken adapters.GothVerificationToken
+
+	// The token is read before it is deleted, as not all databases support RETURNING.
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("identifier = ? AND token = ?", identifier, token).First(&verficationToken).Error; err != nil {
+			return err
+		}
+
+		res := tx.Unscoped().Where("identifier = ? AND token = ?", identifier, token).Delete(&adapters.GothVerificationToken{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return goth.ErrBadToken
+		}
+
+		return nil
+	})
+	if err != nil {
+		return adapters.GothVerificationToken{}, goth.ErrBadToken
+	}
+
+	if verficationToken.HasExpired() {
+		return adapters.GothVerificationToken{}, goth.ErrBadToken
+	}
+
+	return verficationToken, nil
+}
+
+// GetVerificationToken is a helper function to retrieve the latest verification token of an identifier.
+func (a *gormAdapter) GetVerificationToken(ctx context.Context, identifier string) (adapters.GothVerificationToken, error) {
+	var verficationToken adapters.GothVerificationToken
+	err := a.db.WithContext(ctx).Where("identifier = ?", identifier).Order("created_at desc").First(&verficationToken).Error
+	if err != nil {
+		return adapters.GothVerificationToken{}, goth.ErrBadToken
+	}
+
+	return verficationToken, nil
+}
+
+// IncrementVerificationAttempts is a helper function to count an attempt to use a verification token.
+// The attempts are counted atomically and the updated token is returned.
+func (a *gormAdapter) IncrementVerificationAttempts(ctx context.Context, identifier, token string) (adapters.GothVerificationToken, error) {
+	var verficationToken adapters.GothVerificationToken
+
+	// The token is read again in the transaction, as not all databases support RETURNING.
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&adapters.GothVerificationToken{}).Where("identifier = ? AND token = ?", identifier, token).Update("attempts", gorm.Expr("attempts + ?", 1))
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return goth.ErrBadToken
+		}
+
+		return tx.Where("identifier = ? AND token = ?", identifier, token).First(&verficationToken).Error
+	})
+	if err != nil {
+		return adapters.GothVerificationToken{}, goth.ErrBadToken
+	}
+
+	return verficationToken, nil
+}
+
+// DeleteVerificationTokens is a helper function to delete all verification tokens of an identifier.
+func (a *gormAdapter) DeleteVerificationTokens(ctx context.Context, identifier string) error {
+	err := a.db.WithContext(ctx).Unscoped().Where("identifier = ?", identifier).Delete(&adapters.GothVerificationToken{}).Error
+	if err != nil {
+		return goth.ErrBadRequest
+	}
+
+	return nil
+}
+
+// GetAccount is a helper function to retrieve the account of a user for a provider.
+func (a *gormAdapter) GetAccount(ctx context.Context, userID uuid.UUID, provider string) (adapters.GothAccount, error) {
+	var account adapters.GothAccount
+	err := a.db.WithContext(ctx).Where("user_id = ? AND provider = ?", userID, provider).First(&account).Error
+	if err != nil {
+		return adapters.GothAccount{}, goth.ErrMissingAccount
+	}
+
+	return account, nil
+}
+
+// UpdateAccount is a helper function to update an account.
+func (a *gormAdapter) UpdateAccount(ctx context.Context, account adapters.GothAccount) (adapters.GothAccount, error) {
+	err := a.db.WithContext(ctx).Model(&adapters.GothAccount{}).Omit(clause.Associations).Where("id = ?", account.ID).Updates(&account).Error
+	if err != nil {
+		return adapters.GothAccount{}, goth.ErrBadRequest
+	}
+
+	return account, nil
+}
+
+// ListAccounts is a helper function to list all accounts of a user.
+func (a *gormAdapter) ListAccounts(ctx context.Context, userID uuid.UUID) ([]adapters.GothAccount, error) {
+	var accounts []adapters.GothAccount
+	err := a.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at asc").Find(&accounts).Error
+	if err != nil {
+		return nil, goth.ErrBadRequest
+	}
+
+	return accounts, nil
+}
+
 // LinkAccount is a helper function to link an account to a user.
-func (a *gormAdapter) LinkAccount(ctx context.Context, accountID, userID uuid.UUID) error {
-	err := a.db.WithContext(ctx).Model(&adapters.GothAccount{}).Where("id = ?", accountID).Update("user_id", userID).Error
+// An account of the provider that is already linked to the user is updated.
+func (a *gormAdapter) LinkAccount(ctx context.Context, userID uuid.UUID, account adapters.GothAccount) (adapters.GothAccount, error) {
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Where("provider = ?", account.Provider)
+		if account.ProviderAccountID != nil {
+			query = query.Where("provider_account_id = ?", account.ProviderAccountID)
+		} else {
+			query = query.Where("user_id = ?", userID)
+		}
+
+		var existing adapters.GothAccount
+		err := query.First(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			account.ID = uuid.Nil
+			account.UserID = &userID
+
+			return tx.Omit(clause.Associations).Create(&account).Error
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if existing.UserID == nil || *existing.UserID != userID {
+			return adapters.ErrAccountAlreadyLinked
+		}
+
+		account.ID = existing.ID
+		account.UserID = &userID
+
+		return tx.Model(&existing).Omit(clause.Associations).Updates(&account).Error
+	})
+	if errors.Is(err, adapters.ErrAccountAlreadyLinked) {
+		return adapters.GothAccount{}, err
+	}
+
+	if err != nil {
+		return adapters.GothAccount{}, goth.ErrBadRequest
+	}
+
+	return account, nil
+}
+
+// UnlinkAccount is a helper function to unlink an account from a user.
+// The last account of a user cannot be unlinked.
+// The WebAuthn credentials of the user are deleted with the WebAuthn account.
+func (a *gormAdapter) UnlinkAccount(ctx context.Context, accountID, userID uuid.UUID) error {
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		err := tx.Model(&adapters.GothAccount{}).Where("user_id = ?", userID).Count(&count).Error
+		if err != nil {
+			return err
+		}
+
+		if count <= 1 {
+			return adapters.ErrLastAccount
+		}
+
+		var account adapters.GothAccount
+		if err := tx.Where("id = ? AND user_id = ?", accountID, userID).First(&account).Error; err != nil {
+			return goth.ErrMissingAccount
+		}
+
+		if err := tx.Delete(&account).Error; err != nil {
+			return err
+		}
+
+		if account.Type == adapters.AccountTypeWebAuthn {
+			return tx.Unscoped().Where("user_id = ?", userID).Delete(&adapters.GothWebAuthnCredential{}).Error
+		}
+
+		return nil
+	})
+	if errors.Is(err, adapters.ErrLastAccount) || errors.Is(err, goth.ErrMissingAccount) {
+		return err
+	}
+
 	if err != nil {
 		return goth.ErrBadRequest
 	}
 
 	return nil
 }
+
+// escapeLike escapes the wildcards of a LIKE pattern with "!", e.g. "_" which is valid in email addresses.
+// The backslash is not used as escape character, as MySQL also treats it as escape in string literals.
+func escapeLike(s string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(s)
+}
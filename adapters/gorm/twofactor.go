@@ -0,0 +1,164 @@
+package adapters
+
+import (
+	"context"
+
+	goth "github.com/katallaxie/fiber-goth"
+	"github.com/katallaxie/fiber-goth/adapters"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateTwoFactor is a helper function to create the second factor of a user.
+// The previous second factor and backup codes of the user are deleted.
+func (a *gormAdapter) CreateTwoFactor(ctx context.Context, twoFactor adapters.GothTwoFactor) (adapters.GothTwoFactor, error) {
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("user_id = ?", twoFactor.UserID).Delete(&adapters.GothTwoFactor{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("user_id = ?", twoFactor.UserID).Delete(&adapters.GothBackupCode{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Omit(clause.Associations).Create(&twoFactor).Error
+	})
+	if err != nil {
+		return adapters.GothTwoFactor{}, goth.ErrBadRequest
+	}
+
+	return twoFactor, nil
+}
+
+// GetTwoFactor is a helper function to retrieve the second factor of a user.
+func (a *gormAdapter) GetTwoFactor(ctx context.Context, userID uuid.UUID) (adapters.GothTwoFactor, error) {
+	var twoFactor adapters.GothTwoFactor
+	err := a.db.WithContext(ctx).Where("user_id = ?", userID).First(&twoFactor).Error
+	if err != nil {
+		return adapters.GothTwoFactor{}, goth.ErrMissingTwoFactor
+	}
+
+	return twoFactor, nil
+}
+
+// UpdateTwoFactor is a helper function to update the enabled state and the attempts of a second factor.
+func (a *gormAdapter) UpdateTwoFactor(ctx context.Context, twoFactor adapters.GothTwoFactor) (adapters.GothTwoFactor, error) {
+	err := a.db.WithContext(ctx).Model(&adapters.GothTwoFactor{}).Omit(clause.Associations).Where("id = ?", twoFactor.ID).
+		Select("enabled", "attempts").Updates(&twoFactor).Error
+	if err != nil {
+		return adapters.GothTwoFactor{}, goth.ErrBadRequest
+	}
+
+	return twoFactor, nil
+}
+
+// DeleteTwoFactor is a helper function to delete the second factor and the backup codes of a user.
+func (a *gormAdapter) DeleteTwoFactor(ctx context.Context, userID uuid.UUID) error {
+	var deleted int64
+
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Unscoped().Where("user_id = ?", userID).Delete(&adapters.GothTwoFactor{})
+		if res.Error != nil {
+			return res.Error
+		}
+		deleted = res.RowsAffected
+
+		return tx.Where("user_id = ?", userID).Delete(&adapters.GothBackupCode{}).Error
+	})
+	if err != nil {
+		return goth.ErrBadRequest
+	}
+
+	if deleted == 0 {
+		return goth.ErrMissingTwoFactor
+	}
+
+	return nil
+}
+
+// IncrementTwoFactorAttempts is a helper function to count an attempt to enter a code.
+// The attempts are counted atomically and the updated second factor is returned.
+func (a *gormAdapter) IncrementTwoFactorAttempts(ctx context.Context, userID uuid.UUID) (adapters.GothTwoFactor, error) {
+	var twoFactor adapters.GothTwoFactor
+
+	// The second factor is read again in the transaction, as not all databases support RETURNING.
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&adapters.GothTwoFactor{}).Where("user_id = ?", userID).Update("attempts", gorm.Expr("attempts + ?", 1))
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return goth.ErrMissingTwoFactor
+		}
+
+		return tx.Where("user_id = ?", userID).First(&twoFactor).Error
+	})
+	if err != nil {
+		return adapters.GothTwoFactor{}, goth.ErrMissingTwoFactor
+	}
+
+	return twoFactor, nil
+}
+
+// UseTwoFactorStep is a helper function to record the time step of a code that has been entered.
+// The step is only recorded if it is later than the last used step, so that each code is used once.
+func (a *gormAdapter) UseTwoFactorStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	res := a.db.WithContext(ctx).Model(&adapters.GothTwoFactor{}).Where("user_id = ? AND last_used_step < ?", userID, step).
+		Updates(map[string]any{"last_used_step": step, "attempts": 0})
+	if res.Error != nil || res.RowsAffected == 0 {
+		return goth.ErrBadTwoFactorCode
+	}
+
+	return nil
+}
+
+// CreateBackupCodes is a helper function to create the backup codes of a user.
+// The previous backup codes of the user are deleted.
+func (a *gormAdapter) CreateBackupCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error {
+	codes := make([]adapters.GothBackupCode, 0, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes = append(codes, adapters.GothBackupCode{UserID: userID, CodeHash: hash})
+	}
+
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&adapters.GothBackupCode{}).Error; err != nil {
+			return err
+		}
+
+		if len(codes) == 0 {
+			return nil
+		}
+
+		return tx.Omit(clause.Associations).Create(&codes).Error
+	})
+	if err != nil {
+		return goth.ErrBadRequest
+	}
+
+	return nil
+}
+
+// UseBackupCode is a helper function to use a backup code of a user.
+// The backup code is deleted on use.
+func (a *gormAdapter) UseBackupCode(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	res := a.db.WithContext(ctx).Where("user_id = ? AND code_hash = ?", userID, codeHash).Delete(&adapters.GothBackupCode{})
+	if res.Error != nil || res.RowsAffected == 0 {
+		return goth.ErrBadTwoFactorCode
+	}
+
+	return nil
+}
+
+// CountBackupCodes is a helper function to count the unused backup codes of a user.
+func (a *gormAdapter) CountBackupCodes(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := a.db.WithContext(ctx).Model(&adapters.GothBackupCode{}).Where("user_id = ?", userID).Count(&count).Error
+	if err != nil {
+		return 0, goth.ErrBadRequest
+	}
+
+	return count, nil
+}
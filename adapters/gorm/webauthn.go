@@ -0,0 +1,68 @@
+package adapters
+
+import (
+	"context"
+
+	goth "github.com/katallaxie/fiber-goth"
+	"github.com/katallaxie/fiber-goth/adapters"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+)
+
+// CreateWebAuthnCredential is a helper function to create a new WebAuthn credential.
+func (a *gormAdapter) CreateWebAuthnCredential(ctx context.Context, credential adapters.GothWebAuthnCredential) (adapters.GothWebAuthnCredential, error) {
+	err := a.db.WithContext(ctx).Omit(clause.Associations).Create(&credential).Error
+	if err != nil {
+		return adapters.GothWebAuthnCredential{}, goth.ErrBadRequest
+	}
+
+	return credential, nil
+}
+
+// GetWebAuthnCredential is a helper function to retrieve a WebAuthn credential by the ID of the credential in the authenticator.
+func (a *gormAdapter) GetWebAuthnCredential(ctx context.Context, credentialID string) (adapters.GothWebAuthnCredential, error) {
+	var credential adapters.GothWebAuthnCredential
+	err := a.db.WithContext(ctx).Where("credential_id = ?", credentialID).First(&credential).Error
+	if err != nil {
+		return adapters.GothWebAuthnCredential{}, goth.ErrMissingCredential
+	}
+
+	return credential, nil
+}
+
+// UpdateWebAuthnCredential is a helper function to update a WebAuthn credential.
+func (a *gormAdapter) UpdateWebAuthnCredential(ctx context.Context, credential adapters.GothWebAuthnCredential) (adapters.GothWebAuthnCredential, error) {
+	err := a.db.WithContext(ctx).Model(&adapters.GothWebAuthnCredential{}).Omit(clause.Associations).Where("id = ?", credential.ID).
+		Select("name", "sign_count", "clone_warning", "backup_state", "last_used_at").Updates(&credential).Error
+	if err != nil {
+		return adapters.GothWebAuthnCredential{}, goth.ErrBadRequest
+	}
+
+	return credential, nil
+}
+
+// ListWebAuthnCredentials is a helper function to list all WebAuthn credentials of a user.
+func (a *gormAdapter) ListWebAuthnCredentials(ctx context.Context, userID uuid.UUID) ([]adapters.GothWebAuthnCredential, error) {
+	var credentials []adapters.GothWebAuthnCredential
+	err := a.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at asc").Find(&credentials).Error
+	if err != nil {
+		return nil, goth.ErrBadRequest
+	}
+
+	return credentials, nil
+}
+
+// DeleteWebAuthnCredential is a helper function to delete a WebAuthn credential of a user.
+func (a *gormAdapter) DeleteWebAuthnCredential(ctx context.Context, id, userID uuid.UUID) error {
+	res := a.db.WithContext(ctx).Unscoped().Where("id = ? AND user_id = ?", id, userID).Delete(&adapters.GothWebAuthnCredential{})
+	if res.Error != nil {
+		return goth.ErrBadRequest
+	}
+
+	if res.RowsAffected == 0 {
+		return goth.ErrMissingCredential
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package adapters
+
+import (
+	"context"
+	"encoding/gob"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func init() {
+	gob.Register(&GothWebAuthnCredential{})
+}
+
+// GothWebAuthnCredential is a WebAuthn credential (passkey) of a user.
+type GothWebAuthnCredential struct {
+	// ID is the unique identifier of the credential.
+	ID uuid.UUID `json:"id" gorm:"primaryKey;unique;type:uuid;column:id;default:gen_random_uuid()"`
+	// CredentialID is the base64url encoded ID of the credential in the authenticator.
+	CredentialID string `json:"credential_id" gorm:"uniqueIndex"`
+	// UserID is the user ID of the credential.
+	UserID uuid.UUID `json:"user_id"`
+	// User is the user of the credential.
+	User GothUser `json:"user" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	// Name is the name of the credential given by the user.
+	Name string `json:"name" validate:"max=255"`
+	// PublicKey is the public key of the credential.
+	PublicKey []byte `json:"public_key"`
+	// AttestationType is the attestation format of the authenticator.
+	AttestationType string `json:"attestation_type"`
+	// Transports is the comma-separated list of transports the authenticator supports.
+	Transports string `json:"transports"`
+	// AAGUID is the model identifier of the authenticator.
+	AAGUID uuid.UUID `json:"aaguid" gorm:"type:uuid"`
+	// SignCount is the signature counter of the authenticator.
+	SignCount uint32 `json:"sign_count"`
+	// CloneWarning is true if the signature counter indicates a cloned authenticator.
+	CloneWarning bool `json:"clone_warning"`
+	// BackupEligible is true if the credential can be backed up, e.g. synced passkeys.
+	BackupEligible bool `json:"backup_eligible"`
+	// BackupState is true if the credential is backed up.
+	BackupState bool `json:"backup_state"`
+	// Attachment is the attachment of the authenticator, e.g. platform or cross-platform.
+	Attachment string `json:"attachment"`
+	// LastUsedAt is the time the credential was last used to sign in.
+	LastUsedAt *time.Time `json:"last_used_at"`
+	// CreatedAt is the creation time of the credential.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is the update time of the credential.
+	UpdatedAt time.Time `json:"updated_at"`
+	// DeletedAt is the deletion time of the credential.
+	DeletedAt gorm.DeletedAt `json:"deleted_at"`
+}
+
+// WebAuthnAdapter is an interface that defines the methods for WebAuthn credentials.
+// Adapters implement it in addition to the Adapter interface to support passkeys.
+type WebAuthnAdapter interface {
+	// CreateWebAuthnCredential creates a new credential.
+	CreateWebAuthnCredential(ctx context.Context, credential GothWebAuthnCredential) (GothWebAuthnCredential, error)
+	// GetWebAuthnCredential retrieves a credential by the ID of the credential in the authenticator.
+	GetWebAuthnCredential(ctx context.Context, credentialID string) (GothWebAuthnCredential, error)
+	// UpdateWebAuthnCredential updates a credential.
+	UpdateWebAuthnCredential(ctx context.Context, credential GothWebAuthnCredential) (GothWebAuthnCredential, error)
+	// ListWebAuthnCredentials retrieves all credentials of a user.
+	ListWebAuthnCredentials(ctx context.Context, userID uuid.UUID) ([]GothWebAuthnCredential, error)
+	// DeleteWebAuthnCredential deletes a credential of a user.
+	DeleteWebAuthnCredential(ctx context.Context, id, userID uuid.UUID) error
+}
+
+var _ WebAuthnAdapter = (*UnimplementedWebAuthnAdapter)(nil)
+
+// UnimplementedWebAuthnAdapter is a WebAuthn adapter that does not implement any of the methods.
+type UnimplementedWebAuthnAdapter struct{}
+
+// CreateWebAuthnCredential creates a new credential.
+func (a *UnimplementedWebAuthnAdapter) CreateWebAuthnCredential(_ context.Context, _ GothWebAuthnCredential) (GothWebAuthnCredential, error) {
+	return GothWebAuthnCredential{}, ErrUnimplemented
+}
+
+// GetWebAuthnCredential retrieves a credential by the ID of the credential in the authenticator.
+func (a *UnimplementedWebAuthnAdapter) GetWebAuthnCredential(_ context.Context, _ string) (GothWebAuthnCredential, error) {
+	return GothWebAuthnCredential{}, ErrUnimplemented
+}
+
+// UpdateWebAuthnCredential updates a credential.
+func (a *UnimplementedWebAuthnAdapter) UpdateWebAuthnCredential(_ context.Context, _ GothWebAuthnCredential) (GothWebAuthnCredential, error) {
+	return GothWebAuthnCredential{}, ErrUnimplemented
+}
+
+// ListWebAuthnCredentials retrieves all credentials of a user.
+func (a *UnimplementedWebAuthnAdapter) ListWebAuthnCredentials(_ context.Context, _ uuid.UUID) ([]GothWebAuthnCredential, error) {
+	return nil, ErrUnimplemented
+}
+
+// DeleteWebAuthnCredential deletes a credential of a user.
+func (a *UnimplementedWebAuthnAdapter) DeleteWebAuthnCredential(_ context.Context, _, _ uuid.UUID) error {
+	return ErrUnimplemented
+}
@@ -0,0 +1,126 @@
+package goth
+
+import (
+	"errors"
+	"net/http"
+	"path"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/filesystem"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/katallaxie/fiber-goth/adapters"
+	"github.com/katallaxie/fiber-goth/pkg/apis"
+	"github.com/katallaxie/fiber-goth/pkg/controllers"
+	"github.com/katallaxie/fiber-goth/pkg/spec"
+)
+
+// MountAPI registers the REST API of the auth handlers at the API URL of the config.
+// The OpenAPI document of the API is served at "/openapi.json" and "/openapi.yaml",
+// and a page to explore the API at "/reference" below the API URL.
+// The session of the request is passed to the controller, which answers requests
+// without a valid session with an unauthorized error.
+func MountAPI(app fiber.Router, config Config, opts ...controllers.Opt) {
+	cfg := configDefault(config)
+
+	opts = append([]controllers.Opt{
+		controllers.WithBaseURL(cfg.APIURL),
+		controllers.WithPolicy(cfg.Policy),
+	}, opts...)
+
+	// The handlers of Fiber v2 do not support the link intent and two-factor authentication.
+	notImplemented := controllers.NotImplemented("LinkSocialAccount", "ListTrustedDevices", "PostRevokeTrustedDevice", "PostRevokeTrustedDevices")
+
+	handler := apis.NewStrictHandler(controllers.NewAPIController(cfg.Adapter, opts...), []apis.StrictMiddlewareFunc{notImplemented})
+	router := app.Group(cfg.APIURL, apiErrorHandler, apiSession(cfg))
+	apis.RegisterHandlers(router, handler)
+	mountReference(router, cfg.APIURL)
+}
+
+// mountReference registers the handlers that serve the OpenAPI document and the page to explore it.
+func mountReference(router fiber.Router, baseURL string) {
+	doc, err := spec.JSON(baseURL)
+	router.Get("/openapi.json", sendBytes(fiber.MIMEApplicationJSONCharsetUTF8, doc, err))
+
+	docYAML, err := spec.YAML(baseURL)
+	router.Get("/openapi.yaml", sendBytes("application/yaml; charset=utf-8", docYAML, err))
+
+	page, err := spec.Explorer(path.Join(baseURL, "openapi.json"), path.Join(baseURL, "reference"))
+	router.Get("/reference", sendBytes(fiber.MIMETextHTMLCharsetUTF8, page, err))
+	router.Use("/reference", filesystem.New(filesystem.Config{Root: http.FS(spec.Assets())}))
+}
+
+// sendBytes returns a handler that sends the content, or the error if the content could not be created.
+func sendBytes(contentType string, b []byte, err error) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if err != nil {
+			return err
+		}
+
+		c.Set(fiber.HeaderContentType, contentType)
+
+		return c.Send(b)
+	}
+}
+
+// apiSession attaches the session of the signed-in user to the context of the controller.
+func apiSession(cfg Config) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		session, err := currentSession(c, cfg)
+		if err == nil {
+			c.SetUserContext(controllers.ContextWithSession(c.UserContext(), session))
+		}
+
+		return c.Next()
+	}
+}
+
+// apiErrorHandler maps the errors of the API handlers to the error schema of the API.
+func apiErrorHandler(c *fiber.Ctx) error {
+	err := c.Next()
+	if err == nil {
+		return nil
+	}
+
+	code := fiber.StatusInternalServerError
+	message := utils.StatusMessage(code)
+
+	var fe *fiber.Error
+	var ge *Error
+
+	switch {
+	case errors.As(err, &fe):
+		code, message = fe.Code, fe.Message
+	case errors.As(err, &ge):
+		code, message = ge.Code, ge.Message
+	}
+
+	return c.Status(code).JSON(fiber.Map{"message": message})
+}
+
+// currentSession returns the valid session of the signed-in user.
+// It falls back to the token of the request if the session is not attached to the context.
+func currentSession(c *fiber.Ctx, cfg Config) (adapters.GothSession, error) {
+	if session, err := SessionFromContext(c); err == nil {
+		return session, nil
+	}
+
+	token, err := cfg.Extractor(c)
+	if err != nil {
+		return adapters.GothSession{}, err
+	}
+
+	session, err := cfg.Adapter.GetSession(c.Context(), token)
+	if err != nil {
+		return adapters.GothSession{}, err
+	}
+
+	if !session.IsValid() {
+		return adapters.GothSession{}, ErrBadSession
+	}
+
+	if session.TwoFactorPending {
+		return adapters.GothSession{}, ErrTwoFactorRequired
+	}
+
+	return session, nil
+}
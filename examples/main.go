@@ -9,6 +9,7 @@ import (
 
 	goth "github.com/katallaxie/fiber-goth/v3"
 	gorm_adapter "github.com/katallaxie/fiber-goth/v3/adapters/gorm"
+	"github.com/katallaxie/fiber-goth/v3/csrf"
 	"github.com/katallaxie/fiber-goth/v3/providers"
 	"github.com/katallaxie/fiber-goth/v3/providers/dex"
 	"github.com/katallaxie/fiber-goth/v3/providers/entraid"
@@ -134,6 +135,12 @@ func run(_ context.Context) error {
 	app.Get("/auth/:provider/callback", goth.NewCompleteAuthHandler(gothConfig))
 	app.Get("/logout", goth.NewLogoutHandler(gothConfig))
 
+	app.Use("/api/auth", csrf.New(csrf.Config{
+		Adapter: ga,
+		Next:    func(c fiber.Ctx) bool { return c.Cookies(gothConfig.SessionCookieName()) == "" },
+	}))
+	goth.MountAPI(app, gothConfig)
+
 	if err := app.Listen(cfg.Flags.Addr); err != nil {
 		return err
 	}
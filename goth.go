@@ -5,6 +5,7 @@
 package goth
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
@@ -15,9 +16,14 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/utils"
+	"github.com/google/uuid"
+	"github.com/katallaxie/fiber-goth/access"
 	"github.com/katallaxie/fiber-goth/adapters"
+	"github.com/katallaxie/fiber-goth/pkg/controllers"
 	"github.com/katallaxie/fiber-goth/providers"
+	"github.com/katallaxie/pkg/cast"
 	"github.com/valyala/fasthttp"
+	"golang.org/x/oauth2"
 )
 
 var _ Handler = (*BeginAuthHandler)(nil)
@@ -26,12 +32,23 @@ const charset = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz-
 
 // Params maps the parameters of the Fiber context to the gothic context.
 type Params struct {
-	ctx *fiber.Ctx
+	ctx          *fiber.Ctx
+	codeVerifier string
 }
 
 // Get returns the value of a query paramater.
+// It falls back to the form value of the request, e.g. of a POST to the complete handler.
 func (p *Params) Get(key string) string {
-	return p.ctx.Query(key)
+	if v := p.ctx.Query(key); v != "" {
+		return v
+	}
+
+	return p.ctx.FormValue(key)
+}
+
+// CodeVerifier returns the code verifier for PKCE, if applicable.
+func (p *Params) CodeVerifier() string {
+	return p.codeVerifier
 }
 
 // The contextKey type is unexported to prevent collisions with context keys defined in
@@ -80,10 +97,40 @@ var (
 	ErrBadSession = NewError(http.StatusBadRequest, "session is invalid")
 	// ErrMissingUser is thrown if the user is missing.
 	ErrMissingUser = NewError(http.StatusBadRequest, "missing user")
+	// ErrMissingAccount is thrown if the account is missing.
+	ErrMissingAccount = NewError(http.StatusBadRequest, "missing account")
+	// ErrMissingOrganization is thrown if the organization is missing.
+	ErrMissingOrganization = NewError(http.StatusBadRequest, "missing organization")
+	// ErrMissingMember is thrown if the member is missing.
+	ErrMissingMember = NewError(http.StatusBadRequest, "missing member")
+	// ErrMissingTeam is thrown if the team is missing.
+	ErrMissingTeam = NewError(http.StatusBadRequest, "missing team")
+	// ErrMissingInvitation is thrown if the invitation is missing.
+	ErrMissingInvitation = NewError(http.StatusBadRequest, "missing invitation")
+	// ErrMissingAdapter is thrown if no adapter is configured.
+	ErrMissingAdapter = NewError(http.StatusInternalServerError, "missing adapter")
 	// ErrMissingCookie is thrown if the cookie is missing.
 	ErrMissingCookie = NewError(http.StatusBadRequest, "missing session cookie")
+	// ErrBadToken is thrown if a verification token is invalid or has expired.
+	ErrBadToken = NewError(http.StatusBadRequest, "token is invalid or has expired")
 	// ErrBadRequest is thrown if the request is invalid.
 	ErrBadRequest = NewError(http.StatusBadRequest, "bad request")
+	// ErrForbidden is thrown if the user is not allowed to perform an action.
+	ErrForbidden = NewError(http.StatusForbidden, "forbidden")
+	// ErrTwoFactorRequired is thrown if the session waits for the second factor.
+	ErrTwoFactorRequired = NewError(http.StatusUnauthorized, "two-factor authentication required")
+	// ErrMissingTwoFactor is thrown if the user has not enabled two-factor authentication.
+	ErrMissingTwoFactor = NewError(http.StatusBadRequest, "two-factor authentication is not enabled")
+	// ErrBadTwoFactorCode is thrown if a code of the second factor is invalid or has been used.
+	ErrBadTwoFactorCode = NewError(http.StatusBadRequest, "two-factor code is invalid")
+	// ErrMissingCredential is thrown if the WebAuthn credential is missing.
+	ErrMissingCredential = NewError(http.StatusBadRequest, "missing credential")
+	// ErrMissingTrustedDevice is thrown if the trusted device could not be found.
+	ErrMissingTrustedDevice = NewError(http.StatusNotFound, "trusted device not found")
+	// ErrMissingPersonalAccessToken is thrown if the personal access token could not be found.
+	ErrMissingPersonalAccessToken = NewError(http.StatusNotFound, "personal access token not found")
+	// ErrMissingAPIKey is thrown if the API key is not found.
+	ErrMissingAPIKey = NewError(http.StatusNotFound, "API key not found")
 )
 
 const (
@@ -123,6 +170,11 @@ func (SessionHandler) New(cfg Config) fiber.Handler {
 			}
 		}
 
+		// the second factor is verified with the v3 module, which shares the sessions
+		if session.TwoFactorPending {
+			return cfg.ErrorHandler(c, ErrTwoFactorRequired)
+		}
+
 		duration, err := time.ParseDuration(cfg.Expiry)
 		if err != nil {
 			return cfg.ErrorHandler(c, err)
@@ -187,11 +239,33 @@ func (BeginAuthHandler) New(cfg Config) fiber.Handler {
 			return err
 		}
 
+		if form, ok := intent.(providers.AuthFormIntent); ok {
+			page, err := form.GetAuthForm()
+			if err != nil {
+				return err
+			}
+
+			c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+
+			return c.Send(page)
+		}
+
 		url, err := intent.GetAuthURL()
 		if err != nil {
 			return err
 		}
 
+		if intent.CodeVerifier() != "" {
+			c.Cookie(&fiber.Cookie{
+				Name:     cfg.CodeVerifierCookieName,
+				Value:    intent.CodeVerifier(),
+				Path:     "/",
+				MaxAge:   300, //nolint:mnd
+				Secure:   cfg.CookieSecure,
+				HTTPOnly: true,
+			})
+		}
+
 		return c.Redirect(url, fiber.StatusTemporaryRedirect)
 	}
 }
@@ -230,7 +304,9 @@ func (CompleteAuthCompleteHandler) New(cfg Config) fiber.Handler {
 			return cfg.ErrorHandler(c, ErrMissingProviderName)
 		}
 
-		user, err := provider.CompleteAuth(c.Context(), cfg.Adapter, &Params{ctx: c})
+		params := &Params{ctx: c, codeVerifier: c.Cookies(cfg.CodeVerifierCookieName)}
+
+		user, err := provider.CompleteAuth(c.Context(), cfg.Adapter, params)
 		if err != nil {
 			return cfg.ErrorHandler(c, ErrMissingUser)
 		}
@@ -241,7 +317,7 @@ func (CompleteAuthCompleteHandler) New(cfg Config) fiber.Handler {
 		}
 		expires := time.Now().Add(duration)
 
-		session, err := cfg.Adapter.CreateSession(c.Context(), user.ID, expires)
+		session, err := cfg.Adapter.CreateSession(c.Context(), user.ID, expires, sessionMetadata(c)...)
 		if err != nil {
 			return cfg.ErrorHandler(c, ErrMissingSession)
 		}
@@ -339,7 +415,7 @@ func NewProtectMiddleware(config ...Config) fiber.Handler {
 			return c.Redirect(cfg.LoginURL, fiber.StatusTemporaryRedirect)
 		}
 
-		if !session.IsValid() {
+		if !session.IsValid() || session.TwoFactorPending {
 			return c.Redirect(cfg.LoginURL, fiber.StatusTemporaryRedirect)
 		}
 
@@ -396,7 +472,7 @@ func NewProtectedHandler(handler fiber.Handler, config ...Config) fiber.Handler
 			return c.Redirect(cfg.LoginURL, fiber.StatusTemporaryRedirect)
 		}
 
-		if !session.IsValid() {
+		if !session.IsValid() || session.TwoFactorPending {
 			return c.Redirect(cfg.LoginURL, fiber.StatusTemporaryRedirect)
 		}
 
@@ -452,6 +528,92 @@ func SessionFromContext(c *fiber.Ctx) (adapters.GothSession, error) {
 	return session, nil
 }
 
+// ActiveOrganizationFromContext returns the ID of the active organization of the session from the request context.
+func ActiveOrganizationFromContext(c *fiber.Ctx) (uuid.UUID, error) {
+	session, err := SessionFromContext(c)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if session.ActiveOrganizationID == nil {
+		return uuid.Nil, ErrMissingOrganization
+	}
+
+	return *session.ActiveOrganizationID, nil
+}
+
+// RequirePermission returns a middleware that requires the member of the active organization
+// to be allowed to perform the action on the resource.
+// The adapter has to implement the organization adapter.
+func RequirePermission(resource, action string, config ...Config) fiber.Handler {
+	cfg := configDefault(config...)
+
+	return func(c *fiber.Ctx) error {
+		session, err := SessionFromContext(c)
+		if err != nil {
+			return err
+		}
+
+		if session.ActiveOrganizationID == nil {
+			return ErrMissingOrganization
+		}
+
+		orgs, ok := cfg.Adapter.(adapters.OrganizationAdapter)
+		if !ok {
+			return ErrMissingAdapter
+		}
+
+		member, err := orgs.GetMember(c.Context(), *session.ActiveOrganizationID, session.UserID)
+		if err != nil {
+			return ErrForbidden
+		}
+
+		if !cfg.Policy.Can(member.Role, resource, action) {
+			return ErrForbidden
+		}
+
+		return c.Next()
+	}
+}
+
+// ActiveTeamFromContext returns the ID of the active team of the session from the request context.
+func ActiveTeamFromContext(c *fiber.Ctx) (uuid.UUID, error) {
+	session, err := SessionFromContext(c)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if session.ActiveTeamID == nil {
+		return uuid.Nil, ErrMissingTeam
+	}
+
+	return *session.ActiveTeamID, nil
+}
+
+// AccessTokenFor returns a valid access token of the user for the provider.
+// The token is transparently refreshed and written back through the adapter when it is about to expire.
+func AccessTokenFor(ctx context.Context, userID uuid.UUID, provider string, config ...Config) (*oauth2.Token, error) {
+	cfg := configDefault(config...)
+
+	if cfg.Adapter == nil {
+		return nil, ErrMissingAdapter
+	}
+
+	account, err := providers.AccessToken(ctx, cfg.Adapter, userID, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &oauth2.Token{
+		AccessToken:  cast.Value(account.AccessToken),
+		TokenType:    cast.Value(account.TokenType),
+		RefreshToken: cast.Value(account.RefreshToken),
+		Expiry:       cast.Value(account.ExpiresAt),
+	}
+
+	return token, nil
+}
+
 // Config caputes the configuration for running the goth middleware.
 type Config struct {
 	// Next defines a function to skip this middleware when returned true.
@@ -487,6 +649,11 @@ type Config struct {
 	// CookieName is the name of the cookie used to store the session.
 	CookieName string
 
+	// CodeVerifierCookieName is the name of the cookie used to store the code verifier for PKCE.
+	//
+	// Optional. Default: "fiber_goth.code_verifier"
+	CodeVerifierCookieName string
+
 	// CookieSameSite is the SameSite attribute of the cookie.
 	CookieSameSite fasthttp.CookieSameSite
 
@@ -524,6 +691,11 @@ type Config struct {
 	// CompletionURL is the default url after completion
 	CompletionURL string
 
+	// APIURL is the URL the REST API is mounted at.
+	//
+	// Optional. Default: controllers.DefaultBaseURL
+	APIURL string
+
 	// ErrorHandler is executed when an error is returned from fiber.Handler.
 	//
 	// Optional. Default: DefaultErrorHandler
@@ -531,26 +703,34 @@ type Config struct {
 
 	// Extractor is the function used to extract the token from the request.
 	Extractor func(c *fiber.Ctx) (string, error)
+
+	// Policy maps the roles of organization members to their permissions.
+	//
+	// Optional. Default: access.DefaultPolicy
+	Policy access.Policy
 }
 
 // ConfigDefault is the default config.
 var ConfigDefault = Config{
-	ErrorHandler:        defaultErrorHandler,
-	BeginAuthHandler:    BeginAuthHandler{},
-	CompleteAuthHandler: CompleteAuthCompleteHandler{},
-	LogoutHandler:       LogoutHandler{},
-	SessionHandler:      SessionHandler{},
-	IndexHandler:        defaultIndexHandler,
-	Encryptor:           EncryptCookie,
-	Decryptor:           DecryptCookie,
-	Expiry:              "7h",
-	CookieName:          "fiber_goth.session",
-	Extractor:           TokenFromCookie("fiber_goth.session"),
-	CookieSameSite:      fasthttp.CookieSameSiteLaxMode,
-	CompletionURL:       "/",
-	LoginURL:            "/login",
-	LogoutURL:           "/logout",
-	CallbackURL:         "/auth",
+	ErrorHandler:           defaultErrorHandler,
+	BeginAuthHandler:       BeginAuthHandler{},
+	CompleteAuthHandler:    CompleteAuthCompleteHandler{},
+	LogoutHandler:          LogoutHandler{},
+	SessionHandler:         SessionHandler{},
+	IndexHandler:           defaultIndexHandler,
+	Encryptor:              EncryptCookie,
+	Decryptor:              DecryptCookie,
+	Expiry:                 "7h",
+	CookieName:             "fiber_goth.session",
+	CodeVerifierCookieName: "fiber_goth.code_verifier",
+	Extractor:              TokenFromCookie("fiber_goth.session"),
+	Policy:                 access.DefaultPolicy,
+	CookieSameSite:         fasthttp.CookieSameSiteLaxMode,
+	CompletionURL:          "/",
+	LoginURL:               "/login",
+	LogoutURL:              "/logout",
+	CallbackURL:            "/auth",
+	APIURL:                 controllers.DefaultBaseURL,
 }
 
 // default ErrorHandler that process return error from fiber.Handler.
@@ -629,6 +809,10 @@ func configDefault(config ...Config) Config {
 		cfg.CookieName = ConfigDefault.CookieName
 	}
 
+	if cfg.CodeVerifierCookieName == "" {
+		cfg.CodeVerifierCookieName = ConfigDefault.CodeVerifierCookieName
+	}
+
 	if cfg.CookieSameSite == 0 {
 		cfg.CookieSameSite = ConfigDefault.CookieSameSite
 	}
@@ -649,6 +833,10 @@ func configDefault(config ...Config) Config {
 		cfg.CallbackURL = ConfigDefault.CallbackURL
 	}
 
+	if cfg.APIURL == "" {
+		cfg.APIURL = ConfigDefault.APIURL
+	}
+
 	if cfg.ErrorHandler == nil {
 		cfg.ErrorHandler = ConfigDefault.ErrorHandler
 	}
@@ -657,9 +845,22 @@ func configDefault(config ...Config) Config {
 		cfg.CompletionFilter = defaultCompletionFilter(cfg.CompletionURL)
 	}
 
+	if cfg.Policy == nil {
+		cfg.Policy = ConfigDefault.Policy
+	}
+
 	return cfg
 }
 
+// sessionMetadata returns the metadata of the client to record on a new session.
+// The IP address respects the trusted proxy settings of the app.
+func sessionMetadata(c *fiber.Ctx) []adapters.SessionOpt {
+	return []adapters.SessionOpt{
+		adapters.WithIPAddress(c.IP()),
+		adapters.WithUserAgent(c.Get(fiber.HeaderUserAgent)),
+	}
+}
+
 func stateFromContext(ctx *fiber.Ctx) (string, error) {
 	state := ctx.Query(state)
 	if len(state) > 0 {
@@ -104,6 +104,11 @@ type ClientInterface interface {
 
 	ChangePassword(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreatePersonalAccessTokenWithBody request with any body
+	CreatePersonalAccessTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePersonalAccessToken(ctx context.Context, body CreatePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteUserWithBody request with any body
 	DeleteUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -131,9 +136,15 @@ type ClientInterface interface {
 	// ListUserAccounts request
 	ListUserAccounts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListPersonalAccessTokens request
+	ListPersonalAccessTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListUserSessions request
 	ListUserSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListTrustedDevices request
+	ListTrustedDevices(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetOk request
 	GetOk(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -179,7 +190,7 @@ type ClientInterface interface {
 	GetOrganizationGetActiveMemberRole(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetOrganization request
-	GetOrganization(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetOrganization(ctx context.Context, params *GetOrganizationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetOrganizationGetInvitation request
 	GetOrganizationGetInvitation(ctx context.Context, params *GetOrganizationGetInvitationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -203,16 +214,16 @@ type ClientInterface interface {
 	GetOrganizationList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetOrganizationListInvitations request
-	GetOrganizationListInvitations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetOrganizationListInvitations(ctx context.Context, params *GetOrganizationListInvitationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetOrganizationListMembers request
 	GetOrganizationListMembers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetOrganizationListTeamMembers request
-	GetOrganizationListTeamMembers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetOrganizationListTeamMembers(ctx context.Context, params *GetOrganizationListTeamMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetOrganizationListTeams request
-	GetOrganizationListTeams(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetOrganizationListTeams(ctx context.Context, params *GetOrganizationListTeamsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetOrganizationListUserInvitations request
 	GetOrganizationListUserInvitations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -288,6 +299,11 @@ type ClientInterface interface {
 
 	PostRevokeOtherSessions(ctx context.Context, body PostRevokeOtherSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostRevokePersonalAccessTokenWithBody request with any body
+	PostRevokePersonalAccessTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRevokePersonalAccessToken(ctx context.Context, body PostRevokePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostRevokeSessionWithBody request with any body
 	PostRevokeSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -298,6 +314,16 @@ type ClientInterface interface {
 
 	PostRevokeSessions(ctx context.Context, body PostRevokeSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostRevokeTrustedDeviceWithBody request with any body
+	PostRevokeTrustedDeviceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRevokeTrustedDevice(ctx context.Context, body PostRevokeTrustedDeviceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRevokeTrustedDevicesWithBody request with any body
+	PostRevokeTrustedDevicesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRevokeTrustedDevices(ctx context.Context, body PostRevokeTrustedDevicesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SendVerificationEmailWithBody request with any body
 	SendVerificationEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -313,6 +339,11 @@ type ClientInterface interface {
 
 	SocialSignIn(ctx context.Context, body SocialSignInJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateSocialSignInNonceWithBody request with any body
+	CreateSocialSignInNonceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSocialSignInNonce(ctx context.Context, body CreateSocialSignInNonceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SignOutWithBody request with any body
 	SignOutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -397,6 +428,30 @@ func (c *Client) ChangePassword(ctx context.Context, body ChangePasswordJSONRequ
 	return c.Client.Do(req)
 }
 
+func (c *Client) CreatePersonalAccessTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePersonalAccessTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePersonalAccessToken(ctx context.Context, body CreatePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePersonalAccessTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DeleteUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDeleteUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -517,6 +572,18 @@ func (c *Client) ListUserAccounts(ctx context.Context, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
+func (c *Client) ListPersonalAccessTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPersonalAccessTokensRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListUserSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListUserSessionsRequest(c.Server)
 	if err != nil {
@@ -529,6 +596,18 @@ func (c *Client) ListUserSessions(ctx context.Context, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
+func (c *Client) ListTrustedDevices(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTrustedDevicesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetOk(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetOkRequest(c.Server)
 	if err != nil {
@@ -733,8 +812,8 @@ func (c *Client) GetOrganizationGetActiveMemberRole(ctx context.Context, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOrganization(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrganizationRequest(c.Server)
+func (c *Client) GetOrganization(ctx context.Context, params *GetOrganizationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -841,8 +920,8 @@ func (c *Client) GetOrganizationList(ctx context.Context, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOrganizationListInvitations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrganizationListInvitationsRequest(c.Server)
+func (c *Client) GetOrganizationListInvitations(ctx context.Context, params *GetOrganizationListInvitationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationListInvitationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -865,8 +944,8 @@ func (c *Client) GetOrganizationListMembers(ctx context.Context, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOrganizationListTeamMembers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrganizationListTeamMembersRequest(c.Server)
+func (c *Client) GetOrganizationListTeamMembers(ctx context.Context, params *GetOrganizationListTeamMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationListTeamMembersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -877,8 +956,8 @@ func (c *Client) GetOrganizationListTeamMembers(ctx context.Context, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOrganizationListTeams(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrganizationListTeamsRequest(c.Server)
+func (c *Client) GetOrganizationListTeams(ctx context.Context, params *GetOrganizationListTeamsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationListTeamsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1237,6 +1316,30 @@ func (c *Client) PostRevokeOtherSessions(ctx context.Context, body PostRevokeOth
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostRevokePersonalAccessTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRevokePersonalAccessTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRevokePersonalAccessToken(ctx context.Context, body PostRevokePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRevokePersonalAccessTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostRevokeSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostRevokeSessionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -1285,6 +1388,54 @@ func (c *Client) PostRevokeSessions(ctx context.Context, body PostRevokeSessions
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostRevokeTrustedDeviceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRevokeTrustedDeviceRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRevokeTrustedDevice(ctx context.Context, body PostRevokeTrustedDeviceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRevokeTrustedDeviceRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRevokeTrustedDevicesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRevokeTrustedDevicesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRevokeTrustedDevices(ctx context.Context, body PostRevokeTrustedDevicesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRevokeTrustedDevicesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) SendVerificationEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewSendVerificationEmailRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -1357,6 +1508,30 @@ func (c *Client) SocialSignIn(ctx context.Context, body SocialSignInJSONRequestB
 	return c.Client.Do(req)
 }
 
+func (c *Client) CreateSocialSignInNonceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSocialSignInNonceRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSocialSignInNonce(ctx context.Context, body CreateSocialSignInNonceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSocialSignInNonceRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) SignOutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewSignOutRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -1572,6 +1747,46 @@ func NewChangePasswordRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
+// NewCreatePersonalAccessTokenRequest calls the generic CreatePersonalAccessToken builder with application/json body
+func NewCreatePersonalAccessTokenRequest(server string, body CreatePersonalAccessTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePersonalAccessTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePersonalAccessTokenRequestWithBody generates requests for CreatePersonalAccessToken with any type of body
+func NewCreatePersonalAccessTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/create-personal-access-token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewDeleteUserRequest calls the generic DeleteUser builder with application/json body
 func NewDeleteUserRequest(server string, body DeleteUserJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -1838,6 +2053,33 @@ func NewListUserAccountsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewListPersonalAccessTokensRequest generates requests for ListPersonalAccessTokens
+func NewListPersonalAccessTokensRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/list-personal-access-tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewListUserSessionsRequest generates requests for ListUserSessions
 func NewListUserSessionsRequest(server string) (*http.Request, error) {
 	var err error
@@ -1865,6 +2107,33 @@ func NewListUserSessionsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewListTrustedDevicesRequest generates requests for ListTrustedDevices
+func NewListTrustedDevicesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/list-trusted-devices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetOkRequest generates requests for GetOk
 func NewGetOkRequest(server string) (*http.Request, error) {
 	var err error
@@ -2227,7 +2496,7 @@ func NewGetOrganizationGetActiveMemberRoleRequest(server string) (*http.Request,
 }
 
 // NewGetOrganizationRequest generates requests for GetOrganization
-func NewGetOrganizationRequest(server string) (*http.Request, error) {
+func NewGetOrganizationRequest(server string, params *GetOrganizationParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2245,6 +2514,44 @@ func NewGetOrganizationRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OrganizationId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "organizationId", runtime.ParamLocationQuery, *params.OrganizationId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrganizationSlug != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "organizationSlug", runtime.ParamLocationQuery, *params.OrganizationSlug); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2450,7 +2757,7 @@ func NewGetOrganizationListRequest(server string) (*http.Request, error) {
 }
 
 // NewGetOrganizationListInvitationsRequest generates requests for GetOrganizationListInvitations
-func NewGetOrganizationListInvitationsRequest(server string) (*http.Request, error) {
+func NewGetOrganizationListInvitationsRequest(server string, params *GetOrganizationListInvitationsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2468,13 +2775,35 @@ func NewGetOrganizationListInvitationsRequest(server string) (*http.Request, err
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.OrganizationId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "organizationId", runtime.ParamLocationQuery, *params.OrganizationId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
 
 // NewGetOrganizationListMembersRequest generates requests for GetOrganizationListMembers
 func NewGetOrganizationListMembersRequest(server string) (*http.Request, error) {
@@ -2504,7 +2833,7 @@ func NewGetOrganizationListMembersRequest(server string) (*http.Request, error)
 }
 
 // NewGetOrganizationListTeamMembersRequest generates requests for GetOrganizationListTeamMembers
-func NewGetOrganizationListTeamMembersRequest(server string) (*http.Request, error) {
+func NewGetOrganizationListTeamMembersRequest(server string, params *GetOrganizationListTeamMembersParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2522,6 +2851,28 @@ func NewGetOrganizationListTeamMembersRequest(server string) (*http.Request, err
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TeamId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "teamId", runtime.ParamLocationQuery, *params.TeamId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2531,7 +2882,7 @@ func NewGetOrganizationListTeamMembersRequest(server string) (*http.Request, err
 }
 
 // NewGetOrganizationListTeamsRequest generates requests for GetOrganizationListTeams
-func NewGetOrganizationListTeamsRequest(server string) (*http.Request, error) {
+func NewGetOrganizationListTeamsRequest(server string, params *GetOrganizationListTeamsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2549,6 +2900,28 @@ func NewGetOrganizationListTeamsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OrganizationId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "organizationId", runtime.ParamLocationQuery, *params.OrganizationId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3183,6 +3556,46 @@ func NewPostRevokeOtherSessionsRequestWithBody(server string, contentType string
 	return req, nil
 }
 
+// NewPostRevokePersonalAccessTokenRequest calls the generic PostRevokePersonalAccessToken builder with application/json body
+func NewPostRevokePersonalAccessTokenRequest(server string, body PostRevokePersonalAccessTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRevokePersonalAccessTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostRevokePersonalAccessTokenRequestWithBody generates requests for PostRevokePersonalAccessToken with any type of body
+func NewPostRevokePersonalAccessTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/revoke-personal-access-token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewPostRevokeSessionRequest calls the generic PostRevokeSession builder with application/json body
 func NewPostRevokeSessionRequest(server string, body PostRevokeSessionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -3263,6 +3676,86 @@ func NewPostRevokeSessionsRequestWithBody(server string, contentType string, bod
 	return req, nil
 }
 
+// NewPostRevokeTrustedDeviceRequest calls the generic PostRevokeTrustedDevice builder with application/json body
+func NewPostRevokeTrustedDeviceRequest(server string, body PostRevokeTrustedDeviceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRevokeTrustedDeviceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostRevokeTrustedDeviceRequestWithBody generates requests for PostRevokeTrustedDevice with any type of body
+func NewPostRevokeTrustedDeviceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/revoke-trusted-device")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostRevokeTrustedDevicesRequest calls the generic PostRevokeTrustedDevices builder with application/json body
+func NewPostRevokeTrustedDevicesRequest(server string, body PostRevokeTrustedDevicesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRevokeTrustedDevicesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostRevokeTrustedDevicesRequestWithBody generates requests for PostRevokeTrustedDevices with any type of body
+func NewPostRevokeTrustedDevicesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/revoke-trusted-devices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewSendVerificationEmailRequest calls the generic SendVerificationEmail builder with application/json body
 func NewSendVerificationEmailRequest(server string, body SendVerificationEmailJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -3383,6 +3876,46 @@ func NewSocialSignInRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
+// NewCreateSocialSignInNonceRequest calls the generic CreateSocialSignInNonce builder with application/json body
+func NewCreateSocialSignInNonceRequest(server string, body CreateSocialSignInNonceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSocialSignInNonceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSocialSignInNonceRequestWithBody generates requests for CreateSocialSignInNonce with any type of body
+func NewCreateSocialSignInNonceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sign-in/social/nonce")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewSignOutRequest calls the generic SignOut builder with application/json body
 func NewSignOutRequest(server string, body SignOutJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -3660,6 +4193,11 @@ type ClientWithResponsesInterface interface {
 
 	ChangePasswordWithResponse(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error)
 
+	// CreatePersonalAccessTokenWithBodyWithResponse request with any body
+	CreatePersonalAccessTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenResponse, error)
+
+	CreatePersonalAccessTokenWithResponse(ctx context.Context, body CreatePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenResponse, error)
+
 	// DeleteUserWithBodyWithResponse request with any body
 	DeleteUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error)
 
@@ -3687,9 +4225,15 @@ type ClientWithResponsesInterface interface {
 	// ListUserAccountsWithResponse request
 	ListUserAccountsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListUserAccountsResponse, error)
 
+	// ListPersonalAccessTokensWithResponse request
+	ListPersonalAccessTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPersonalAccessTokensResponse, error)
+
 	// ListUserSessionsWithResponse request
 	ListUserSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListUserSessionsResponse, error)
 
+	// ListTrustedDevicesWithResponse request
+	ListTrustedDevicesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTrustedDevicesResponse, error)
+
 	// GetOkWithResponse request
 	GetOkWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOkResponse, error)
 
@@ -3735,7 +4279,7 @@ type ClientWithResponsesInterface interface {
 	GetOrganizationGetActiveMemberRoleWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrganizationGetActiveMemberRoleResponse, error)
 
 	// GetOrganizationWithResponse request
-	GetOrganizationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error)
+	GetOrganizationWithResponse(ctx context.Context, params *GetOrganizationParams, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error)
 
 	// GetOrganizationGetInvitationWithResponse request
 	GetOrganizationGetInvitationWithResponse(ctx context.Context, params *GetOrganizationGetInvitationParams, reqEditors ...RequestEditorFn) (*GetOrganizationGetInvitationResponse, error)
@@ -3759,16 +4303,16 @@ type ClientWithResponsesInterface interface {
 	GetOrganizationListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrganizationListResponse, error)
 
 	// GetOrganizationListInvitationsWithResponse request
-	GetOrganizationListInvitationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrganizationListInvitationsResponse, error)
+	GetOrganizationListInvitationsWithResponse(ctx context.Context, params *GetOrganizationListInvitationsParams, reqEditors ...RequestEditorFn) (*GetOrganizationListInvitationsResponse, error)
 
 	// GetOrganizationListMembersWithResponse request
 	GetOrganizationListMembersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrganizationListMembersResponse, error)
 
 	// GetOrganizationListTeamMembersWithResponse request
-	GetOrganizationListTeamMembersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrganizationListTeamMembersResponse, error)
+	GetOrganizationListTeamMembersWithResponse(ctx context.Context, params *GetOrganizationListTeamMembersParams, reqEditors ...RequestEditorFn) (*GetOrganizationListTeamMembersResponse, error)
 
 	// GetOrganizationListTeamsWithResponse request
-	GetOrganizationListTeamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrganizationListTeamsResponse, error)
+	GetOrganizationListTeamsWithResponse(ctx context.Context, params *GetOrganizationListTeamsParams, reqEditors ...RequestEditorFn) (*GetOrganizationListTeamsResponse, error)
 
 	// GetOrganizationListUserInvitationsWithResponse request
 	GetOrganizationListUserInvitationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrganizationListUserInvitationsResponse, error)
@@ -3844,6 +4388,11 @@ type ClientWithResponsesInterface interface {
 
 	PostRevokeOtherSessionsWithResponse(ctx context.Context, body PostRevokeOtherSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRevokeOtherSessionsResponse, error)
 
+	// PostRevokePersonalAccessTokenWithBodyWithResponse request with any body
+	PostRevokePersonalAccessTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRevokePersonalAccessTokenResponse, error)
+
+	PostRevokePersonalAccessTokenWithResponse(ctx context.Context, body PostRevokePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRevokePersonalAccessTokenResponse, error)
+
 	// PostRevokeSessionWithBodyWithResponse request with any body
 	PostRevokeSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRevokeSessionResponse, error)
 
@@ -3854,6 +4403,16 @@ type ClientWithResponsesInterface interface {
 
 	PostRevokeSessionsWithResponse(ctx context.Context, body PostRevokeSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRevokeSessionsResponse, error)
 
+	// PostRevokeTrustedDeviceWithBodyWithResponse request with any body
+	PostRevokeTrustedDeviceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRevokeTrustedDeviceResponse, error)
+
+	PostRevokeTrustedDeviceWithResponse(ctx context.Context, body PostRevokeTrustedDeviceJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRevokeTrustedDeviceResponse, error)
+
+	// PostRevokeTrustedDevicesWithBodyWithResponse request with any body
+	PostRevokeTrustedDevicesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRevokeTrustedDevicesResponse, error)
+
+	PostRevokeTrustedDevicesWithResponse(ctx context.Context, body PostRevokeTrustedDevicesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRevokeTrustedDevicesResponse, error)
+
 	// SendVerificationEmailWithBodyWithResponse request with any body
 	SendVerificationEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SendVerificationEmailResponse, error)
 
@@ -3869,6 +4428,11 @@ type ClientWithResponsesInterface interface {
 
 	SocialSignInWithResponse(ctx context.Context, body SocialSignInJSONRequestBody, reqEditors ...RequestEditorFn) (*SocialSignInResponse, error)
 
+	// CreateSocialSignInNonceWithBodyWithResponse request with any body
+	CreateSocialSignInNonceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSocialSignInNonceResponse, error)
+
+	CreateSocialSignInNonceWithResponse(ctx context.Context, body CreateSocialSignInNonceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSocialSignInNonceResponse, error)
+
 	// SignOutWithBodyWithResponse request with any body
 	SignOutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignOutResponse, error)
 
@@ -4057,6 +4621,51 @@ func (r ChangePasswordResponse) StatusCode() int {
 	return 0
 }
 
+type CreatePersonalAccessTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		PersonalAccessToken PersonalAccessToken `json:"personalAccessToken"`
+
+		// Token The token, which is only returned once
+		Token string `json:"token"`
+	}
+	JSON400 *struct {
+		Message string `json:"message"`
+	}
+	JSON401 *struct {
+		Message string `json:"message"`
+	}
+	JSON403 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON404 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON429 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON500 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePersonalAccessTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePersonalAccessTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type DeleteUserResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -4370,10 +4979,10 @@ func (r ListUserAccountsResponse) StatusCode() int {
 	return 0
 }
 
-type ListUserSessionsResponse struct {
+type ListPersonalAccessTokensResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Session
+	JSON200      *[]PersonalAccessToken
 	JSON400      *struct {
 		Message string `json:"message"`
 	}
@@ -4395,7 +5004,7 @@ type ListUserSessionsResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r ListUserSessionsResponse) Status() string {
+func (r ListPersonalAccessTokensResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4403,14 +5012,94 @@ func (r ListUserSessionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListUserSessionsResponse) StatusCode() int {
+func (r ListPersonalAccessTokensResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetOkResponse struct {
+type ListUserSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Session
+	JSON400      *struct {
+		Message string `json:"message"`
+	}
+	JSON401 *struct {
+		Message string `json:"message"`
+	}
+	JSON403 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON404 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON429 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON500 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUserSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUserSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTrustedDevicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TrustedDevice
+	JSON400      *struct {
+		Message string `json:"message"`
+	}
+	JSON401 *struct {
+		Message string `json:"message"`
+	}
+	JSON403 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON404 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON429 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON500 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTrustedDevicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTrustedDevicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOkResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
@@ -4457,8 +5146,8 @@ type PostOrganizationAcceptInvitationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Invitation *map[string]interface{} `json:"invitation,omitempty"`
-		Member     *map[string]interface{} `json:"member,omitempty"`
+		Invitation *Invitation `json:"invitation,omitempty"`
+		Member     *Member     `json:"member,omitempty"`
 	}
 	JSON400 *struct {
 		Message string `json:"message"`
@@ -4551,6 +5240,7 @@ func (r PostOrganizationAddTeamMemberResponse) StatusCode() int {
 type PostOrganizationCancelInvitationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *Invitation
 	JSON400      *struct {
 		Message string `json:"message"`
 	}
@@ -4590,7 +5280,10 @@ func (r PostOrganizationCancelInvitationResponse) StatusCode() int {
 type PostOrganizationCheckSlugResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *struct {
+	JSON200      *struct {
+		Status *bool `json:"status,omitempty"`
+	}
+	JSON400 *struct {
 		Message string `json:"message"`
 	}
 	JSON401 *struct {
@@ -4809,7 +5502,10 @@ func (r GetOrganizationGetActiveMemberResponse) StatusCode() int {
 type GetOrganizationGetActiveMemberRoleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *struct {
+	JSON200      *struct {
+		Role string `json:"role"`
+	}
+	JSON400 *struct {
 		Message string `json:"message"`
 	}
 	JSON401 *struct {
@@ -5031,7 +5727,15 @@ func (r CreateOrganizationInvitationResponse) StatusCode() int {
 type PostOrganizationLeaveResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *struct {
+	JSON200      *struct {
+		Member struct {
+			Id             string `json:"id"`
+			OrganizationId string `json:"organizationId"`
+			Role           string `json:"role"`
+			UserId         string `json:"userId"`
+		} `json:"member"`
+	}
+	JSON400 *struct {
 		Message string `json:"message"`
 	}
 	JSON401 *struct {
@@ -5110,6 +5814,7 @@ func (r GetOrganizationListResponse) StatusCode() int {
 type GetOrganizationListInvitationsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *[]Invitation
 	JSON400      *struct {
 		Message string `json:"message"`
 	}
@@ -5391,7 +6096,7 @@ type PostOrganizationRejectInvitationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Invitation *map[string]interface{} `json:"invitation,omitempty"`
+		Invitation *Invitation             `json:"invitation,omitempty"`
 		Member     *map[string]interface{} `json:"member"`
 	}
 	JSON400 *struct {
@@ -5999,6 +6704,49 @@ func (r PostRevokeOtherSessionsResponse) StatusCode() int {
 	return 0
 }
 
+type PostRevokePersonalAccessTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Status Indicates if the personal access token was revoked successfully
+		Status bool `json:"status"`
+	}
+	JSON400 *struct {
+		Message string `json:"message"`
+	}
+	JSON401 *struct {
+		Message string `json:"message"`
+	}
+	JSON403 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON404 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON429 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON500 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRevokePersonalAccessTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRevokePersonalAccessTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type PostRevokeSessionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -6085,6 +6833,92 @@ func (r PostRevokeSessionsResponse) StatusCode() int {
 	return 0
 }
 
+type PostRevokeTrustedDeviceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Status Indicates if the trusted device was revoked successfully
+		Status bool `json:"status"`
+	}
+	JSON400 *struct {
+		Message string `json:"message"`
+	}
+	JSON401 *struct {
+		Message string `json:"message"`
+	}
+	JSON403 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON404 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON429 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON500 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRevokeTrustedDeviceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRevokeTrustedDeviceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRevokeTrustedDevicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Status Indicates if all trusted devices were revoked successfully
+		Status bool `json:"status"`
+	}
+	JSON400 *struct {
+		Message string `json:"message"`
+	}
+	JSON401 *struct {
+		Message string `json:"message"`
+	}
+	JSON403 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON404 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON429 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON500 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRevokeTrustedDevicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRevokeTrustedDevicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type SendVerificationEmailResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -6180,10 +7014,13 @@ type SocialSignInResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Redirect bool    `json:"redirect"`
-		Token    string  `json:"token"`
-		Url      *string `json:"url,omitempty"`
-		User     User    `json:"user"`
+		Redirect bool   `json:"redirect"`
+		Token    string `json:"token"`
+
+		// TwoFactorPending The session is pending until the second factor is verified
+		TwoFactorPending *bool   `json:"twoFactorPending,omitempty"`
+		Url              *string `json:"url,omitempty"`
+		User             User    `json:"user"`
 	}
 	JSON400 *struct {
 		Message string `json:"message"`
@@ -6221,6 +7058,51 @@ func (r SocialSignInResponse) StatusCode() int {
 	return 0
 }
 
+type CreateSocialSignInNonceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		ExpiresAt time.Time `json:"expiresAt"`
+
+		// Nonce Nonce to request the ID token with
+		Nonce string `json:"nonce"`
+	}
+	JSON400 *struct {
+		Message string `json:"message"`
+	}
+	JSON401 *struct {
+		Message string `json:"message"`
+	}
+	JSON403 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON404 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON429 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON500 *struct {
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSocialSignInNonceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSocialSignInNonceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type SignOutResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -6502,9 +7384,26 @@ func (c *ClientWithResponses) ChangePasswordWithResponse(ctx context.Context, bo
 	return ParseChangePasswordResponse(rsp)
 }
 
-// DeleteUserWithBodyWithResponse request with arbitrary body returning *DeleteUserResponse
-func (c *ClientWithResponses) DeleteUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error) {
-	rsp, err := c.DeleteUserWithBody(ctx, contentType, body, reqEditors...)
+// CreatePersonalAccessTokenWithBodyWithResponse request with arbitrary body returning *CreatePersonalAccessTokenResponse
+func (c *ClientWithResponses) CreatePersonalAccessTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenResponse, error) {
+	rsp, err := c.CreatePersonalAccessTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePersonalAccessTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePersonalAccessTokenWithResponse(ctx context.Context, body CreatePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenResponse, error) {
+	rsp, err := c.CreatePersonalAccessToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePersonalAccessTokenResponse(rsp)
+}
+
+// DeleteUserWithBodyWithResponse request with arbitrary body returning *DeleteUserResponse
+func (c *ClientWithResponses) DeleteUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error) {
+	rsp, err := c.DeleteUserWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -6589,6 +7488,15 @@ func (c *ClientWithResponses) ListUserAccountsWithResponse(ctx context.Context,
 	return ParseListUserAccountsResponse(rsp)
 }
 
+// ListPersonalAccessTokensWithResponse request returning *ListPersonalAccessTokensResponse
+func (c *ClientWithResponses) ListPersonalAccessTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPersonalAccessTokensResponse, error) {
+	rsp, err := c.ListPersonalAccessTokens(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPersonalAccessTokensResponse(rsp)
+}
+
 // ListUserSessionsWithResponse request returning *ListUserSessionsResponse
 func (c *ClientWithResponses) ListUserSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListUserSessionsResponse, error) {
 	rsp, err := c.ListUserSessions(ctx, reqEditors...)
@@ -6598,6 +7506,15 @@ func (c *ClientWithResponses) ListUserSessionsWithResponse(ctx context.Context,
 	return ParseListUserSessionsResponse(rsp)
 }
 
+// ListTrustedDevicesWithResponse request returning *ListTrustedDevicesResponse
+func (c *ClientWithResponses) ListTrustedDevicesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTrustedDevicesResponse, error) {
+	rsp, err := c.ListTrustedDevices(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTrustedDevicesResponse(rsp)
+}
+
 // GetOkWithResponse request returning *GetOkResponse
 func (c *ClientWithResponses) GetOkWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOkResponse, error) {
 	rsp, err := c.GetOk(ctx, reqEditors...)
@@ -6745,8 +7662,8 @@ func (c *ClientWithResponses) GetOrganizationGetActiveMemberRoleWithResponse(ctx
 }
 
 // GetOrganizationWithResponse request returning *GetOrganizationResponse
-func (c *ClientWithResponses) GetOrganizationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error) {
-	rsp, err := c.GetOrganization(ctx, reqEditors...)
+func (c *ClientWithResponses) GetOrganizationWithResponse(ctx context.Context, params *GetOrganizationParams, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error) {
+	rsp, err := c.GetOrganization(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -6823,8 +7740,8 @@ func (c *ClientWithResponses) GetOrganizationListWithResponse(ctx context.Contex
 }
 
 // GetOrganizationListInvitationsWithResponse request returning *GetOrganizationListInvitationsResponse
-func (c *ClientWithResponses) GetOrganizationListInvitationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrganizationListInvitationsResponse, error) {
-	rsp, err := c.GetOrganizationListInvitations(ctx, reqEditors...)
+func (c *ClientWithResponses) GetOrganizationListInvitationsWithResponse(ctx context.Context, params *GetOrganizationListInvitationsParams, reqEditors ...RequestEditorFn) (*GetOrganizationListInvitationsResponse, error) {
+	rsp, err := c.GetOrganizationListInvitations(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -6841,8 +7758,8 @@ func (c *ClientWithResponses) GetOrganizationListMembersWithResponse(ctx context
 }
 
 // GetOrganizationListTeamMembersWithResponse request returning *GetOrganizationListTeamMembersResponse
-func (c *ClientWithResponses) GetOrganizationListTeamMembersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrganizationListTeamMembersResponse, error) {
-	rsp, err := c.GetOrganizationListTeamMembers(ctx, reqEditors...)
+func (c *ClientWithResponses) GetOrganizationListTeamMembersWithResponse(ctx context.Context, params *GetOrganizationListTeamMembersParams, reqEditors ...RequestEditorFn) (*GetOrganizationListTeamMembersResponse, error) {
+	rsp, err := c.GetOrganizationListTeamMembers(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -6850,8 +7767,8 @@ func (c *ClientWithResponses) GetOrganizationListTeamMembersWithResponse(ctx con
 }
 
 // GetOrganizationListTeamsWithResponse request returning *GetOrganizationListTeamsResponse
-func (c *ClientWithResponses) GetOrganizationListTeamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrganizationListTeamsResponse, error) {
-	rsp, err := c.GetOrganizationListTeams(ctx, reqEditors...)
+func (c *ClientWithResponses) GetOrganizationListTeamsWithResponse(ctx context.Context, params *GetOrganizationListTeamsParams, reqEditors ...RequestEditorFn) (*GetOrganizationListTeamsResponse, error) {
+	rsp, err := c.GetOrganizationListTeams(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -7106,6 +8023,23 @@ func (c *ClientWithResponses) PostRevokeOtherSessionsWithResponse(ctx context.Co
 	return ParsePostRevokeOtherSessionsResponse(rsp)
 }
 
+// PostRevokePersonalAccessTokenWithBodyWithResponse request with arbitrary body returning *PostRevokePersonalAccessTokenResponse
+func (c *ClientWithResponses) PostRevokePersonalAccessTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRevokePersonalAccessTokenResponse, error) {
+	rsp, err := c.PostRevokePersonalAccessTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRevokePersonalAccessTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRevokePersonalAccessTokenWithResponse(ctx context.Context, body PostRevokePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRevokePersonalAccessTokenResponse, error) {
+	rsp, err := c.PostRevokePersonalAccessToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRevokePersonalAccessTokenResponse(rsp)
+}
+
 // PostRevokeSessionWithBodyWithResponse request with arbitrary body returning *PostRevokeSessionResponse
 func (c *ClientWithResponses) PostRevokeSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRevokeSessionResponse, error) {
 	rsp, err := c.PostRevokeSessionWithBody(ctx, contentType, body, reqEditors...)
@@ -7140,6 +8074,40 @@ func (c *ClientWithResponses) PostRevokeSessionsWithResponse(ctx context.Context
 	return ParsePostRevokeSessionsResponse(rsp)
 }
 
+// PostRevokeTrustedDeviceWithBodyWithResponse request with arbitrary body returning *PostRevokeTrustedDeviceResponse
+func (c *ClientWithResponses) PostRevokeTrustedDeviceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRevokeTrustedDeviceResponse, error) {
+	rsp, err := c.PostRevokeTrustedDeviceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRevokeTrustedDeviceResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRevokeTrustedDeviceWithResponse(ctx context.Context, body PostRevokeTrustedDeviceJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRevokeTrustedDeviceResponse, error) {
+	rsp, err := c.PostRevokeTrustedDevice(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRevokeTrustedDeviceResponse(rsp)
+}
+
+// PostRevokeTrustedDevicesWithBodyWithResponse request with arbitrary body returning *PostRevokeTrustedDevicesResponse
+func (c *ClientWithResponses) PostRevokeTrustedDevicesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRevokeTrustedDevicesResponse, error) {
+	rsp, err := c.PostRevokeTrustedDevicesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRevokeTrustedDevicesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRevokeTrustedDevicesWithResponse(ctx context.Context, body PostRevokeTrustedDevicesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRevokeTrustedDevicesResponse, error) {
+	rsp, err := c.PostRevokeTrustedDevices(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRevokeTrustedDevicesResponse(rsp)
+}
+
 // SendVerificationEmailWithBodyWithResponse request with arbitrary body returning *SendVerificationEmailResponse
 func (c *ClientWithResponses) SendVerificationEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SendVerificationEmailResponse, error) {
 	rsp, err := c.SendVerificationEmailWithBody(ctx, contentType, body, reqEditors...)
@@ -7191,6 +8159,23 @@ func (c *ClientWithResponses) SocialSignInWithResponse(ctx context.Context, body
 	return ParseSocialSignInResponse(rsp)
 }
 
+// CreateSocialSignInNonceWithBodyWithResponse request with arbitrary body returning *CreateSocialSignInNonceResponse
+func (c *ClientWithResponses) CreateSocialSignInNonceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSocialSignInNonceResponse, error) {
+	rsp, err := c.CreateSocialSignInNonceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSocialSignInNonceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSocialSignInNonceWithResponse(ctx context.Context, body CreateSocialSignInNonceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSocialSignInNonceResponse, error) {
+	rsp, err := c.CreateSocialSignInNonce(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSocialSignInNonceResponse(rsp)
+}
+
 // SignOutWithBodyWithResponse request with arbitrary body returning *SignOutResponse
 func (c *ClientWithResponses) SignOutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignOutResponse, error) {
 	rsp, err := c.SignOutWithBody(ctx, contentType, body, reqEditors...)
@@ -7558,6 +8543,91 @@ func ParseChangePasswordResponse(rsp *http.Response) (*ChangePasswordResponse, e
 	return response, nil
 }
 
+// ParseCreatePersonalAccessTokenResponse parses an HTTP response from a CreatePersonalAccessTokenWithResponse call
+func ParseCreatePersonalAccessTokenResponse(rsp *http.Response) (*CreatePersonalAccessTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePersonalAccessTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			PersonalAccessToken PersonalAccessToken `json:"personalAccessToken"`
+
+			// Token The token, which is only returned once
+			Token string `json:"token"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseDeleteUserResponse parses an HTTP response from a DeleteUserWithResponse call
 func ParseDeleteUserResponse(rsp *http.Response) (*DeleteUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -8139,22 +9209,22 @@ func ParseListUserAccountsResponse(rsp *http.Response) (*ListUserAccountsRespons
 	return response, nil
 }
 
-// ParseListUserSessionsResponse parses an HTTP response from a ListUserSessionsWithResponse call
-func ParseListUserSessionsResponse(rsp *http.Response) (*ListUserSessionsResponse, error) {
+// ParseListPersonalAccessTokensResponse parses an HTTP response from a ListPersonalAccessTokensWithResponse call
+func ParseListPersonalAccessTokensResponse(rsp *http.Response) (*ListPersonalAccessTokensResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListUserSessionsResponse{
+	response := &ListPersonalAccessTokensResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Session
+		var dest []PersonalAccessToken
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8219,25 +9289,22 @@ func ParseListUserSessionsResponse(rsp *http.Response) (*ListUserSessionsRespons
 	return response, nil
 }
 
-// ParseGetOkResponse parses an HTTP response from a GetOkWithResponse call
-func ParseGetOkResponse(rsp *http.Response) (*GetOkResponse, error) {
+// ParseListUserSessionsResponse parses an HTTP response from a ListUserSessionsWithResponse call
+func ParseListUserSessionsResponse(rsp *http.Response) (*ListUserSessionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOkResponse{
+	response := &ListUserSessionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			// Ok Indicates if the API is working
-			Ok bool `json:"ok"`
-		}
+		var dest []Session
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8302,25 +9369,22 @@ func ParseGetOkResponse(rsp *http.Response) (*GetOkResponse, error) {
 	return response, nil
 }
 
-// ParsePostOrganizationAcceptInvitationResponse parses an HTTP response from a PostOrganizationAcceptInvitationWithResponse call
-func ParsePostOrganizationAcceptInvitationResponse(rsp *http.Response) (*PostOrganizationAcceptInvitationResponse, error) {
+// ParseListTrustedDevicesResponse parses an HTTP response from a ListTrustedDevicesWithResponse call
+func ParseListTrustedDevicesResponse(rsp *http.Response) (*ListTrustedDevicesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostOrganizationAcceptInvitationResponse{
+	response := &ListTrustedDevicesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Invitation *map[string]interface{} `json:"invitation,omitempty"`
-			Member     *map[string]interface{} `json:"member,omitempty"`
-		}
+		var dest []TrustedDevice
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8385,15 +9449,15 @@ func ParsePostOrganizationAcceptInvitationResponse(rsp *http.Response) (*PostOrg
 	return response, nil
 }
 
-// ParsePostOrganizationAddTeamMemberResponse parses an HTTP response from a PostOrganizationAddTeamMemberWithResponse call
-func ParsePostOrganizationAddTeamMemberResponse(rsp *http.Response) (*PostOrganizationAddTeamMemberResponse, error) {
+// ParseGetOkResponse parses an HTTP response from a GetOkWithResponse call
+func ParseGetOkResponse(rsp *http.Response) (*GetOkResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostOrganizationAddTeamMemberResponse{
+	response := &GetOkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -8401,17 +9465,8 @@ func ParsePostOrganizationAddTeamMemberResponse(rsp *http.Response) (*PostOrgani
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			// CreatedAt Timestamp when the team member was created
-			CreatedAt time.Time `json:"createdAt"`
-
-			// Id Unique identifier of the team member
-			Id string `json:"id"`
-
-			// TeamId The team ID of the team the team member is in
-			TeamId string `json:"teamId"`
-
-			// UserId The user ID of the team member
-			UserId string `json:"userId"`
+			// Ok Indicates if the API is working
+			Ok bool `json:"ok"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -8477,20 +9532,30 @@ func ParsePostOrganizationAddTeamMemberResponse(rsp *http.Response) (*PostOrgani
 	return response, nil
 }
 
-// ParsePostOrganizationCancelInvitationResponse parses an HTTP response from a PostOrganizationCancelInvitationWithResponse call
-func ParsePostOrganizationCancelInvitationResponse(rsp *http.Response) (*PostOrganizationCancelInvitationResponse, error) {
+// ParsePostOrganizationAcceptInvitationResponse parses an HTTP response from a PostOrganizationAcceptInvitationWithResponse call
+func ParsePostOrganizationAcceptInvitationResponse(rsp *http.Response) (*PostOrganizationAcceptInvitationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostOrganizationCancelInvitationResponse{
+	response := &PostOrganizationAcceptInvitationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Invitation *Invitation `json:"invitation,omitempty"`
+			Member     *Member     `json:"member,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Message string `json:"message"`
@@ -8550,20 +9615,39 @@ func ParsePostOrganizationCancelInvitationResponse(rsp *http.Response) (*PostOrg
 	return response, nil
 }
 
-// ParsePostOrganizationCheckSlugResponse parses an HTTP response from a PostOrganizationCheckSlugWithResponse call
-func ParsePostOrganizationCheckSlugResponse(rsp *http.Response) (*PostOrganizationCheckSlugResponse, error) {
+// ParsePostOrganizationAddTeamMemberResponse parses an HTTP response from a PostOrganizationAddTeamMemberWithResponse call
+func ParsePostOrganizationAddTeamMemberResponse(rsp *http.Response) (*PostOrganizationAddTeamMemberResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostOrganizationCheckSlugResponse{
+	response := &PostOrganizationAddTeamMemberResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// CreatedAt Timestamp when the team member was created
+			CreatedAt time.Time `json:"createdAt"`
+
+			// Id Unique identifier of the team member
+			Id string `json:"id"`
+
+			// TeamId The team ID of the team the team member is in
+			TeamId string `json:"teamId"`
+
+			// UserId The user ID of the team member
+			UserId string `json:"userId"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Message string `json:"message"`
@@ -8623,22 +9707,22 @@ func ParsePostOrganizationCheckSlugResponse(rsp *http.Response) (*PostOrganizati
 	return response, nil
 }
 
-// ParsePostOrganizationCreateResponse parses an HTTP response from a PostOrganizationCreateWithResponse call
-func ParsePostOrganizationCreateResponse(rsp *http.Response) (*PostOrganizationCreateResponse, error) {
+// ParsePostOrganizationCancelInvitationResponse parses an HTTP response from a PostOrganizationCancelInvitationWithResponse call
+func ParsePostOrganizationCancelInvitationResponse(rsp *http.Response) (*PostOrganizationCancelInvitationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostOrganizationCreateResponse{
+	response := &PostOrganizationCancelInvitationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Organization
+		var dest Invitation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8703,15 +9787,15 @@ func ParsePostOrganizationCreateResponse(rsp *http.Response) (*PostOrganizationC
 	return response, nil
 }
 
-// ParsePostOrganizationCreateTeamResponse parses an HTTP response from a PostOrganizationCreateTeamWithResponse call
-func ParsePostOrganizationCreateTeamResponse(rsp *http.Response) (*PostOrganizationCreateTeamResponse, error) {
+// ParsePostOrganizationCheckSlugResponse parses an HTTP response from a PostOrganizationCheckSlugWithResponse call
+func ParsePostOrganizationCheckSlugResponse(rsp *http.Response) (*PostOrganizationCheckSlugResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostOrganizationCreateTeamResponse{
+	response := &PostOrganizationCheckSlugResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -8719,20 +9803,7 @@ func ParsePostOrganizationCreateTeamResponse(rsp *http.Response) (*PostOrganizat
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			// CreatedAt Timestamp when the team was created
-			CreatedAt time.Time `json:"createdAt"`
-
-			// Id Unique identifier of the created team
-			Id string `json:"id"`
-
-			// Name Name of the team
-			Name string `json:"name"`
-
-			// OrganizationId ID of the organization the team belongs to
-			OrganizationId string `json:"organizationId"`
-
-			// UpdatedAt Timestamp when the team was last updated
-			UpdatedAt time.Time `json:"updatedAt"`
+			Status *bool `json:"status,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -8798,22 +9869,197 @@ func ParsePostOrganizationCreateTeamResponse(rsp *http.Response) (*PostOrganizat
 	return response, nil
 }
 
-// ParsePostOrganizationDeleteResponse parses an HTTP response from a PostOrganizationDeleteWithResponse call
-func ParsePostOrganizationDeleteResponse(rsp *http.Response) (*PostOrganizationDeleteResponse, error) {
+// ParsePostOrganizationCreateResponse parses an HTTP response from a PostOrganizationCreateWithResponse call
+func ParsePostOrganizationCreateResponse(rsp *http.Response) (*PostOrganizationCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostOrganizationDeleteResponse{
+	response := &PostOrganizationCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest string
+		var dest Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostOrganizationCreateTeamResponse parses an HTTP response from a PostOrganizationCreateTeamWithResponse call
+func ParsePostOrganizationCreateTeamResponse(rsp *http.Response) (*PostOrganizationCreateTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOrganizationCreateTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// CreatedAt Timestamp when the team was created
+			CreatedAt time.Time `json:"createdAt"`
+
+			// Id Unique identifier of the created team
+			Id string `json:"id"`
+
+			// Name Name of the team
+			Name string `json:"name"`
+
+			// OrganizationId ID of the organization the team belongs to
+			OrganizationId string `json:"organizationId"`
+
+			// UpdatedAt Timestamp when the team was last updated
+			UpdatedAt time.Time `json:"updatedAt"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostOrganizationDeleteResponse parses an HTTP response from a PostOrganizationDeleteWithResponse call
+func ParsePostOrganizationDeleteResponse(rsp *http.Response) (*PostOrganizationDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOrganizationDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest string
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8977,6 +10223,15 @@ func ParseGetOrganizationGetActiveMemberRoleResponse(rsp *http.Response) (*GetOr
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Role string `json:"role"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Message string `json:"message"`
@@ -9393,6 +10648,20 @@ func ParsePostOrganizationLeaveResponse(rsp *http.Response) (*PostOrganizationLe
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Member struct {
+				Id             string `json:"id"`
+				OrganizationId string `json:"organizationId"`
+				Role           string `json:"role"`
+				UserId         string `json:"userId"`
+			} `json:"member"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Message string `json:"message"`
@@ -9546,6 +10815,13 @@ func ParseGetOrganizationListInvitationsResponse(rsp *http.Response) (*GetOrgani
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Invitation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Message string `json:"message"`
@@ -10056,7 +11332,7 @@ func ParsePostOrganizationRejectInvitationResponse(rsp *http.Response) (*PostOrg
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Invitation *map[string]interface{} `json:"invitation,omitempty"`
+			Invitation *Invitation             `json:"invitation,omitempty"`
 			Member     *map[string]interface{} `json:"member"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11206,15 +12482,15 @@ func ParsePostRevokeOtherSessionsResponse(rsp *http.Response) (*PostRevokeOtherS
 	return response, nil
 }
 
-// ParsePostRevokeSessionResponse parses an HTTP response from a PostRevokeSessionWithResponse call
-func ParsePostRevokeSessionResponse(rsp *http.Response) (*PostRevokeSessionResponse, error) {
+// ParsePostRevokePersonalAccessTokenResponse parses an HTTP response from a PostRevokePersonalAccessTokenWithResponse call
+func ParsePostRevokePersonalAccessTokenResponse(rsp *http.Response) (*PostRevokePersonalAccessTokenResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostRevokeSessionResponse{
+	response := &PostRevokePersonalAccessTokenResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -11222,7 +12498,7 @@ func ParsePostRevokeSessionResponse(rsp *http.Response) (*PostRevokeSessionRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			// Status Indicates if the session was revoked successfully
+			// Status Indicates if the personal access token was revoked successfully
 			Status bool `json:"status"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11289,15 +12565,15 @@ func ParsePostRevokeSessionResponse(rsp *http.Response) (*PostRevokeSessionRespo
 	return response, nil
 }
 
-// ParsePostRevokeSessionsResponse parses an HTTP response from a PostRevokeSessionsWithResponse call
-func ParsePostRevokeSessionsResponse(rsp *http.Response) (*PostRevokeSessionsResponse, error) {
+// ParsePostRevokeSessionResponse parses an HTTP response from a PostRevokeSessionWithResponse call
+func ParsePostRevokeSessionResponse(rsp *http.Response) (*PostRevokeSessionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostRevokeSessionsResponse{
+	response := &PostRevokeSessionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -11305,7 +12581,7 @@ func ParsePostRevokeSessionsResponse(rsp *http.Response) (*PostRevokeSessionsRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			// Status Indicates if all sessions were revoked successfully
+			// Status Indicates if the session was revoked successfully
 			Status bool `json:"status"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11372,15 +12648,15 @@ func ParsePostRevokeSessionsResponse(rsp *http.Response) (*PostRevokeSessionsRes
 	return response, nil
 }
 
-// ParseSendVerificationEmailResponse parses an HTTP response from a SendVerificationEmailWithResponse call
-func ParseSendVerificationEmailResponse(rsp *http.Response) (*SendVerificationEmailResponse, error) {
+// ParsePostRevokeSessionsResponse parses an HTTP response from a PostRevokeSessionsWithResponse call
+func ParsePostRevokeSessionsResponse(rsp *http.Response) (*PostRevokeSessionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SendVerificationEmailResponse{
+	response := &PostRevokeSessionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -11388,8 +12664,8 @@ func ParseSendVerificationEmailResponse(rsp *http.Response) (*SendVerificationEm
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			// Status Indicates if the email was sent successfully
-			Status *bool `json:"status,omitempty"`
+			// Status Indicates if all sessions were revoked successfully
+			Status bool `json:"status"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -11398,8 +12674,7 @@ func ParseSendVerificationEmailResponse(rsp *http.Response) (*SendVerificationEm
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
-			// Message Error message
-			Message *string `json:"message,omitempty"`
+			Message string `json:"message"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -11456,15 +12731,15 @@ func ParseSendVerificationEmailResponse(rsp *http.Response) (*SendVerificationEm
 	return response, nil
 }
 
-// ParseSignInEmailResponse parses an HTTP response from a SignInEmailWithResponse call
-func ParseSignInEmailResponse(rsp *http.Response) (*SignInEmailResponse, error) {
+// ParsePostRevokeTrustedDeviceResponse parses an HTTP response from a PostRevokeTrustedDeviceWithResponse call
+func ParsePostRevokeTrustedDeviceResponse(rsp *http.Response) (*PostRevokeTrustedDeviceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SignInEmailResponse{
+	response := &PostRevokeTrustedDeviceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -11472,12 +12747,8 @@ func ParseSignInEmailResponse(rsp *http.Response) (*SignInEmailResponse, error)
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Redirect bool `json:"redirect"`
-
-			// Token Session token
-			Token string  `json:"token"`
-			Url   *string `json:"url"`
-			User  User    `json:"user"`
+			// Status Indicates if the trusted device was revoked successfully
+			Status bool `json:"status"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -11543,15 +12814,15 @@ func ParseSignInEmailResponse(rsp *http.Response) (*SignInEmailResponse, error)
 	return response, nil
 }
 
-// ParseSocialSignInResponse parses an HTTP response from a SocialSignInWithResponse call
-func ParseSocialSignInResponse(rsp *http.Response) (*SocialSignInResponse, error) {
+// ParsePostRevokeTrustedDevicesResponse parses an HTTP response from a PostRevokeTrustedDevicesWithResponse call
+func ParsePostRevokeTrustedDevicesResponse(rsp *http.Response) (*PostRevokeTrustedDevicesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SocialSignInResponse{
+	response := &PostRevokeTrustedDevicesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -11559,10 +12830,352 @@ func ParseSocialSignInResponse(rsp *http.Response) (*SocialSignInResponse, error
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Redirect bool    `json:"redirect"`
-			Token    string  `json:"token"`
-			Url      *string `json:"url,omitempty"`
-			User     User    `json:"user"`
+			// Status Indicates if all trusted devices were revoked successfully
+			Status bool `json:"status"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSendVerificationEmailResponse parses an HTTP response from a SendVerificationEmailWithResponse call
+func ParseSendVerificationEmailResponse(rsp *http.Response) (*SendVerificationEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SendVerificationEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Status Indicates if the email was sent successfully
+			Status *bool `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			// Message Error message
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSignInEmailResponse parses an HTTP response from a SignInEmailWithResponse call
+func ParseSignInEmailResponse(rsp *http.Response) (*SignInEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SignInEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Redirect bool `json:"redirect"`
+
+			// Token Session token
+			Token string  `json:"token"`
+			Url   *string `json:"url"`
+			User  User    `json:"user"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSocialSignInResponse parses an HTTP response from a SocialSignInWithResponse call
+func ParseSocialSignInResponse(rsp *http.Response) (*SocialSignInResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SocialSignInResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Redirect bool   `json:"redirect"`
+			Token    string `json:"token"`
+
+			// TwoFactorPending The session is pending until the second factor is verified
+			TwoFactorPending *bool   `json:"twoFactorPending,omitempty"`
+			Url              *string `json:"url,omitempty"`
+			User             User    `json:"user"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSocialSignInNonceResponse parses an HTTP response from a CreateSocialSignInNonceWithResponse call
+func ParseCreateSocialSignInNonceResponse(rsp *http.Response) (*CreateSocialSignInNonceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSocialSignInNonceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			ExpiresAt time.Time `json:"expiresAt"`
+
+			// Nonce Nonce to request the ID token with
+			Nonce string `json:"nonce"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -69,15 +69,31 @@ type Organization struct {
 	Slug      string    `json:"slug"`
 }
 
+// PersonalAccessToken defines model for PersonalAccessToken.
+type PersonalAccessToken struct {
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	Id         string     `json:"id"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	Name       string     `json:"name"`
+
+	// OrganizationId The organization the token is limited to, it is revoked when the user leaves the organization
+	OrganizationId *string `json:"organizationId,omitempty"`
+
+	// Prefix The visible prefix of the token to recognize it
+	Prefix string   `json:"prefix"`
+	Scopes []string `json:"scopes"`
+	UserId string   `json:"userId"`
+}
+
 // Session defines model for Session.
 type Session struct {
 	ActiveOrganizationId *string   `json:"activeOrganizationId,omitempty"`
 	ActiveTeamId         *string   `json:"activeTeamId,omitempty"`
 	CreatedAt            time.Time `json:"createdAt"`
 	ExpiresAt            time.Time `json:"expiresAt"`
-	Id                   *string   `json:"id,omitempty"`
+	Id                   string    `json:"id"`
 	IpAddress            *string   `json:"ipAddress,omitempty"`
-	Token                string    `json:"token"`
 	UpdatedAt            time.Time `json:"updatedAt"`
 	UserAgent            *string   `json:"userAgent,omitempty"`
 	UserId               string    `json:"userId"`
@@ -100,6 +116,17 @@ type TeamMember struct {
 	UserId    string     `json:"userId"`
 }
 
+// TrustedDevice defines model for TrustedDevice.
+type TrustedDevice struct {
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	Id         string     `json:"id"`
+	IpAddress  *string    `json:"ipAddress,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	UserAgent  *string    `json:"userAgent,omitempty"`
+	UserId     string     `json:"userId"`
+}
+
 // User defines model for User.
 type User struct {
 	CreatedAt     time.Time `json:"createdAt"`
@@ -142,6 +169,21 @@ type ChangePasswordJSONBody struct {
 	RevokeOtherSessions *bool `json:"revokeOtherSessions,omitempty"`
 }
 
+// CreatePersonalAccessTokenJSONBody defines parameters for CreatePersonalAccessToken.
+type CreatePersonalAccessTokenJSONBody struct {
+	// ExpiresIn The lifetime of the token in seconds, tokens without it do not expire
+	ExpiresIn *int `json:"expiresIn,omitempty"`
+
+	// Name The name of the token
+	Name string `json:"name"`
+
+	// OrganizationId The organization to limit the token to, the user has to be a member
+	OrganizationId *string `json:"organizationId,omitempty"`
+
+	// Scopes The scopes of the token. Eg: "repo:read"
+	Scopes []string `json:"scopes"`
+}
+
 // DeleteUserJSONBody defines parameters for DeleteUser.
 type DeleteUserJSONBody struct {
 	// CallbackURL The callback URL to redirect to after the user is deleted
@@ -261,6 +303,12 @@ type PostOrganizationDeleteJSONBody struct {
 	OrganizationId string `json:"organizationId"`
 }
 
+// GetOrganizationParams defines parameters for GetOrganization.
+type GetOrganizationParams struct {
+	OrganizationId   *string `form:"organizationId,omitempty" json:"organizationId,omitempty"`
+	OrganizationSlug *string `form:"organizationSlug,omitempty" json:"organizationSlug,omitempty"`
+}
+
 // GetOrganizationGetInvitationParams defines parameters for GetOrganizationGetInvitation.
 type GetOrganizationGetInvitationParams struct {
 	Id *string `form:"id,omitempty" json:"id,omitempty"`
@@ -288,8 +336,10 @@ type CreateOrganizationInvitationJSONBody struct {
 	Resend *bool `json:"resend,omitempty"`
 
 	// Role The role(s) to assign to the user. It can be `admin`, `member`, owner. Eg: "member"
-	Role   string `json:"role"`
-	TeamId string `json:"teamId"`
+	Role string `json:"role"`
+
+	// TeamId The optional team ID to add the user to once the invitation is accepted
+	TeamId *string `json:"teamId,omitempty"`
 }
 
 // PostOrganizationLeaveJSONBody defines parameters for PostOrganizationLeave.
@@ -298,6 +348,21 @@ type PostOrganizationLeaveJSONBody struct {
 	OrganizationId string `json:"organizationId"`
 }
 
+// GetOrganizationListInvitationsParams defines parameters for GetOrganizationListInvitations.
+type GetOrganizationListInvitationsParams struct {
+	OrganizationId *string `form:"organizationId,omitempty" json:"organizationId,omitempty"`
+}
+
+// GetOrganizationListTeamMembersParams defines parameters for GetOrganizationListTeamMembers.
+type GetOrganizationListTeamMembersParams struct {
+	TeamId *string `form:"teamId,omitempty" json:"teamId,omitempty"`
+}
+
+// GetOrganizationListTeamsParams defines parameters for GetOrganizationListTeams.
+type GetOrganizationListTeamsParams struct {
+	OrganizationId *string `form:"organizationId,omitempty" json:"organizationId,omitempty"`
+}
+
 // PostOrganizationRejectInvitationJSONBody defines parameters for PostOrganizationRejectInvitation.
 type PostOrganizationRejectInvitationJSONBody struct {
 	// InvitationId The ID of the invitation to reject
@@ -429,15 +494,30 @@ type ResetPasswordCallbackParams struct {
 // PostRevokeOtherSessionsJSONBody defines parameters for PostRevokeOtherSessions.
 type PostRevokeOtherSessionsJSONBody = map[string]interface{}
 
+// PostRevokePersonalAccessTokenJSONBody defines parameters for PostRevokePersonalAccessToken.
+type PostRevokePersonalAccessTokenJSONBody struct {
+	// Id The ID of the personal access token to revoke
+	Id string `json:"id"`
+}
+
 // PostRevokeSessionJSONBody defines parameters for PostRevokeSession.
 type PostRevokeSessionJSONBody struct {
-	// Token The token to revoke
-	Token string `json:"token"`
+	// Id The ID of the session to revoke
+	Id string `json:"id"`
 }
 
 // PostRevokeSessionsJSONBody defines parameters for PostRevokeSessions.
 type PostRevokeSessionsJSONBody = map[string]interface{}
 
+// PostRevokeTrustedDeviceJSONBody defines parameters for PostRevokeTrustedDevice.
+type PostRevokeTrustedDeviceJSONBody struct {
+	// Id The ID of the trusted device to revoke
+	Id string `json:"id"`
+}
+
+// PostRevokeTrustedDevicesJSONBody defines parameters for PostRevokeTrustedDevices.
+type PostRevokeTrustedDevicesJSONBody = map[string]interface{}
+
 // SendVerificationEmailJSONBody defines parameters for SendVerificationEmail.
 type SendVerificationEmailJSONBody struct {
 	// CallbackURL The URL to use for email verification callback
@@ -476,10 +556,10 @@ type SocialSignInJSONBody struct {
 		// AccessToken Access token from the provider
 		AccessToken *string `json:"accessToken,omitempty"`
 
-		// ExpiresAt Expiry date of the token
-		ExpiresAt *float32 `json:"expiresAt,omitempty"`
+		// ExpiresAt Expiry date of the token in seconds since the epoch
+		ExpiresAt *float64 `json:"expiresAt,omitempty"`
 
-		// Nonce Nonce used to generate the token
+		// Nonce Nonce used to generate the token, which has been issued by /sign-in/social/nonce
 		Nonce *string `json:"nonce,omitempty"`
 
 		// RefreshToken Refresh token from the provider
@@ -501,6 +581,11 @@ type SocialSignInJSONBody struct {
 	Scopes *[]interface{} `json:"scopes,omitempty"`
 }
 
+// CreateSocialSignInNonceJSONBody defines parameters for CreateSocialSignInNonce.
+type CreateSocialSignInNonceJSONBody struct {
+	Provider string `json:"provider"`
+}
+
 // SignOutJSONBody defines parameters for SignOut.
 type SignOutJSONBody = map[string]interface{}
 
@@ -555,6 +640,9 @@ type ChangeEmailJSONRequestBody ChangeEmailJSONBody
 // ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
 type ChangePasswordJSONRequestBody ChangePasswordJSONBody
 
+// CreatePersonalAccessTokenJSONRequestBody defines body for CreatePersonalAccessToken for application/json ContentType.
+type CreatePersonalAccessTokenJSONRequestBody CreatePersonalAccessTokenJSONBody
+
 // DeleteUserJSONRequestBody defines body for DeleteUser for application/json ContentType.
 type DeleteUserJSONRequestBody DeleteUserJSONBody
 
@@ -633,12 +721,21 @@ type ResetPasswordJSONRequestBody ResetPasswordJSONBody
 // PostRevokeOtherSessionsJSONRequestBody defines body for PostRevokeOtherSessions for application/json ContentType.
 type PostRevokeOtherSessionsJSONRequestBody = PostRevokeOtherSessionsJSONBody
 
+// PostRevokePersonalAccessTokenJSONRequestBody defines body for PostRevokePersonalAccessToken for application/json ContentType.
+type PostRevokePersonalAccessTokenJSONRequestBody PostRevokePersonalAccessTokenJSONBody
+
 // PostRevokeSessionJSONRequestBody defines body for PostRevokeSession for application/json ContentType.
 type PostRevokeSessionJSONRequestBody PostRevokeSessionJSONBody
 
 // PostRevokeSessionsJSONRequestBody defines body for PostRevokeSessions for application/json ContentType.
 type PostRevokeSessionsJSONRequestBody = PostRevokeSessionsJSONBody
 
+// PostRevokeTrustedDeviceJSONRequestBody defines body for PostRevokeTrustedDevice for application/json ContentType.
+type PostRevokeTrustedDeviceJSONRequestBody PostRevokeTrustedDeviceJSONBody
+
+// PostRevokeTrustedDevicesJSONRequestBody defines body for PostRevokeTrustedDevices for application/json ContentType.
+type PostRevokeTrustedDevicesJSONRequestBody = PostRevokeTrustedDevicesJSONBody
+
 // SendVerificationEmailJSONRequestBody defines body for SendVerificationEmail for application/json ContentType.
 type SendVerificationEmailJSONRequestBody SendVerificationEmailJSONBody
 
@@ -648,6 +745,9 @@ type SignInEmailJSONRequestBody SignInEmailJSONBody
 // SocialSignInJSONRequestBody defines body for SocialSignIn for application/json ContentType.
 type SocialSignInJSONRequestBody SocialSignInJSONBody
 
+// CreateSocialSignInNonceJSONRequestBody defines body for CreateSocialSignInNonce for application/json ContentType.
+type CreateSocialSignInNonceJSONRequestBody CreateSocialSignInNonceJSONBody
+
 // SignOutJSONRequestBody defines body for SignOut for application/json ContentType.
 type SignOutJSONRequestBody = SignOutJSONBody
 
@@ -663,129 +763,144 @@ type UpdateUserJSONRequestBody UpdateUserJSONBody
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9e28cN7LvVyHmXiD3AhrJm937xxWwOEexvFkhTmzo4cVBYhxT0zUzXPWQHZIteTbw",
-	"dz/gq5vdzX7MaCR5k/rL1jSbXXz+flUsVv02W4hNIThwrWanv83UYg0bav97tliIkmvz30KKAqRmYB/Q",
-	"xQKUuhZ3wM2felvA7HSmtGR8NftyFD9/87lgEtSZrWUp5Ibq2eksoxrmmm1gdpR823z2IkvWvZBANWSu",
-	"wgyWtMxNjd8DB2keEKqJLLmvfNoXWfpTLOtvYkGVehAy/WIhxT3LQPY0QcJSglr3Vx4X2KMD1UKYXxIV",
-	"l0VWd960ykrV0w4r568lk5DNTn+ORq3R/qqCeORiQT5W3xS3/4SFNt+84PdMU80E7869pxh/2FCWJzsM",
-	"du/9vslk2tQ7JYRcUc7+ZdvcN2tEnh5UpakuVbM7CuCZeZyQTwPdTBnQlkihl6rvxZ3THNy6qanB/RE2",
-	"tyBHBvZRXb1DZ9Y9tnFiPWIFdDqsmvn2a3EfpfrlXfT6U/ZOLlYi+WADmmZU0+RDTjc9sy8vV+N9Y1/3",
-	"hcc64gqUSvYBXWh2D+/GR9cVvO6b6E8DIgfcKoqzLJOgVPKp7oWNPXf3sxU4kN975scbgROvb7ev6kwN",
-	"vBmwp5z5vXN4woaxc9+mF0BnjxheCqZHnn6/1P0LZeoM8FUMDu+NGm3G0+O5efIBJFsyyBpfXNJcgWkU",
-	"zd7xfDs71bKEqu5bIXKgfGjRbugKdpt2jTl1kKanJ10A7ukEzPXQ4jkp2OF2zwy4NuMrn6nTj2b3NC9h",
-	"fJFEkoV3+knU0AAZ2IVFKZneXhl1zcNjwX6A7Wsh7pjnNmohWeHGcHb2/oL8AFtCS702YrjBJfeMkoV7",
-	"xRC32ems+svN22a1lSjuV9P4W6AS5Fmp192PfmefEYsJrS8bNmBlN6vLFqsrX2tdzL58sax5KdJtuYQl",
-	"SOALIEshyVaUkvyN3YIk3wu9JhdcacoXVmCmDdebRU/P3l+YEQDpeMbsT8evjl9ZICiA04LNTmd/Pn51",
-	"/BejylC9tr174hWceRBpBbor2fegiV4D8YWJKUy8OpSR2619GNSjmf2grGDHvO117gvzETN7VCG4cuP7",
-	"7atXdvUJrj1g06LIfXee/FO5per0d/s0y5h5RPP30fL1+1xzRQfal36lsRPWm0SZ3M932Hufbm/tLLxZ",
-	"+/PJRRW/Y1t35HomUfjLUWvkr0pr9DBC/WXHgWp24AaUSje3JWEoOEW672hGLuHXEpQ+JjeqpHm+JVkJ",
-	"RAuyYUoxviIFlXQDGqQ6IkISxu9pzrLo52PXuD99bY274WZrEZL9C7Jjct5sVdSS5gbkW/Pnp2rNqNh/",
-	"E/KWZRnwY/JfoiSZIFxosqb3QAqQVn7BTVOcOY3oNVNEghKlXIBplxamoIEo98yoPVW7/vJi7fpJaPI3",
-	"UfLsmFyvgUg37SCrZX+gyrZ1aUtZeb/9/y8m77UQ5EfKt2GBKDcediDg8wLAbN1m3zb8gORsw/QxuZZb",
-	"QleUcZJTDdI24v893bofbcQF1yA5zckVyHuQ5I2UQpoBYIqYqWFA5zaHDXlgem1bo1xBvabawCdZUG7H",
-	"hH0+bjCM2enPvzVA/uePX8yqpCtlFuq5p1AfzTsnizXlK5hXIFAIZTuiiXOvbak3npb6CfKdyLaP6L4F",
-	"zfNburi7uXzbhWUzD28u35oVIyFjEhbaLqylBkmssOQ+prwJjsfh4U1oVbdyDg++HupUd1O9Ak02pdLk",
-	"Fgglbg9qFBon8OGj6W2wLmog+ssj+ULvdGwBnTX/Ef+ciKWdTa5dbvjNXFu45vEyz+mt4V8NEpE2Xjbn",
-	"c2YkBUWY+4CfJXbvUA5rl2U+S2logZj8bwnL2ensf53UJxwn/njjxCqj7e72wkzBnDdxe4Nsvt2QRRLm",
-	"W6QESAmQEuxBCb59MXlvuF/KZu8ib7hmentM3JqnuQSabQl8ZkorJC+/O/ISH+0G/tKU1/EXp8v7wgEH",
-	"vdqY4jvvQ70HozyllMD1+0jeLjPxhWpB7Y7hd9U0zxmu0FCdqjLHclL1SLgXd/BOr0H6A50Eyv9YsSMP",
-	"3ySYxdqo3iVGUXe2O+L52VJ1KNPa9+CBKAi79h1ww2aE6ZPwsyIPIM2OaHorm8KYyilm9FiIf6yBV3PT",
-	"brS+8O729O5saJLe5iKoKg8G6Cnm+Jbktq9qgrmmitwCcE/X4yncMSN1hS05+7UEUtthWwL3G566dRVS",
-	"LFkOxBaxykVP40vJpgxrsGQlFhzdwJigLav28PDnVGni39jvYIHF/gDxKcOHelh2MGZ37G5TKF5Y7Q2u",
-	"7Rl5hpwbOTdybjTD/UGZbAY5aJhXVCHJYs9toX7W6p7fuEfPZKQLBfqtdRWSMUVcM5M0thjksKaCb1RF",
-	"Y4/tnDN7nuFngbAxvzokqHXSkS7N+Uz9VjB7vBpZFUlwjZkwr17UlldJX9vxunY7f9w1brirJtW46a5t",
-	"jvPfONoNhszkaDcBTXJID5AeID1AetCiBycBcXtdWl4HSNaCLMSmsKShbGyyVtok1HX8W2pSEeq1TjZh",
-	"87LNsG5Iv5Ygt7UXUqiwHpcu6joLixZOlG0Ty/wET8Fv+osxVxn+bi9XCR9PfPTj8wD8a8GXzKj3pgtC",
-	"qUfBeT3oz4fmDQ0/kD6EcIRwhHCE8D8mhIP5bC9mnzNV5HSrCOXEliSF2/g7eGzFH/c01fBZn6z1Jm92",
-	"bBcL/37941viX6x8Q2IJ2l2OjpS4v+P+jvs77u+N/X0Feu6m67yyM6bNuN+DrrwLwwQ3bxyRTJh1S4m/",
-	"Tm4YPLeD0AGC90Jpd/mgutd/KJNv4zZ/Fy/CRYmLc0KVEgtm7+BUfRtE7zGdtq/aJ49p7XPzgaVw5uN3",
-	"pvvjWxgD197StuM+cX1rRs9PI6mf31fhaaM3DAVNeOrAB3aWXG+Lfdf+GfnQWUYRD2lvFG7NNaaoQZNq",
-	"yi2M8r0q3TpDyEfIR8hHyB+GfFXHPhi8Xxi8+kL5hGZ3VT16FHa0HZeaHR/JO+RtH2R5lHN+1Z7JLkKo",
-	"SiKuIK4grvyxcSVn/G5udZW8X4t8y/gdocQVq7QyLfrdg8wbV7b4WaX1HEhlrG6hn/eFI9r/tl/lP7Sm",
-	"iii24pARllQtM2avf1z6KpJWVns/hJZabKhmi+pzfpOIL/ubLR6WZW610DXlWW52Qqfj1i9tRSkV5Muk",
-	"W7O1o77eo+Xu6E6CnXXBIpyVMkhgZsiQs0+k0+2mSnLBF7CfKmiD2MURkKiUdDsUAKkdksYWS0U3qTT/",
-	"tGB2Cl+xFb8p0lEKatFa6mM1b4kr4sbB3Q1cSrFpR39otqzHRvACFgLZO+U7x8F2Kam1KPOM3NZzGbIw",
-	"/wMNcEfQ7jA90aXVNdDEdU7Zc/egU3Vz3gfptBi1x1QNRlaJrBJZJbJKZJXjrFLZEwrD/FSvveItU5rQ",
-	"vGKUynKNGh16mKXSNwrkWaj9keDGNGzUyMnEcIjIRwUkG4kGXLOJSs6uYbtFgZ4wji/rBPDti+QYR/yt",
-	"Qp361qRoV4vtIK4iriKuIq4irnZxVUU35sdwVbN7qG+ThzPuQWCtLuQfClgn2v4RARABEAEQARABhhBA",
-	"DNzFWcPiLpjezt5fGLEehLxzqR46R8Dv7mYHtQs6yUYsgh2xRu6FiLtJG1SrWoQIhAiECISIPyhERGkk",
-	"Tsy8LfScNZMmJU95z2xRQjmpS9upz0lcZdI/OM674uqJ0jQd6ui3FqvP/fbiPFwraTXBijQeuSj+wPOf",
-	"rDUHqWMi21S5PrpBkvBcCqERoRGhEaGxBY2NxGkpfMyyuQa6mde7axodfXTLfBsCJJIqKdwIHGZZlKnp",
-	"UFhYp2VKhDoAukn5X1AvMhHL4/0utmTkYc0WayKhkKCAaxV7UthvZGY6UlV97HgUdcfTQx0eeHs6rRrS",
-	"yfEzr9kGlKabgjyEUIpRTXsF1EwFqLzpC07ZFHsge1dPi2vGVM2aWH6zPPm+l6CmiJg6YKzOC6uZMZQI",
-	"LbFjRg0IixUDPCEJQhKEJAhJUIIELShfQN5jJBhmN6/tq1+Vsu9a8/TKPkIIQghCCEIIQoiBkDUs7uYh",
-	"3/dE7DDvXLms34cBjfD9LljEwhJTzCKFEeCYvFmdkl9mm+1cyNUvs1HgsB9BwEDAQMBAwEDA2BcwrGFm",
-	"II2Sfb7z4aN77WCAcgdQvHbhFM6sM2WjWf05aQQxbzaiMXhnzAYQ+d/c5VPbIy4oE4cHIjg4aDJQkrwX",
-	"l4uVSKOdeRKUo1b3dSx5G9A0pHju1hSeTq1tWqaasVr6cdxC98RaRm2ULEtV5QbCrJKLpd2OQoruo8Z4",
-	"uow5LM/Jra0uOyZXztQueL4NP5LbLaHZhnFldmZrI17QPK/zfbvlduz/nZt3AyMxX5izbAIl8Vl29mYm",
-	"u5rPh1x6GysET6KRECEhQkKEhGgiIbKH0eOsyDIEe7BmxGR8T550bT52KK40DfqN0JHKbf5MAdzRLG5O",
-	"H4o3YPvi3J9OV4eOAZ3DISDjx8T7yqkqBEGXlgXx4t92wOHndxzb43z6yQ+mQ59rN8UmEsWfWjNln4lR",
-	"nws0pkfV9FvIBV+pZOiHwWSJQ315oLSJnsi1mrhDvsT0YTiegiMBQwKGBAwJ2AABc7ljRlMi7sq03GsH",
-	"Y1k70yJm42lkQYrhzaxV+8u44nXENwNvVmJvSke0MiDIIcghyCHIjYGcSx1hVN7I430wmrR3pM1AU5ZX",
-	"WfwTanPyknH03OaQMG9FrvCHuzuVjp/URctuTEeRp6NQ7hQUqfJZ7qhutn4MHYiQhZCFkIWQdQDImoct",
-	"2+PWLqhzaV5FvyzcbXG3xd0Wd9v0brss83wuWp5Og0qCeWMnZWCGHii49ePWj1s/bv1f1dbfvAPYu+c3",
-	"gwTdbsnF+QTzT+OaYL1ZWbGZqfrXEuQ2HIOfOsvK0ElB3xVAI3f3kODjQS1OsKEsTxqOIE5OOTUQuG0A",
-	"yDe9tfoCF3sbuuIiP3nvi8FCV94Ld7rJrJOpY8hk5jrQ15YwnNUNriqO+zbRoIT4rY5FOxzSA6QHSA+Q",
-	"HuxOD9ZUzevpPOCjGsehrbKpRW+OeUz8nar3cenDOE40Rc+gkLCw3no+d2kiPXhj7drLorNUxrCqmOpJ",
-	"Mz6lnnaCr6jS1M55WAdSm/QtjegeDBPJt9rXYn1JhFiEWIRYhFiE2N0h1ukqo7EI67uxu0Xqde/FQjxB",
-	"4J5KL+4CoX1EaJZJs0TFshE80LX9UDdBqgqHUz1aFAWeqPTS/t62LdgWHDXIjZkwuQSabf0Xs5Fru0F7",
-	"7jbCPPk/6v/aoVSKrXicCe2YXGgz78gtkE/2SumnI/LJTZVPR0Q8cFPGX6yxv6bv1dQhAYdBpaWe+9de",
-	"+HJL19byJFaYi8N7Ez2vaWS3gIlIx5COIR1DOoZ0rEnHcqD3MD2e1Vtb/MUuelxkVYI377OrBbFN2P9C",
-	"6wHugyCiIKIgoiCiIKIYRGEOSIbzdsavqLFzdfPWs+TqbLpVYcJOxADEAMQAxIB9MCDys1JTbzKYjf4i",
-	"eg15Nu6xuMfiHot7bM8e66wgO+2vP/pXcG/FvRX3VtxbcW/t2VujnIlq2KBR26OrI/8VuwfuIkFOsW7U",
-	"6RPV7KDBhs6kpFsrVJQxzo2ICoE1o0iElZUEswf+brIHjpuxzOwzu7SWDO4xhiJyA+QGyA2QG4xwAzV+",
-	"ymGLkQmxq3sowZOSgQQLaMlYsYGvPxYzxmB+zhjMSCmQUiClQEqBlOJwlMKlQkqemfWwi6gwofU1RAkL",
-	"YPcWH0ZJxo3RJPsP3PZ2svha/NuH4im47FZrUedxaFyEmMYJ9gtBsLsL/bClomVLoEqJBbNtqqZyo2G8",
-	"zHN6a2RwF0MP4K+fiFTwKBd+dL5BNoFsAtkEson92cROVgorVif7o2sJlZqI5fFURvE1mS6G3D1tbjBU",
-	"ZhF+EH4QfhB+DgM/EozIrSh76Uv+l7bo7pf827fSXD1PcNG/Fmtc82o2wfXCuDU2/sDzXzxvDlJHA6uD",
-	"NPQojFFkH7yBjXiJeIl4iXi5M15uxP14QJxLW4zQ4Hi0lGKzB1CaSqLkRIcASSfQRfaujuyaxknpo+N4",
-	"wKxvcLsu2DcuTt9BbKg3/pjptWNysbSrq5DinmWQHfWle6qyK5cqhLyxN8ynXSxv98vzw3s0p/7NM0ml",
-	"ehbjviDrQNaBrANZx/6swzo/jXMOa3R9BOO4dj5WLxU15pw8rNliXR+QLmmeK1LyzMaca7MBph3wZ7Ck",
-	"Za7jCHXfqBRP2CH4zB4e54HHhK/YewmTGMiLBbSLFk0rrKPgSyY3blx8KcJ4Zivmq8iy7hpN8wk0azok",
-	"mXnoexOt+MgPkB8gP0B+MMYP9jRNBMfqqfzgwFaJUZitXMDUWpR5ZtT8gAzWSrDXpS7HM9I1VpB+PBW4",
-	"qw8igjcQ3M8zBHIEcgRyBHIE8gEgV1Vq6X4Av/IpThOqbQfAr0Ky6U6q06fR7SelbxsxAKi8XJnVqkAT",
-	"GjT4ONg8L/PcFCi56u8KwpakKZ8Z2dh0EB8RmG/2qOhPDuWYORZxFHEUcRRx9ClwdMRo3gLTSVpwBaoH",
-	"NZQPpUF5UQxyfteIPYg9iD2IPYg9Y9jjQjH0Q86Nfb7z2ax77WBwk1Gd+DUXK5FW0cyTlOdWyvC6AU1D",
-	"/d2awtOptaWDgpiaeBQYZKwW1at8Wn1zUi1fEvde9zjf3tk3zfblR1RFkQ4gHUA6gHTg35AO+GPZeXDJ",
-	"HaQG2qfeNKhUndNOiEvmXo8Fcse0ly4KxGEdyPvA3Qmb2XVbFPm2bk3pWyeaWUF7fa/GoPWME2H/T/MB",
-	"F7I69Gd1NbpXtoR7mZkZm1JVPxEFbl9aA81AKlPXqt8MvZOzWX8iVg4PTk4tyC1Y4Rlkfnp7szglriaz",
-	"M9JwAdv9ZLbNwiaWtae+odXKSffzLzObvvWX2RH5ZaZoDr/MPo6SEh9apJoO6DCPDvNIupB0IelC0vW1",
-	"kK5h239tiIHPTDlkBLqZwrTSxpmDngekDTTDwdl6kI/3RTabAImN0KcT7CE7BD5zXMZ/YG9/+aPnM498",
-	"/YF1fWfuGGD3xr/FMdDuowLt9jhAhkFBz0dkbMjYkLEhY3OMTcJSglrPtbiDwbhDtpi3sLgpbt4gpV24",
-	"lMhQwNaTomq+imtf4DAEjS4WouS6j+z4x4b0pCK8toXuQKG3N/Va2sJz84GQZv+d6fnqyb6JblLi+taM",
-	"4mgk9fMzMjc7rsN06jQ+ev4mDlY8lYj11yzjCTZWYI9v21lybX/da9mfxevGy9LPSNpbhoPFxow1uFLN",
-	"wIXgS7YqZZWJG8EfwR/BH8E/gP+5u5tf4b7twnlBlXoQMptLUC7kbZ+PJs/s4Y0rTmxxH50ouu3fQX4/",
-	"VO/9e5f2K4dCf+iPnuQko1kmzYqM49fbWw1DjUmeCkHGJCz0dY9nzs3lWxd2wBVrfM3VrtfAZPVJe8hl",
-	"1We7kTPFv9HEbX5CEhfx3cZV+MaFUwoVBzpAya8lyG2t8JFP/wFmJv314qcPZ28vzv/7+t0Pb3761PmO",
-	"+8iONduX/xrVO0pB4MXiN/WtzUSmgFshcqAcgzGizQBpA9IGpA1TaIOCmjQM2QvCtcAKZI1+TPtIgoKK",
-	"IhyMHXB4eB8JmnboqMRzlx179b6esAgWVWOMryochchYvOcHSgRDBEMEQwRDBMNDgeHJbxYNvvRmj7n0",
-	"mpZqaIc2fwzN81u6uLNqZCVz2pTewMrX/sXZ0azeuWwbdsUqZooVVK/DEezpTEeW+hqGjqIx7QzdIVTj",
-	"IIxVQ2tpQh/dXL7dSaaPB4VN3WNcRtBE0ETQRNBE0BwHzXtxB3Oh1yDn3o9fDSmSprhNumZfCa7/qjpv",
-	"tVhiRqFoJmMTHHrOoU2N70xlV+Hz+6ucnW5/KjWtPZg2fBwowpapznkAadaVaWnrhPEopfDF+6r/JHq+",
-	"I6IhoiGiIaJNRDS/945DGTFrNK8usQ2A1FVV4kDhbSZZMs2Xxx2/bVWpneoFANANrds7Hmw+dwQ+BD4E",
-	"PgQ+BL5nA75pSlxSfRuHwN+DiobKGWIUYhRiFGLUM2KUAp7N70GypW/2vHIaHXR0jV+Z4OZq3voQveLy",
-	"Vx5Kb4vPvobcT0sFFledvI0mLOqTQvhMN0VuRm+tdaFOT078L8cLsTmJCvaksK49Y0b9b4Ovrem3ZI82",
-	"xDE9+5+RLI/wMn0hJdQ1y+wmCrhuY3vV0kZ3vrjjTfMLdp2GNCWN4fnQHUDntwzczJJsauT2XiKAaI5o",
-	"jmiOaD6A5mzF54yfjGE4W3HCuBPG3wThWexh0kJvtuIXz4nZr2OHHw/cVNlrtN5LJg3js+kg/CZOHO45",
-	"S/d+a69/bPAwGqtBgouU9eOETRd8BxfP6fbaZndu2wtVujgW/lapmckh6NzsqDWeYWRcnW5Sni5prhJI",
-	"3uszHD7fe+G4lHYoR5mXHY6RYLE3pkwnYlxoxlHl32Xr2sGiQObkEnQpuSLA4mNnkoGmLFcGPKqJbOY/",
-	"miCQtCBpQdLyxyYtNqDDVNZCiSseB5JokRb73FGXw8XTyDLmorme+9BnHejZidjUXr+C0KWGyGVrbbRk",
-	"tuKQEZbEwowpg4GXDeCNP3buCpg9TGyoZovqc36T0FGIDrPFw7LMLbNaU57lVRTW6KWtKKWCfDlLgbq9",
-	"YPt63+azpY1yZ9XrNS0KsIcaQxEuBgNrDMSWqFKnDgUhgTgARos6mkdbYgPziWXDF91Xw0sbHfXL0YwL",
-	"vkhYEX4yP5txzlxsXg4yBFXu5T7t+B09EWimN7GHhF2cT69kotfD0SwXK8b/zrjuzdzAOFkzrmM7nQ3q",
-	"4mHam+lEVqFDMnYcPBha15qEvXFresKg2OrNznFTJEc/Zwum820QxC7TeVlUS8iSZr88LzauuKvO7KuG",
-	"svrm1YRaJleUWogCEoa1syp0si3glpKTpTNofkN/YHlOxD1IyTI30TxLD3UYrQOyKJOwjc/cGzznj6Cc",
-	"9KkfqG6guoHqBqobqG78u6obotQjioYom9cl0seabMXflfordr7x52F4jx0RAxEDEQMRY1/EKItJp2pl",
-	"4YO4+MivE0/Xbop/ML22Z1JnPDt4qJfDOsjs7ucyckjGNklXCx9BdslyILaIFXOkrmmZGHc/8LuOY/Xs",
-	"dOjXmtNLt68xRZwC2rydYbR0M2Nt5DlXi93/va7OFPlkFOtPx+O+sD5S/KRTxS9PEwWgZbVoQFUwMnk7",
-	"T33bZ/Lh3uRUB/8IwfjtwtwnwcE+kRTjysMgpCt2nkOQJSW3Kn3tPLWmitwCcL86Y7eiyITB+sIn9yZl",
-	"OPzSrBpfSjZlWB+3cgeyMnSH/0C5GMKoxivtQz0sk1MyNKve1QBk3efCjHb9g3wd+Try9d35+rcvJu8N",
-	"L6QwPWxP7N5wzfTWnidIQnMJNNu6BFDWmrukLHcnR27V20WPKsfvSOUoec743TxkNOhPD2bLEcqj5Afd",
-	"q2Ku1FlV4glyS4ykhviKEzJgpEdEXERctJAhXD0Crlwqy0oxH0scPnio4srduEeHgaoBLdZpr03l8klV",
-	"1S9Pbn7ZyRsBEQ4RDhEOEQ4RbhDhrLV1W1+OTkYwtra/bWSsbQJBE+a+B+3Kv6kM9FMjFN+3vtMTEvip",
-	"AhQHV+Xk/avx2MTPFYt4j1vJwaY+FnXkER5/fi5goBIEYARgBGAE4CkA3H6JFuwH2L4W4o6Bee0oUY2T",
-	"wmGp9du24TxOT05ysaD5Wih9+udXr16d0IKdmPU7i77duUnjj9+BZ4Vg3KYooJpQCYTxRV5mITvcd6AN",
-	"MtoUt7fb4GJvp6uC6HXzpumTgkptWIKZJEVerhg/rmEzdMCXj1/+JwAA//9SO70tVMQBAA==",
+	"H4sIAAAAAAAC/+x9a28judXmXyG0C2QXkOx+k+yHbeDFrtPuJEZ6phu+TLDINHZoFSUxLpE1JMtuJej/",
+	"/uLwUsWqYl0ky3LPzPnUbRUvh9fn4eHhOf+eLeW2kIIJo2dv/z3Tyw3bUvvfi+VSlsLAfwslC6YMZ/YD",
+	"XS6Z1rfygQn40+wKNns700ZxsZ59ncff338puGL6wpaykmpLzeztLKOGLQzfstk8mRuqvcqSZS8Vo4Zl",
+	"rsCMrWiZQ4l/YYIp+ECoIaoUvvBpNfJ0VTzrb2JBtX6SKp2xUPKRZ0z1NEGxlWJ60194nOCADtRLWbBk",
+	"wWWR1Z03rbBS97TDyvlzyRXLZm//EY1ao/1VAfHIxYJ8ruqU9/9kSwN1XolHbqjhUnTn3kuMP9tSnic7",
+	"jO3f+32TCdrUOyWkWlPB/2Xb3JNEyTw9qNpQU+pmdxRMZPA5IZ9hdDtlQFsihV6q6os7pzm4dVNTg/sd",
+	"294zNTKwz+rqPTqz7rGtE+sZK6DTYdXMt7XFfZTql49R9pfsnVyuZfLDlhmaUUOTHwXd9sy+vFyP943N",
+	"7hOPdcQnprQUNL9ooswJtoGjLfacanOn9xuu3h7uTueM6aXihZsps9sNI3EaYjaMGOg2wjXJ+ZZDfxg5",
+	"J9zAL4o9ygeWkacNc2lhnpKc0UemiWkVlpK0UGzFv6QFeeSa3+eMuDREriJpjCSKLeVa8H8xwk0vdNkB",
+	"5oZtdbI//A9UKbrbZ33ybOZ7uWpCtEZ9zWOT84ZpnVygdGn4I/s4vvW4hLd9u/D8G5/avLjIMsW0PibB",
+	"uFgzxzMP3nzt4PYBUi1VVWBqbGFMXnLn3WOFP79j0xtwB6OGZzv0yMvjtelfC1OH3xcxPLyq1IZll+yR",
+	"L9kvDVGGl90heHPEdVdtoukFmBqMO83Uicagn9zDlx+Y4ivOskaNK5prBg2l2UeR72ZvjSpZVfa9lDmj",
+	"Ymi0tnTN9tsDGgv8KE1P7wCBxU8/jbkeWp7yPHa8ZZMxYWB81Yk6fT57pHnJpiycSrKQZwqAdQfo63ym",
+	"2bJU3OxuQHfj6UjB/8Z276R84KzL1C4+XZG/sR2hpdmAGG5wySOnZOmyzGccElZ/uXnbLLamYvZXaPw9",
+	"o4qpi9JsupX+yX7zXLBZs+VfIDusLpusLnxjTDH7+tUeoVcy3ZZrtmKKiSUjK6nITpaK/JnfM0X+Is2G",
+	"XAltqFhagbnJodDo68WnKxgBphyvm/3H2ZuzNxaVCyZowWdvZ384e3P2x9l8VlCzsb177rUdiyDSmpmu",
+	"ZH9hxrJfn5hAYuJ1Ixm539mP/m9oMCyrigNAbq+Au4JKYPboQgrtxvf3b97AP0spjN/CaVHkvjvP/6nd",
+	"UnXKPPs1yzh8ovmnaPn6fa65osMZMJ2lsRPWm0SZ3M/32Htfbm9NMsVG9clFFeexrZu7nkkk/jpvjfxN",
+	"ac+uINQf9xyoZgdumdbp5rYkDAmnSPcnmpFr9nPJtDkjd7qkeb4jWcnggLblWnOxJgVVdMsMU3pOpCJc",
+	"PNKcZ9HPZ65x//GtNe5OwNYiFf8Xy87IZbNVUUuaG5BvzR9eqjWjYv9ZqnueZUyckf8nS5JJIqQhG/rI",
+	"SMGUlV/aE7TTrROzsSd5LUu1ZNAuIyEhQJT7RpdRu/74au36XhryZ1mK7IyAekC5aceyWvYnqm1bVzaV",
+	"lff3//vV5L2VknxHxS4sEO3Gww4E+7JkDLZu2LcVNcwpV87IrdoRuqZckJwapmwj/tebN6/WiCthmBI0",
+	"JzdMPTJF3islFQwA1wSmBoDOfc625ImbjW2NdgnNhhqAT7Kkwo4J/3LWYBizt//4dwPk//H5K6xKutaw",
+	"UC89hfoMec6XGyrWbFGBQCG17Ygmzr2zqd57WuonyJ9ktntG9y1pnt/T5cPd9Ye0muru+oNTR2VcsaWB",
+	"/9OVYYpYYcljTHlTujr29D60qlu4YE++HOrObFC8ZoZsS23IPSOUuD2okWicwIdK09tgnRQg+usz+ULv",
+	"dGwBnb0LIP57UPe5drnhh7m2dM0TZZ7T+5y1SET6JqM5nzOQlGnCXQV+lti9QzusXZX5LHVCC8Tkvyu2",
+	"mr2d/bfz+rrz3DVXn9vDaLu7vTBTMOd93N4gm283yyIJ8x1SAqQESAkOoAS/fzV574RfyrB3kffCcLM7",
+	"I27N0xx0RDvCvnBtNJKXXx15ie08An9pyuv4izvL+8QBB/2xMcV3PoVyj0Z5SqWYMJ8iebvMxCeqBbU7",
+	"ht9V0zxnuECgOlVhjuWkynFXjh/Nhil/gZZA+e8qduThmwS1WBvVu8Qo6s52R5yeLZlwZd3a99gT0Szs",
+	"2vZ6dkUk9En4WZMnpli4oJ3CmMopavRYiL83Ln1ho/WJ99end2dDk/Q2F0FVeFBAT1HHtyS3fVUTzA3V",
+	"5J4x4ek6yxJTJaiRusKWgv9cMlLrYVsC9yueumUVSq54zohNYg8XPY0vFZ8yrEGTlVhwdMvGBG1ptYeH",
+	"H66OiM9x2MUCj42D4luGH+ph2UOZ3dG7TaF4YbU3uLZn5BlybuTcyLlRDfdbZbJ2710U3qxu4abuomIp",
+	"PbTW5gIhfb5qykO+GIJq8df8kQlSWVK1eK8tMGXcdywK7G9Pr0QaOnO+YgBoTaM0LohmSykyPXe/aNse",
+	"WRrCTdgAXMk1EnJh2Jqp6VBtfEOPYNwn3XRv2NXN68EAUmSk49H9Nq21nV23OvetIfkZeb9+S36cKVbI",
+	"t3Di/XE2m0820euxBXUipMDkuHS8SNuTDikEU7MUmpUm9rehm+bkacOXG1jcUuQ7opgplWAZkf72ediG",
+	"yc+RlLx46YhsB9kOsh1kO2NsJ2M5M2xRKUaS5ObSJurX0bnvd+7Tia4kQ4L+u8kK47kmrplJpV0xqLGD",
+	"An6nK6XdGbn2ex5oo4J6ivvVoZjepKoYAEIrmDUmi+5Q+/jP1xcH/z1vLivp61vLTuO9imHCNWU1qcYv",
+	"KtuXj76O+X4wBJOj3QS8gER6gPQA6QHSgxY9OA+I22vA+84ngIkMp0TI6hC42mSttEmo61jz1qQilGtN",
+	"isPmZZvBodqfS6Z2tc11KLAel57jJ4hpRdk1scxP8BT8pmuMucpwvb1cJVSeqPTzaQD+nRQrDpcZ0AUh",
+	"1bPgvB7006F54z4jkD6EcIRwhHCE8N8mhDOothezL7kucrrThApiU5LCbfwdPLbij7+rMeyLOd+Ybd7s",
+	"2C4W/vX2uw/EZ6wsYWMJ2l2OGlzc33F/x/0d9/fG/r5mZuIdNTywDG8p4tvpOckkrFtKvCctYPDCDkIH",
+	"CD5JbdxTy6PfRzccmXXxwn8mV5eEai2X3L44rvo2iN57ddz0MpY0SrPfoQJ4FguFfoTuj9+cDjz8T+uO",
+	"+8T1rRm95YykPr1l5ss6rhvyF/fSPt/sLLndFYeu/QvyQ2cZRTykvVG4NdeYooAm1ZRbwuF7Xbp1hpCP",
+	"kI+Qj5A/DPm69qw16E0hvGEI6RMnu5vq07Owo22m3ez4SN4hU6Igy7OeIlbtmWwQjUdJxBXEFcSV3zau",
+	"5Fw8LOxZJe8/RX7g4oFQ4pJVpzIj+82DIMeNTX5RnXqOdGSsfO5c9nliPdy3QcNGWPM1WKby5NEy4/ax",
+	"67UvIqllhQSwh0m4YltW1flNInZtBFs8W5W5PYVuqMhy2AndGbfOtJOl0ixfJR9xWT3quwNa7q7uFCM8",
+	"0ghnpQoSwAwZMvaJznT7HSWFtfk96ChYG2e3XZ2anjxJS+KUL7cwJD2C2Sl8w9firkj7ZOqzG7+o5m0w",
+	"H7fjYMsjKyW3bV9XgxbiVbrTawhU75TvXAfbpaQ3sswzMLcPOVkW5n+gAbZm4i7TE11aOb3ofitVz0vL",
+	"TtHNeR+kM3JUH1M1GFklskpklcgqkVWOs0ptbyiA+elefcUHrg2hecUoteUaNTr0MEvryFddhNKfCW7V",
+	"E62Bm4lhB+TPcr86EgjlIG/vLxfChHdil/Q5EY+DnbQ9yKdoV4vtIK4iriKuIq4irnZxNflMfQLKJp+p",
+	"60FPTJA38dL3eKB7yBNjRApECkQKRApEijGk0JEnubETmOGPrPayFqyhBo9glaO6U6BBdEuMCIAIgAiA",
+	"CIAIMIoAxkX0WmQ2pNcEIPAZiM/Q8GJl5dMPvPBCL6XIyIoujUxjRCOc2GlQolElYgViBWIFYgVixRhW",
+	"yIEX/hu2fAgX+hBJjGvyJNWDi53dMSz9+DA7qrWBfOiK1LEz6Ig18tpcPkzaoFrFIkQgRCBEIET8RiEi",
+	"crEKwR1ZAbEdH7mpo54mbUcvbFKwLKxT26kv2lHUu68O41jhrpyrusZjGZTWYvU96ru6DMegVhOsSOPe",
+	"3+MKTm+v1xykocNT1Llf57NtFdV6KI+PfT1pJuNZC4EUgRSB9LcGpDGMJdE0yxaG0e2i3nLTWOrjCUHc",
+	"Dmd2VfsvHwHPLLtldPtdSH0c5ASZ+zATvqVswIPLdSJXZ4c9rs+8C3HFCsU0gFFdj3frnsF0pLqq7GwU",
+	"o31TqvpPAdM9nVYN6eSIRbd8y7Sh24I8heA1UUkHhTBKhQS66wsH1BS7U9boNKn5VTVrYvlheYpDHTFM",
+	"ETFl5FjZLFYzox6AKYB7GzUgLFZ0MoskCEkQkiAkQQkStKRiyfIelcIwu3lns35TqgHXmm9PNTD1+I+H",
+	"d8QtxC3ELcStUdyC+9GFzsv1HoAFeW4gy7GQKtQ/EhoNkll4AgFCyLLtbiHV2sYrG96zbCWnV2D3P//e",
+	"Q+ns1qptP0yXR8qt46IzxDPEM8QzxDPEs4BnVlk1HvV0z+tbl+1oePfAWPHOubm7sE8XGs3qj4wuCeRs",
+	"eMnzTx8aOOl/c06BbI84Z7kQzF8K5pATkC7prySXa9kTYFWuZTgwtrqvo93cMkMz7+KoW1L4OrW0aUFY",
+	"x0rppxnwZWopo3pbnqWKcgMBq+RqZbcj/xA9mzfG0wfczXO4Cig1QMyNu36wEUf9j+R+R2i25ULDzmz1",
+	"5uA2yn2ol9uZ/3cBeQNhghoWPJvAmEIg11MRp6HjfWOF4AEfCRESIiRESIgmEiJ7QT8hFjwwBEhqxeTi",
+	"QJ4Et4dH40rToB+EjjQC8OePs6PEY7+69Df2oZoKncPFKBdnxFsb6so1XJeWBfHi3/bA4dNrLg64s3/x",
+	"y/rQ58ZNsYlE8fvWTDlkYtR3JY3pUTX9nuVSrHXSJV/Lq9H0vsypNsTnndihKXMAT+RaTexzfzTZQAAt",
+	"A5CAIQFDAoYEbICAuZieo6Hq92VaLtvRWNbetIhbP4dZkGJ4M2uV/jrmiR3xYeBhJfaG2kctA4IcghyC",
+	"HILcGMi5kH5w5I1eAQxG+XHJSMYM5XnlqCNxbE4+046+29h+kCt6HnC812dpv7ZdtOwkUTJPRwfYy1mt",
+	"T5w4utny0aU7QhZCFkIWQtYRIGsRtuxB3IJEAa1ciJ0FF/7eVxwJw65BkKPiWA8atfY2xBTEFMQUxBTE",
+	"lGdgCtyCLGTLnmsQUiDHXnAxm8/qjcqKzKG8n0umduG25233yDCkHOu73ZJkzcyk682UEnBcMG9GPiRa",
+	"n22WFy5R72e0Q0JoRGhEaERo/Kagsfk6thcTm8627nfk6nLCAarxgHYCPPLpkNh8HPsymNOcBGxLeZ5U",
+	"H7IvBVdMX5jk1x59pW0AU+97S/UJrg5Wd8ZJvvc2OIOJbrwt9nTFaech1ZDi1HWgLy2hPq0bXBUc922i",
+	"QfMUb2l0LJ6ckR4gPUB6gPRgf3qwoXpRT+cBS+XYn3MV6zzKOWY381eqP8Wpj2M+0xQ9Y4ViS2qCLcs8",
+	"wS6aa9e+aJ6l4nlXyXSapUwqpx1+Oyo0tXMe14yYwRxLI7oHw/Tb6FjikBIhFiEWIRYhFiF2f4h1Z5VR",
+	"L531C+n9PF67fLEQL+DSqjoXd4HQfgLnmQqWaCPwj3RNYcd6D1QVGNeRKtx6+EwUem1/b+sWbAvmDXID",
+	"EyZXjGY7X2M28ng7nJ67jYAv/0P/TzuUGm6u4zjlZ+TKwLyDZ00/2YfFP83JT26q/DQn8kkw5Wr+0XvW",
+	"Tr+uGnKWKe0fNK+8ZoIkWdYYJymWrN0tXHtX5UkT3SZYNY79r/xiqtM5L6PUuTq+idppNS37eSZFdofs",
+	"Dtkdsjtkd012lzP6OPDK6AN83vuRkc31em+MrrIqkq83FzeS2IYe/pb6FZ4itedfxb9/4abmbSSx7UIM",
+	"RwxHDEcMRww/AMO5g+7hGMdxFj1mGAG5ThK6uNE2jFyMGIAYgBiAGHAQBkSGcnHM+9GN/irKdkoTcZC5",
+	"pbUFDfyhZuOfT4FXzWiRiFaIVohWiFaIVgegldP87IVU3/ksrZ0e91bcW3Fvxb0V99awt0aBdfWwaqi+",
+	"JamsX9b8kQnnGneKnqiOsTvx+FAFF512bDA2PGp0XKjF7T0qpL21PvuI0AryD7TfShmFPXUzRgdPyJHr",
+	"2Op8gSFwfzUhcMePgLA6AEWM4uwRnd4id0HugtwFucsId9Hj91k2GZkQbKCHsrySrtOJ/XpaziEKk+Au",
+	"LWkqDvPtu/xHV/+ndPWPRAiJEBIhJEJIhI5HhFzEveSdbg8nihITWr9zVmzJ+KPFh1FqdAfn38aF8HEu",
+	"Vb+VFy9DpM0FUdzIOlxQ43J6Gic4zMfJ/o9qhvUrLQ0I1VouuW1TNZUbDRNlbkNDh5fnR3jBk3CF8qxH",
+	"PXjdjmwC2QSyCWQTh7OJvXQrVqxOkGHXEqoMKDGmMoqgcPkWVBdD5l0gJx5mEX4QfhB+EH6OBD+Kgcgt",
+	"N57p96bXNun+XkTaL1BdOS/gSaQWa/zk1WyC64VxbWxcwemfmTYHaboldP08teckGT3/xIeeCKQIpAik",
+	"CKR7A+lWPo674rq2yQgNdlQrJbcHICgUEgXHOwZ6OoGuso+1T+k0gCrvl8sjae3GwXXBoR65+u/nXblx",
+	"ZdBrZ+RqZVdXoeQjz1g277upr6L7l5plkZuJad4l2v2C7iXQvQSyDmQdyDqQdXwrrMNaRY1zDkj2HMZx",
+	"64yvXst11CV52vDlpr45XdE816QUGVMJNsCNA/7M2fLFvjF/p1M8YQ8PVAcY0AceE2qxz0AmMRBf1WsQ",
+	"j2rRtBzKSrHiauvGxaciXGS2YLGOVO6u0TSfQLOmQxLMQ9+bqN5HfoD8APkB8oMxfnCgaiJYXE/lB0fW",
+	"SozCbGUbpjeyzDM45gdksFqCg96oOZ6RLrGC9LOpwF1ViAjeQHA/zxDIEcgRyBHIEcgHgFwzs3BH1n4A",
+	"v/HBp9OP1ZoAfsPMhU3WCkL9Umf7SYEjRxQANmq0kUQzQ2g4wcdhLuB6GxKUQvd3BQTjaMoHIxurDuIr",
+	"Aqiz54j+4lCOMasRRxFHEUcRR18CR0eU5i0wnXQKrkD1qIry+gj8jWGQbSViD2IPYg9iD2LPKPY4Hw39",
+	"kHNnv+99N+uyHQ1uMmoSv+ZyLdNHNPiSstxKKV63zNBQfrek8HVqaWlvIVCSiDyGjJWiew+f8GVaKV8T",
+	"D2IPuN/e2zbN9uVnPIoiHUA6gHQA6cAvkA74a9lFMMkdpAbGB/0FVKruaSe4WXPZY4HcNe21cw9xXAPy",
+	"PnB3wmZ23RZFvqtbU/rWyWY84l7bqzFovRB1ZOJ+E7Lak2n1ZrpXtoR5GcyMbamrn4hmbl/aMJoxZV3H",
+	"rfvV0HsZm/WHgBbsyclpJGjA7XixzE9vrxanxJUEOyMNL7PdT7BtFoppJuytb2i1dtL948eZDRz942xO",
+	"fpxpmrMfZ59HSYn3OVJNBzSYR4N5JF1IupB0Ien6VkjXsO6/VsSwL1w7ZASDJS4OVM4c9T4graAZ9trW",
+	"g3yiz+XZBEhs+ESdoA/ZwyOa4zK+goPt5eenU498+x53fWfu6Xn3zucS6IH3WR54ewwgw6Cg5SMyNmRs",
+	"yNiQsTnGpthKMb1ZGPnABh0S2WRew+KmOOQgpV24lKiQwJaTomq+iFuf4DgEjS6XshSmj+z4z0B6Uq5f",
+	"20J3oNDrm3o1beE7VLCCVb5h5CP0fPXl0Lg9KXF9a0ZxNJL69IzMzY7bMJ06jY++v4+9GE8lYv0lq3iC",
+	"jSU4oG47S27trwct+4t43XhZ+hlJe8twsNiYsYAr1QxcwiuTdakqV1QI/gj+CP4I/gH8fZydCvdtFy4K",
+	"qvWTVNlCMc1MPwG4YSID4XxyYpN770TRa/8O8vuh+uTzXdtajoX+rN97kpOMZpmCFRk7trevGoYak7wV",
+	"YhlXbGlueyxz7q4/EOt2wCVr1OZKNxvGVVWlveSCRG4j51r8zhC3+UlFnCt461fhd86dUig40AFKbHym",
+	"+sBHfvo/DGbSf159/8PFh6vL/3/78W/vv/+pU4+rZM+Sbeb/jModpSDs1fw39a3NRAiBeylzRgU6Y0Sd",
+	"AdIGpA1IG6bQBnjVEVBsSF8QngWGtPZ8TPtIgmYVRTgaOxDs6VMkaNqgoxLPPXbsPfelS7CfGhhfFTgK",
+	"kbF4pwdKBEMEQwRDBEMEw2OB4fm/LRp87Q0rc+1PWrpxOoT/L2me39Plgz1GVjKnVekNrHznM3Zj+O6L",
+	"VRySFdRs6pC/JtLU1zAUR/7tDN0xjsZBmFYA4tBHd9cf9pLp81Fh0/QolxE0ETQRNBE0ETTHQfNRPrCF",
+	"NBumFt6OXw8dJCG5jcZmswTTf13dt1osgVEomlHapGA999BQ4kco7CZUf/iRs9PtL3VMaw+mdR/HNLjY",
+	"SXTOE1OwrqClrRvGeerAF++rvkq0fEdEQ0RDRENEm4hoBVMansEt3NQdt6hywEZCvqZdVXRjOYBin3ze",
+	"i8jo5mgh3kYNyNOS22MdCDfF3je11b0Cgva35olq3xzEUcRRxFHEUcTRl8VRf4aZgJywRvPqMfgATN5U",
+	"KU4FjV6mXywYBvkR/hD+EP4Q/hD+Tgx/01SiSWXoOBD+GhSeqOpEjEKMQoxCjDo9RhlVwogvMvbIl2zC",
+	"Sc1nIC7D3HsG8xuC9vIupcjIii6NVK7vB4Ds1hV46QQ43bmu2ZBf7PGu1Qw85SGCIoIigiKCvg6CTjvs",
+	"NTdtPfGKsIGUv4aDX7sb8PyH6IXoheiF6PXy6KWZyBaPTPGVb/aieoI/6DYgzjLBaQDk+iHK8t5WcqxT",
+	"XvySYOgxf6mZ1as6eRtNWNbvLtgXui1yNns72xhT6Lfn5/6Xs6XcnkcJIWIivc9ZeLLQeWc46s0geC6A",
+	"fkv2aEMc6Nn/G8nyjDf7r3RKdc2C3UQzYdrYXrW00Z2v/oyxWYNdpyHoc2N4fugOoPMCwQTMkmxqHMxe",
+	"IoBojmiOaI5oPoDmfC0WXJyPYThfC8KFE8b71RFZ/F6vhd58La5Oidnv4ueTHripJrR+c5iG8dl0ELat",
+	"aZ24O3mLXm8D4b3mWAmKubgD303YdJnv4OKUTgTa7M5te6FI5xXY++iDmRxCeMzmrfEMI+PKdJPy7Yrm",
+	"OoHkvR4Ybiojqx73jaWyQznKvOxwjITeuoM07TGomjGvXsvasvbQKJAFuWamVEITxuNHPCRjhvJcA3hU",
+	"ExnmP6ogkLQgaUHS8tsmLdY97lTWQolLHrvlbZEW+91Rl+N5J84y7mJjXfpAEh3o2YvYVDAAS3ZlWPQA",
+	"dgOnZL4WLCM8iYUZ14CB1w3gjSu7dAlgD5Nbaviyqs5vEiZyeAxbPFuVuWVWGyqyvIppFWXayVJplq9m",
+	"KVBnMH/eHdp8uAwQxJZBNrQomNB1LUl/wYNuigc89a6U3DbanqqHxe6EW9QRPu2IDXMiG84YhTd6gJET",
+	"SxdyjhVyuWmET5DlfR7ZF4gSCCLUKaRYJlQO38PPMCkyFxZNMBXi2dl6g/UFzJh7BnJoXbKM3O9Ia3Gd",
+	"uxrm466We5yFT++/HoZ3dTm9kBa8uxI/J6Kj5HLNxV+5ML1BdrkgGy5MrASEugMH8DpAmVXQk2qSYE/A",
+	"GVszvNfFePKjLx62pbsiObVyvuQm3wVB7B6wKItqfVpG7tf+1dYld8XBpg182DeP60TfRstVL2XBElq7",
+	"iyrKnU3g1qmTpTNoHi2eODxYf2RK8cxNTH8ECGXAkYZlZ7UcNpRer5/z38LJp7tinuSfra3UJyYy+C05",
+	"mcNZAiRy6UgpDCiLOyZXXPvDcax7jETxhyk8POHhCQ9PeHjCw9Mv/vB0XjHI9BHqCoghocQmi4HdOJvY",
+	"ylNB53hlm+Hml8vsw+NaVhpK0/EJLS6yczh7Z2N/xUe07z0zPc45bYCDvSblaArJ9o9YMnhC6BtPGI5x",
+	"57W+/2uh0IgKQRhBGEEYQXgSCMvSjOguZdn0Z5e2lOJr8bE037BZr9/y0dE4IgYiBiIGIsahiFEWkwx1",
+	"ysJH2fChOSca7NwVf+dmY81cLkR29Fgcx7W53d90dsTuhm+T1ps+xOeK54zYJFbMkbLS4b2hqDi09/42",
+	"RLdxMJW97Ihac3rl9jWuiVM7Nx3+gG4eZqwNDeZKsfu/19BzTX6Cs+1PZ+PPa3wo70mGSl9fxk17666i",
+	"AVXhakmquAemWGoHlffkWPR/D9HS7cI8JAL9IaHu4sLDIKQL/iEo/lOSW716bY9d3RsO3hb0PaUue6Pm",
+	"H39pVo0vFZ8yrM9buQNh87vDf6Rg+WFU45X2Qz0sk2PmN4ve9xbGWuSHGe36B/k68nXk6/vz9d+/mrx3",
+	"olASetgaAb0XhpsdLBKmCM0Vo9mOsC9cG3uluqI8d/YlbtXbRY9Hjl/RkaMUORcPixByvvfMcWfTgS1W",
+	"SJp6iu5SXVQpXiD4/0js/m84Yj6G4kPERcRFDRnC1TPgyp5uFtXBPI1VNtH4pYpLd+c+HQeqBk6x9lPr",
+	"cPmiR9WvL65+2cskEBEOEQ4RDhEOEW4Q4ay2dVf7W0mGmLW6v12krB3yEfYXZlz695WCfmoI2cdWPT0x",
+	"W18qgmx4/ZR80j0ePPZUwWIPcHQSdOpjjsyeYXbv5wL6PkMARgBGAEYAngLA7Uy04H9ju3dSPnAG2eaJ",
+	"YpwUDkvt4ynrIezt+XkulzTfSG3e/uHNmzfntODnsH5nUd2dx7lOFMJEVkgubAx5aghVjHCxzEsYR9v8",
+	"PzFjmCIgB7zo9C/M7HTVLMoOOaFPCqqMNeEXO1Lk5ZqLsxo2Qwd8/fz1vwYAAYLllQgDAgA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
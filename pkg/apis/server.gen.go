@@ -27,6 +27,9 @@ type ServerInterface interface {
 	// (POST /change-password)
 	ChangePassword(c *fiber.Ctx) error
 
+	// (POST /create-personal-access-token)
+	CreatePersonalAccessToken(c *fiber.Ctx) error
+
 	// (POST /delete-user)
 	DeleteUser(c *fiber.Ctx) error
 
@@ -48,9 +51,15 @@ type ServerInterface interface {
 	// (GET /list-accounts)
 	ListUserAccounts(c *fiber.Ctx) error
 
+	// (GET /list-personal-access-tokens)
+	ListPersonalAccessTokens(c *fiber.Ctx) error
+
 	// (GET /list-sessions)
 	ListUserSessions(c *fiber.Ctx) error
 
+	// (GET /list-trusted-devices)
+	ListTrustedDevices(c *fiber.Ctx) error
+
 	// (GET /ok)
 	GetOk(c *fiber.Ctx) error
 
@@ -82,7 +91,7 @@ type ServerInterface interface {
 	GetOrganizationGetActiveMemberRole(c *fiber.Ctx) error
 
 	// (GET /organization/get-full-organization)
-	GetOrganization(c *fiber.Ctx) error
+	GetOrganization(c *fiber.Ctx, params GetOrganizationParams) error
 
 	// (GET /organization/get-invitation)
 	GetOrganizationGetInvitation(c *fiber.Ctx, params GetOrganizationGetInvitationParams) error
@@ -100,16 +109,16 @@ type ServerInterface interface {
 	GetOrganizationList(c *fiber.Ctx) error
 
 	// (GET /organization/list-invitations)
-	GetOrganizationListInvitations(c *fiber.Ctx) error
+	GetOrganizationListInvitations(c *fiber.Ctx, params GetOrganizationListInvitationsParams) error
 
 	// (GET /organization/list-members)
 	GetOrganizationListMembers(c *fiber.Ctx) error
 
 	// (GET /organization/list-team-members)
-	GetOrganizationListTeamMembers(c *fiber.Ctx) error
+	GetOrganizationListTeamMembers(c *fiber.Ctx, params GetOrganizationListTeamMembersParams) error
 
 	// (GET /organization/list-teams)
-	GetOrganizationListTeams(c *fiber.Ctx) error
+	GetOrganizationListTeams(c *fiber.Ctx, params GetOrganizationListTeamsParams) error
 
 	// (GET /organization/list-user-invitations)
 	GetOrganizationListUserInvitations(c *fiber.Ctx) error
@@ -159,12 +168,21 @@ type ServerInterface interface {
 	// (POST /revoke-other-sessions)
 	PostRevokeOtherSessions(c *fiber.Ctx) error
 
+	// (POST /revoke-personal-access-token)
+	PostRevokePersonalAccessToken(c *fiber.Ctx) error
+
 	// (POST /revoke-session)
 	PostRevokeSession(c *fiber.Ctx) error
 
 	// (POST /revoke-sessions)
 	PostRevokeSessions(c *fiber.Ctx) error
 
+	// (POST /revoke-trusted-device)
+	PostRevokeTrustedDevice(c *fiber.Ctx) error
+
+	// (POST /revoke-trusted-devices)
+	PostRevokeTrustedDevices(c *fiber.Ctx) error
+
 	// (POST /send-verification-email)
 	SendVerificationEmail(c *fiber.Ctx) error
 
@@ -174,6 +192,9 @@ type ServerInterface interface {
 	// (POST /sign-in/social)
 	SocialSignIn(c *fiber.Ctx) error
 
+	// (POST /sign-in/social/nonce)
+	CreateSocialSignInNonce(c *fiber.Ctx) error
+
 	// (POST /sign-out)
 	SignOut(c *fiber.Ctx) error
 
@@ -221,6 +242,14 @@ func (siw *ServerInterfaceWrapper) ChangePassword(c *fiber.Ctx) error {
 	return siw.Handler.ChangePassword(c)
 }
 
+// CreatePersonalAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) CreatePersonalAccessToken(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CreatePersonalAccessToken(c)
+}
+
 // DeleteUser operation middleware
 func (siw *ServerInterfaceWrapper) DeleteUser(c *fiber.Ctx) error {
 
@@ -302,6 +331,14 @@ func (siw *ServerInterfaceWrapper) ListUserAccounts(c *fiber.Ctx) error {
 	return siw.Handler.ListUserAccounts(c)
 }
 
+// ListPersonalAccessTokens operation middleware
+func (siw *ServerInterfaceWrapper) ListPersonalAccessTokens(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ListPersonalAccessTokens(c)
+}
+
 // ListUserSessions operation middleware
 func (siw *ServerInterfaceWrapper) ListUserSessions(c *fiber.Ctx) error {
 
@@ -310,6 +347,14 @@ func (siw *ServerInterfaceWrapper) ListUserSessions(c *fiber.Ctx) error {
 	return siw.Handler.ListUserSessions(c)
 }
 
+// ListTrustedDevices operation middleware
+func (siw *ServerInterfaceWrapper) ListTrustedDevices(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ListTrustedDevices(c)
+}
+
 // GetOk operation middleware
 func (siw *ServerInterfaceWrapper) GetOk(c *fiber.Ctx) error {
 
@@ -393,9 +438,34 @@ func (siw *ServerInterfaceWrapper) GetOrganizationGetActiveMemberRole(c *fiber.C
 // GetOrganization operation middleware
 func (siw *ServerInterfaceWrapper) GetOrganization(c *fiber.Ctx) error {
 
+	var err error
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetOrganization(c)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "organizationId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organizationId", query, &params.OrganizationId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationId: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "organizationSlug" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organizationSlug", query, &params.OrganizationSlug)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationSlug: %w", err).Error())
+	}
+
+	return siw.Handler.GetOrganization(c, params)
 }
 
 // GetOrganizationGetInvitation operation middleware
@@ -459,9 +529,27 @@ func (siw *ServerInterfaceWrapper) GetOrganizationList(c *fiber.Ctx) error {
 // GetOrganizationListInvitations operation middleware
 func (siw *ServerInterfaceWrapper) GetOrganizationListInvitations(c *fiber.Ctx) error {
 
+	var err error
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetOrganizationListInvitations(c)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationListInvitationsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "organizationId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organizationId", query, &params.OrganizationId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationId: %w", err).Error())
+	}
+
+	return siw.Handler.GetOrganizationListInvitations(c, params)
 }
 
 // GetOrganizationListMembers operation middleware
@@ -475,17 +563,53 @@ func (siw *ServerInterfaceWrapper) GetOrganizationListMembers(c *fiber.Ctx) erro
 // GetOrganizationListTeamMembers operation middleware
 func (siw *ServerInterfaceWrapper) GetOrganizationListTeamMembers(c *fiber.Ctx) error {
 
+	var err error
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetOrganizationListTeamMembers(c)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationListTeamMembersParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "teamId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "teamId", query, &params.TeamId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter teamId: %w", err).Error())
+	}
+
+	return siw.Handler.GetOrganizationListTeamMembers(c, params)
 }
 
 // GetOrganizationListTeams operation middleware
 func (siw *ServerInterfaceWrapper) GetOrganizationListTeams(c *fiber.Ctx) error {
 
+	var err error
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetOrganizationListTeams(c)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationListTeamsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "organizationId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organizationId", query, &params.OrganizationId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationId: %w", err).Error())
+	}
+
+	return siw.Handler.GetOrganizationListTeams(c, params)
 }
 
 // GetOrganizationListUserInvitations operation middleware
@@ -650,6 +774,14 @@ func (siw *ServerInterfaceWrapper) PostRevokeOtherSessions(c *fiber.Ctx) error {
 	return siw.Handler.PostRevokeOtherSessions(c)
 }
 
+// PostRevokePersonalAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) PostRevokePersonalAccessToken(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostRevokePersonalAccessToken(c)
+}
+
 // PostRevokeSession operation middleware
 func (siw *ServerInterfaceWrapper) PostRevokeSession(c *fiber.Ctx) error {
 
@@ -666,6 +798,22 @@ func (siw *ServerInterfaceWrapper) PostRevokeSessions(c *fiber.Ctx) error {
 	return siw.Handler.PostRevokeSessions(c)
 }
 
+// PostRevokeTrustedDevice operation middleware
+func (siw *ServerInterfaceWrapper) PostRevokeTrustedDevice(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostRevokeTrustedDevice(c)
+}
+
+// PostRevokeTrustedDevices operation middleware
+func (siw *ServerInterfaceWrapper) PostRevokeTrustedDevices(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostRevokeTrustedDevices(c)
+}
+
 // SendVerificationEmail operation middleware
 func (siw *ServerInterfaceWrapper) SendVerificationEmail(c *fiber.Ctx) error {
 
@@ -690,6 +838,14 @@ func (siw *ServerInterfaceWrapper) SocialSignIn(c *fiber.Ctx) error {
 	return siw.Handler.SocialSignIn(c)
 }
 
+// CreateSocialSignInNonce operation middleware
+func (siw *ServerInterfaceWrapper) CreateSocialSignInNonce(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CreateSocialSignInNonce(c)
+}
+
 // SignOut operation middleware
 func (siw *ServerInterfaceWrapper) SignOut(c *fiber.Ctx) error {
 
@@ -790,6 +946,8 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 
 	router.Post(options.BaseURL+"/change-password", wrapper.ChangePassword)
 
+	router.Post(options.BaseURL+"/create-personal-access-token", wrapper.CreatePersonalAccessToken)
+
 	router.Post(options.BaseURL+"/delete-user", wrapper.DeleteUser)
 
 	router.Get(options.BaseURL+"/delete-user/callback", wrapper.GetDeleteUserCallback)
@@ -804,8 +962,12 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 
 	router.Get(options.BaseURL+"/list-accounts", wrapper.ListUserAccounts)
 
+	router.Get(options.BaseURL+"/list-personal-access-tokens", wrapper.ListPersonalAccessTokens)
+
 	router.Get(options.BaseURL+"/list-sessions", wrapper.ListUserSessions)
 
+	router.Get(options.BaseURL+"/list-trusted-devices", wrapper.ListTrustedDevices)
+
 	router.Get(options.BaseURL+"/ok", wrapper.GetOk)
 
 	router.Post(options.BaseURL+"/organization/accept-invitation", wrapper.PostOrganizationAcceptInvitation)
@@ -878,16 +1040,24 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 
 	router.Post(options.BaseURL+"/revoke-other-sessions", wrapper.PostRevokeOtherSessions)
 
+	router.Post(options.BaseURL+"/revoke-personal-access-token", wrapper.PostRevokePersonalAccessToken)
+
 	router.Post(options.BaseURL+"/revoke-session", wrapper.PostRevokeSession)
 
 	router.Post(options.BaseURL+"/revoke-sessions", wrapper.PostRevokeSessions)
 
+	router.Post(options.BaseURL+"/revoke-trusted-device", wrapper.PostRevokeTrustedDevice)
+
+	router.Post(options.BaseURL+"/revoke-trusted-devices", wrapper.PostRevokeTrustedDevices)
+
 	router.Post(options.BaseURL+"/send-verification-email", wrapper.SendVerificationEmail)
 
 	router.Post(options.BaseURL+"/sign-in/email", wrapper.SignInEmail)
 
 	router.Post(options.BaseURL+"/sign-in/social", wrapper.SocialSignIn)
 
+	router.Post(options.BaseURL+"/sign-in/social/nonce", wrapper.CreateSocialSignInNonce)
+
 	router.Post(options.BaseURL+"/sign-out", wrapper.SignOut)
 
 	router.Post(options.BaseURL+"/sign-up/email", wrapper.SignUpWithEmailAndPassword)
@@ -1200,6 +1370,94 @@ func (response ChangePassword500JSONResponse) VisitChangePasswordResponse(ctx *f
 	return ctx.JSON(&response)
 }
 
+type CreatePersonalAccessTokenRequestObject struct {
+	Body *CreatePersonalAccessTokenJSONRequestBody
+}
+
+type CreatePersonalAccessTokenResponseObject interface {
+	VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error
+}
+
+type CreatePersonalAccessToken200JSONResponse struct {
+	PersonalAccessToken PersonalAccessToken `json:"personalAccessToken"`
+
+	// Token The token, which is only returned once
+	Token string `json:"token"`
+}
+
+func (response CreatePersonalAccessToken200JSONResponse) VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type CreatePersonalAccessToken400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response CreatePersonalAccessToken400JSONResponse) VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreatePersonalAccessToken401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response CreatePersonalAccessToken401JSONResponse) VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreatePersonalAccessToken403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreatePersonalAccessToken403JSONResponse) VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type CreatePersonalAccessToken404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreatePersonalAccessToken404JSONResponse) VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type CreatePersonalAccessToken429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreatePersonalAccessToken429JSONResponse) VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type CreatePersonalAccessToken500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreatePersonalAccessToken500JSONResponse) VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
 type DeleteUserRequestObject struct {
 	Body *DeleteUserJSONRequestBody
 }
@@ -1822,6 +2080,88 @@ func (response ListUserAccounts500JSONResponse) VisitListUserAccountsResponse(ct
 	return ctx.JSON(&response)
 }
 
+type ListPersonalAccessTokensRequestObject struct {
+}
+
+type ListPersonalAccessTokensResponseObject interface {
+	VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error
+}
+
+type ListPersonalAccessTokens200JSONResponse []PersonalAccessToken
+
+func (response ListPersonalAccessTokens200JSONResponse) VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListPersonalAccessTokens400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ListPersonalAccessTokens400JSONResponse) VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ListPersonalAccessTokens401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ListPersonalAccessTokens401JSONResponse) VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListPersonalAccessTokens403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListPersonalAccessTokens403JSONResponse) VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type ListPersonalAccessTokens404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListPersonalAccessTokens404JSONResponse) VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ListPersonalAccessTokens429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListPersonalAccessTokens429JSONResponse) VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type ListPersonalAccessTokens500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListPersonalAccessTokens500JSONResponse) VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
 type ListUserSessionsRequestObject struct {
 }
 
@@ -1904,6 +2244,88 @@ func (response ListUserSessions500JSONResponse) VisitListUserSessionsResponse(ct
 	return ctx.JSON(&response)
 }
 
+type ListTrustedDevicesRequestObject struct {
+}
+
+type ListTrustedDevicesResponseObject interface {
+	VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error
+}
+
+type ListTrustedDevices200JSONResponse []TrustedDevice
+
+func (response ListTrustedDevices200JSONResponse) VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListTrustedDevices400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ListTrustedDevices400JSONResponse) VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ListTrustedDevices401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ListTrustedDevices401JSONResponse) VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListTrustedDevices403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListTrustedDevices403JSONResponse) VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type ListTrustedDevices404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListTrustedDevices404JSONResponse) VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ListTrustedDevices429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListTrustedDevices429JSONResponse) VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type ListTrustedDevices500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListTrustedDevices500JSONResponse) VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
 type GetOkRequestObject struct {
 }
 
@@ -1998,8 +2420,8 @@ type PostOrganizationAcceptInvitationResponseObject interface {
 }
 
 type PostOrganizationAcceptInvitation200JSONResponse struct {
-	Invitation *map[string]interface{} `json:"invitation,omitempty"`
-	Member     *map[string]interface{} `json:"member,omitempty"`
+	Invitation *Invitation `json:"invitation,omitempty"`
+	Member     *Member     `json:"member,omitempty"`
 }
 
 func (response PostOrganizationAcceptInvitation200JSONResponse) VisitPostOrganizationAcceptInvitationResponse(ctx *fiber.Ctx) error {
@@ -2178,6 +2600,15 @@ type PostOrganizationCancelInvitationResponseObject interface {
 	VisitPostOrganizationCancelInvitationResponse(ctx *fiber.Ctx) error
 }
 
+type PostOrganizationCancelInvitation200JSONResponse Invitation
+
+func (response PostOrganizationCancelInvitation200JSONResponse) VisitPostOrganizationCancelInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
 type PostOrganizationCancelInvitation400JSONResponse struct {
 	Message string `json:"message"`
 }
@@ -2248,8 +2679,19 @@ type PostOrganizationCheckSlugRequestObject struct {
 	Body *PostOrganizationCheckSlugJSONRequestBody
 }
 
-type PostOrganizationCheckSlugResponseObject interface {
-	VisitPostOrganizationCheckSlugResponse(ctx *fiber.Ctx) error
+type PostOrganizationCheckSlugResponseObject interface {
+	VisitPostOrganizationCheckSlugResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationCheckSlug200JSONResponse struct {
+	Status *bool `json:"status,omitempty"`
+}
+
+func (response PostOrganizationCheckSlug200JSONResponse) VisitPostOrganizationCheckSlugResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
 }
 
 type PostOrganizationCheckSlug400JSONResponse struct {
@@ -2676,6 +3118,17 @@ type GetOrganizationGetActiveMemberRoleResponseObject interface {
 	VisitGetOrganizationGetActiveMemberRoleResponse(ctx *fiber.Ctx) error
 }
 
+type GetOrganizationGetActiveMemberRole200JSONResponse struct {
+	Role string `json:"role"`
+}
+
+func (response GetOrganizationGetActiveMemberRole200JSONResponse) VisitGetOrganizationGetActiveMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
 type GetOrganizationGetActiveMemberRole400JSONResponse struct {
 	Message string `json:"message"`
 }
@@ -2743,6 +3196,7 @@ func (response GetOrganizationGetActiveMemberRole500JSONResponse) VisitGetOrgani
 }
 
 type GetOrganizationRequestObject struct {
+	Params GetOrganizationParams
 }
 
 type GetOrganizationResponseObject interface {
@@ -3104,6 +3558,22 @@ type PostOrganizationLeaveResponseObject interface {
 	VisitPostOrganizationLeaveResponse(ctx *fiber.Ctx) error
 }
 
+type PostOrganizationLeave200JSONResponse struct {
+	Member struct {
+		Id             string `json:"id"`
+		OrganizationId string `json:"organizationId"`
+		Role           string `json:"role"`
+		UserId         string `json:"userId"`
+	} `json:"member"`
+}
+
+func (response PostOrganizationLeave200JSONResponse) VisitPostOrganizationLeaveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
 type PostOrganizationLeave400JSONResponse struct {
 	Message string `json:"message"`
 }
@@ -3253,12 +3723,22 @@ func (response GetOrganizationList500JSONResponse) VisitGetOrganizationListRespo
 }
 
 type GetOrganizationListInvitationsRequestObject struct {
+	Params GetOrganizationListInvitationsParams
 }
 
 type GetOrganizationListInvitationsResponseObject interface {
 	VisitGetOrganizationListInvitationsResponse(ctx *fiber.Ctx) error
 }
 
+type GetOrganizationListInvitations200JSONResponse []Invitation
+
+func (response GetOrganizationListInvitations200JSONResponse) VisitGetOrganizationListInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
 type GetOrganizationListInvitations400JSONResponse struct {
 	Message string `json:"message"`
 }
@@ -3399,6 +3879,7 @@ func (response GetOrganizationListMembers500JSONResponse) VisitGetOrganizationLi
 }
 
 type GetOrganizationListTeamMembersRequestObject struct {
+	Params GetOrganizationListTeamMembersParams
 }
 
 type GetOrganizationListTeamMembersResponseObject interface {
@@ -3493,6 +3974,7 @@ func (response GetOrganizationListTeamMembers500JSONResponse) VisitGetOrganizati
 }
 
 type GetOrganizationListTeamsRequestObject struct {
+	Params GetOrganizationListTeamsParams
 }
 
 type GetOrganizationListTeamsResponseObject interface {
@@ -3777,7 +4259,7 @@ type PostOrganizationRejectInvitationResponseObject interface {
 }
 
 type PostOrganizationRejectInvitation200JSONResponse struct {
-	Invitation *map[string]interface{} `json:"invitation,omitempty"`
+	Invitation *Invitation             `json:"invitation,omitempty"`
 	Member     *map[string]interface{} `json:"member"`
 }
 
@@ -4983,6 +5465,92 @@ func (response PostRevokeOtherSessions500JSONResponse) VisitPostRevokeOtherSessi
 	return ctx.JSON(&response)
 }
 
+type PostRevokePersonalAccessTokenRequestObject struct {
+	Body *PostRevokePersonalAccessTokenJSONRequestBody
+}
+
+type PostRevokePersonalAccessTokenResponseObject interface {
+	VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error
+}
+
+type PostRevokePersonalAccessToken200JSONResponse struct {
+	// Status Indicates if the personal access token was revoked successfully
+	Status bool `json:"status"`
+}
+
+func (response PostRevokePersonalAccessToken200JSONResponse) VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokePersonalAccessToken400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokePersonalAccessToken400JSONResponse) VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokePersonalAccessToken401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokePersonalAccessToken401JSONResponse) VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokePersonalAccessToken403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokePersonalAccessToken403JSONResponse) VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokePersonalAccessToken404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokePersonalAccessToken404JSONResponse) VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokePersonalAccessToken429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokePersonalAccessToken429JSONResponse) VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokePersonalAccessToken500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokePersonalAccessToken500JSONResponse) VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
 type PostRevokeSessionRequestObject struct {
 	Body *PostRevokeSessionJSONRequestBody
 }
@@ -5051,104 +5619,276 @@ type PostRevokeSession429JSONResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
-func (response PostRevokeSession429JSONResponse) VisitPostRevokeSessionResponse(ctx *fiber.Ctx) error {
+func (response PostRevokeSession429JSONResponse) VisitPostRevokeSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSession500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeSession500JSONResponse) VisitPostRevokeSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessionsRequestObject struct {
+	Body *PostRevokeSessionsJSONRequestBody
+}
+
+type PostRevokeSessionsResponseObject interface {
+	VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error
+}
+
+type PostRevokeSessions200JSONResponse struct {
+	// Status Indicates if all sessions were revoked successfully
+	Status bool `json:"status"`
+}
+
+func (response PostRevokeSessions200JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessions400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokeSessions400JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessions401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokeSessions401JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessions403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeSessions403JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessions404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeSessions404JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessions429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeSessions429JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessions500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeSessions500JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDeviceRequestObject struct {
+	Body *PostRevokeTrustedDeviceJSONRequestBody
+}
+
+type PostRevokeTrustedDeviceResponseObject interface {
+	VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error
+}
+
+type PostRevokeTrustedDevice200JSONResponse struct {
+	// Status Indicates if the trusted device was revoked successfully
+	Status bool `json:"status"`
+}
+
+func (response PostRevokeTrustedDevice200JSONResponse) VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevice400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokeTrustedDevice400JSONResponse) VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevice401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokeTrustedDevice401JSONResponse) VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevice403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeTrustedDevice403JSONResponse) VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevice404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeTrustedDevice404JSONResponse) VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevice429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeTrustedDevice429JSONResponse) VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(429)
 
 	return ctx.JSON(&response)
 }
 
-type PostRevokeSession500JSONResponse struct {
+type PostRevokeTrustedDevice500JSONResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
-func (response PostRevokeSession500JSONResponse) VisitPostRevokeSessionResponse(ctx *fiber.Ctx) error {
+func (response PostRevokeTrustedDevice500JSONResponse) VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(500)
 
 	return ctx.JSON(&response)
 }
 
-type PostRevokeSessionsRequestObject struct {
-	Body *PostRevokeSessionsJSONRequestBody
+type PostRevokeTrustedDevicesRequestObject struct {
+	Body *PostRevokeTrustedDevicesJSONRequestBody
 }
 
-type PostRevokeSessionsResponseObject interface {
-	VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error
+type PostRevokeTrustedDevicesResponseObject interface {
+	VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error
 }
 
-type PostRevokeSessions200JSONResponse struct {
-	// Status Indicates if all sessions were revoked successfully
+type PostRevokeTrustedDevices200JSONResponse struct {
+	// Status Indicates if all trusted devices were revoked successfully
 	Status bool `json:"status"`
 }
 
-func (response PostRevokeSessions200JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+func (response PostRevokeTrustedDevices200JSONResponse) VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type PostRevokeSessions400JSONResponse struct {
+type PostRevokeTrustedDevices400JSONResponse struct {
 	Message string `json:"message"`
 }
 
-func (response PostRevokeSessions400JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+func (response PostRevokeTrustedDevices400JSONResponse) VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(400)
 
 	return ctx.JSON(&response)
 }
 
-type PostRevokeSessions401JSONResponse struct {
+type PostRevokeTrustedDevices401JSONResponse struct {
 	Message string `json:"message"`
 }
 
-func (response PostRevokeSessions401JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+func (response PostRevokeTrustedDevices401JSONResponse) VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type PostRevokeSessions403JSONResponse struct {
+type PostRevokeTrustedDevices403JSONResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
-func (response PostRevokeSessions403JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+func (response PostRevokeTrustedDevices403JSONResponse) VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(403)
 
 	return ctx.JSON(&response)
 }
 
-type PostRevokeSessions404JSONResponse struct {
+type PostRevokeTrustedDevices404JSONResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
-func (response PostRevokeSessions404JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+func (response PostRevokeTrustedDevices404JSONResponse) VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(404)
 
 	return ctx.JSON(&response)
 }
 
-type PostRevokeSessions429JSONResponse struct {
+type PostRevokeTrustedDevices429JSONResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
-func (response PostRevokeSessions429JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+func (response PostRevokeTrustedDevices429JSONResponse) VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(429)
 
 	return ctx.JSON(&response)
 }
 
-type PostRevokeSessions500JSONResponse struct {
+type PostRevokeTrustedDevices500JSONResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
-func (response PostRevokeSessions500JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+func (response PostRevokeTrustedDevices500JSONResponse) VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(500)
 
@@ -5341,10 +6081,13 @@ type SocialSignInResponseObject interface {
 }
 
 type SocialSignIn200JSONResponse struct {
-	Redirect bool    `json:"redirect"`
-	Token    string  `json:"token"`
-	Url      *string `json:"url,omitempty"`
-	User     User    `json:"user"`
+	Redirect bool   `json:"redirect"`
+	Token    string `json:"token"`
+
+	// TwoFactorPending The session is pending until the second factor is verified
+	TwoFactorPending *bool   `json:"twoFactorPending,omitempty"`
+	Url              *string `json:"url,omitempty"`
+	User             User    `json:"user"`
 }
 
 func (response SocialSignIn200JSONResponse) VisitSocialSignInResponse(ctx *fiber.Ctx) error {
@@ -5420,6 +6163,94 @@ func (response SocialSignIn500JSONResponse) VisitSocialSignInResponse(ctx *fiber
 	return ctx.JSON(&response)
 }
 
+type CreateSocialSignInNonceRequestObject struct {
+	Body *CreateSocialSignInNonceJSONRequestBody
+}
+
+type CreateSocialSignInNonceResponseObject interface {
+	VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error
+}
+
+type CreateSocialSignInNonce200JSONResponse struct {
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Nonce Nonce to request the ID token with
+	Nonce string `json:"nonce"`
+}
+
+func (response CreateSocialSignInNonce200JSONResponse) VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type CreateSocialSignInNonce400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response CreateSocialSignInNonce400JSONResponse) VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateSocialSignInNonce401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response CreateSocialSignInNonce401JSONResponse) VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreateSocialSignInNonce403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreateSocialSignInNonce403JSONResponse) VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type CreateSocialSignInNonce404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreateSocialSignInNonce404JSONResponse) VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type CreateSocialSignInNonce429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreateSocialSignInNonce429JSONResponse) VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type CreateSocialSignInNonce500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreateSocialSignInNonce500JSONResponse) VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
 type SignOutRequestObject struct {
 	Body *SignOutJSONRequestBody
 }
@@ -5893,6 +6724,9 @@ type StrictServerInterface interface {
 	// (POST /change-password)
 	ChangePassword(ctx context.Context, request ChangePasswordRequestObject) (ChangePasswordResponseObject, error)
 
+	// (POST /create-personal-access-token)
+	CreatePersonalAccessToken(ctx context.Context, request CreatePersonalAccessTokenRequestObject) (CreatePersonalAccessTokenResponseObject, error)
+
 	// (POST /delete-user)
 	DeleteUser(ctx context.Context, request DeleteUserRequestObject) (DeleteUserResponseObject, error)
 
@@ -5914,9 +6748,15 @@ type StrictServerInterface interface {
 	// (GET /list-accounts)
 	ListUserAccounts(ctx context.Context, request ListUserAccountsRequestObject) (ListUserAccountsResponseObject, error)
 
+	// (GET /list-personal-access-tokens)
+	ListPersonalAccessTokens(ctx context.Context, request ListPersonalAccessTokensRequestObject) (ListPersonalAccessTokensResponseObject, error)
+
 	// (GET /list-sessions)
 	ListUserSessions(ctx context.Context, request ListUserSessionsRequestObject) (ListUserSessionsResponseObject, error)
 
+	// (GET /list-trusted-devices)
+	ListTrustedDevices(ctx context.Context, request ListTrustedDevicesRequestObject) (ListTrustedDevicesResponseObject, error)
+
 	// (GET /ok)
 	GetOk(ctx context.Context, request GetOkRequestObject) (GetOkResponseObject, error)
 
@@ -6025,12 +6865,21 @@ type StrictServerInterface interface {
 	// (POST /revoke-other-sessions)
 	PostRevokeOtherSessions(ctx context.Context, request PostRevokeOtherSessionsRequestObject) (PostRevokeOtherSessionsResponseObject, error)
 
+	// (POST /revoke-personal-access-token)
+	PostRevokePersonalAccessToken(ctx context.Context, request PostRevokePersonalAccessTokenRequestObject) (PostRevokePersonalAccessTokenResponseObject, error)
+
 	// (POST /revoke-session)
 	PostRevokeSession(ctx context.Context, request PostRevokeSessionRequestObject) (PostRevokeSessionResponseObject, error)
 
 	// (POST /revoke-sessions)
 	PostRevokeSessions(ctx context.Context, request PostRevokeSessionsRequestObject) (PostRevokeSessionsResponseObject, error)
 
+	// (POST /revoke-trusted-device)
+	PostRevokeTrustedDevice(ctx context.Context, request PostRevokeTrustedDeviceRequestObject) (PostRevokeTrustedDeviceResponseObject, error)
+
+	// (POST /revoke-trusted-devices)
+	PostRevokeTrustedDevices(ctx context.Context, request PostRevokeTrustedDevicesRequestObject) (PostRevokeTrustedDevicesResponseObject, error)
+
 	// (POST /send-verification-email)
 	SendVerificationEmail(ctx context.Context, request SendVerificationEmailRequestObject) (SendVerificationEmailResponseObject, error)
 
@@ -6040,6 +6889,9 @@ type StrictServerInterface interface {
 	// (POST /sign-in/social)
 	SocialSignIn(ctx context.Context, request SocialSignInRequestObject) (SocialSignInResponseObject, error)
 
+	// (POST /sign-in/social/nonce)
+	CreateSocialSignInNonce(ctx context.Context, request CreateSocialSignInNonceRequestObject) (CreateSocialSignInNonceResponseObject, error)
+
 	// (POST /sign-out)
 	SignOut(ctx context.Context, request SignOutRequestObject) (SignOutResponseObject, error)
 
@@ -6156,6 +7008,37 @@ func (sh *strictHandler) ChangePassword(ctx *fiber.Ctx) error {
 	return nil
 }
 
+// CreatePersonalAccessToken operation middleware
+func (sh *strictHandler) CreatePersonalAccessToken(ctx *fiber.Ctx) error {
+	var request CreatePersonalAccessTokenRequestObject
+
+	var body CreatePersonalAccessTokenJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePersonalAccessToken(ctx.UserContext(), request.(CreatePersonalAccessTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePersonalAccessToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreatePersonalAccessTokenResponseObject); ok {
+		if err := validResponse.VisitCreatePersonalAccessTokenResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteUser operation middleware
 func (sh *strictHandler) DeleteUser(ctx *fiber.Ctx) error {
 	var request DeleteUserRequestObject
@@ -6351,6 +7234,31 @@ func (sh *strictHandler) ListUserAccounts(ctx *fiber.Ctx) error {
 	return nil
 }
 
+// ListPersonalAccessTokens operation middleware
+func (sh *strictHandler) ListPersonalAccessTokens(ctx *fiber.Ctx) error {
+	var request ListPersonalAccessTokensRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPersonalAccessTokens(ctx.UserContext(), request.(ListPersonalAccessTokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPersonalAccessTokens")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListPersonalAccessTokensResponseObject); ok {
+		if err := validResponse.VisitListPersonalAccessTokensResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ListUserSessions operation middleware
 func (sh *strictHandler) ListUserSessions(ctx *fiber.Ctx) error {
 	var request ListUserSessionsRequestObject
@@ -6376,6 +7284,31 @@ func (sh *strictHandler) ListUserSessions(ctx *fiber.Ctx) error {
 	return nil
 }
 
+// ListTrustedDevices operation middleware
+func (sh *strictHandler) ListTrustedDevices(ctx *fiber.Ctx) error {
+	var request ListTrustedDevicesRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTrustedDevices(ctx.UserContext(), request.(ListTrustedDevicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTrustedDevices")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListTrustedDevicesResponseObject); ok {
+		if err := validResponse.VisitListTrustedDevicesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetOk operation middleware
 func (sh *strictHandler) GetOk(ctx *fiber.Ctx) error {
 	var request GetOkRequestObject
@@ -6669,9 +7602,11 @@ func (sh *strictHandler) GetOrganizationGetActiveMemberRole(ctx *fiber.Ctx) erro
 }
 
 // GetOrganization operation middleware
-func (sh *strictHandler) GetOrganization(ctx *fiber.Ctx) error {
+func (sh *strictHandler) GetOrganization(ctx *fiber.Ctx, params GetOrganizationParams) error {
 	var request GetOrganizationRequestObject
 
+	request.Params = params
+
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
 		return sh.ssi.GetOrganization(ctx.UserContext(), request.(GetOrganizationRequestObject))
 	}
@@ -6839,9 +7774,11 @@ func (sh *strictHandler) GetOrganizationList(ctx *fiber.Ctx) error {
 }
 
 // GetOrganizationListInvitations operation middleware
-func (sh *strictHandler) GetOrganizationListInvitations(ctx *fiber.Ctx) error {
+func (sh *strictHandler) GetOrganizationListInvitations(ctx *fiber.Ctx, params GetOrganizationListInvitationsParams) error {
 	var request GetOrganizationListInvitationsRequestObject
 
+	request.Params = params
+
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
 		return sh.ssi.GetOrganizationListInvitations(ctx.UserContext(), request.(GetOrganizationListInvitationsRequestObject))
 	}
@@ -6889,9 +7826,11 @@ func (sh *strictHandler) GetOrganizationListMembers(ctx *fiber.Ctx) error {
 }
 
 // GetOrganizationListTeamMembers operation middleware
-func (sh *strictHandler) GetOrganizationListTeamMembers(ctx *fiber.Ctx) error {
+func (sh *strictHandler) GetOrganizationListTeamMembers(ctx *fiber.Ctx, params GetOrganizationListTeamMembersParams) error {
 	var request GetOrganizationListTeamMembersRequestObject
 
+	request.Params = params
+
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
 		return sh.ssi.GetOrganizationListTeamMembers(ctx.UserContext(), request.(GetOrganizationListTeamMembersRequestObject))
 	}
@@ -6914,9 +7853,11 @@ func (sh *strictHandler) GetOrganizationListTeamMembers(ctx *fiber.Ctx) error {
 }
 
 // GetOrganizationListTeams operation middleware
-func (sh *strictHandler) GetOrganizationListTeams(ctx *fiber.Ctx) error {
+func (sh *strictHandler) GetOrganizationListTeams(ctx *fiber.Ctx, params GetOrganizationListTeamsParams) error {
 	var request GetOrganizationListTeamsRequestObject
 
+	request.Params = params
+
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
 		return sh.ssi.GetOrganizationListTeams(ctx.UserContext(), request.(GetOrganizationListTeamsRequestObject))
 	}
@@ -7419,6 +8360,37 @@ func (sh *strictHandler) PostRevokeOtherSessions(ctx *fiber.Ctx) error {
 	return nil
 }
 
+// PostRevokePersonalAccessToken operation middleware
+func (sh *strictHandler) PostRevokePersonalAccessToken(ctx *fiber.Ctx) error {
+	var request PostRevokePersonalAccessTokenRequestObject
+
+	var body PostRevokePersonalAccessTokenJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRevokePersonalAccessToken(ctx.UserContext(), request.(PostRevokePersonalAccessTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRevokePersonalAccessToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostRevokePersonalAccessTokenResponseObject); ok {
+		if err := validResponse.VisitPostRevokePersonalAccessTokenResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostRevokeSession operation middleware
 func (sh *strictHandler) PostRevokeSession(ctx *fiber.Ctx) error {
 	var request PostRevokeSessionRequestObject
@@ -7481,6 +8453,68 @@ func (sh *strictHandler) PostRevokeSessions(ctx *fiber.Ctx) error {
 	return nil
 }
 
+// PostRevokeTrustedDevice operation middleware
+func (sh *strictHandler) PostRevokeTrustedDevice(ctx *fiber.Ctx) error {
+	var request PostRevokeTrustedDeviceRequestObject
+
+	var body PostRevokeTrustedDeviceJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRevokeTrustedDevice(ctx.UserContext(), request.(PostRevokeTrustedDeviceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRevokeTrustedDevice")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostRevokeTrustedDeviceResponseObject); ok {
+		if err := validResponse.VisitPostRevokeTrustedDeviceResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostRevokeTrustedDevices operation middleware
+func (sh *strictHandler) PostRevokeTrustedDevices(ctx *fiber.Ctx) error {
+	var request PostRevokeTrustedDevicesRequestObject
+
+	var body PostRevokeTrustedDevicesJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRevokeTrustedDevices(ctx.UserContext(), request.(PostRevokeTrustedDevicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRevokeTrustedDevices")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostRevokeTrustedDevicesResponseObject); ok {
+		if err := validResponse.VisitPostRevokeTrustedDevicesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // SendVerificationEmail operation middleware
 func (sh *strictHandler) SendVerificationEmail(ctx *fiber.Ctx) error {
 	var request SendVerificationEmailRequestObject
@@ -7574,6 +8608,37 @@ func (sh *strictHandler) SocialSignIn(ctx *fiber.Ctx) error {
 	return nil
 }
 
+// CreateSocialSignInNonce operation middleware
+func (sh *strictHandler) CreateSocialSignInNonce(ctx *fiber.Ctx) error {
+	var request CreateSocialSignInNonceRequestObject
+
+	var body CreateSocialSignInNonceJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateSocialSignInNonce(ctx.UserContext(), request.(CreateSocialSignInNonceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateSocialSignInNonce")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreateSocialSignInNonceResponseObject); ok {
+		if err := validResponse.VisitCreateSocialSignInNonceResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // SignOut operation middleware
 func (sh *strictHandler) SignOut(ctx *fiber.Ctx) error {
 	var request SignOutRequestObject
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+
+	"github.com/katallaxie/fiber-goth/pkg/apis"
+)
+
+// OrganizationsService manages the organizations of the signed-in user.
+type OrganizationsService struct {
+	c *Client
+}
+
+// Create creates a new organization that is owned by the signed-in user.
+func (s *OrganizationsService) Create(ctx context.Context, body apis.PostOrganizationCreateJSONRequestBody) (apis.Organization, error) {
+	res, err := s.c.api.PostOrganizationCreateWithResponse(ctx, body)
+	if err != nil {
+		return apis.Organization{}, err
+	}
+
+	return result(res.StatusCode(), res.Body, res.JSON200)
+}
+
+// Get returns the organization by ID.
+func (s *OrganizationsService) Get(ctx context.Context, id string) (apis.Organization, error) {
+	res, err := s.c.api.GetOrganizationWithResponse(ctx, &apis.GetOrganizationParams{OrganizationId: &id})
+	if err != nil {
+		return apis.Organization{}, err
+	}
+
+	return result(res.StatusCode(), res.Body, res.JSON200)
+}
+
+// GetBySlug returns the organization by slug.
+func (s *OrganizationsService) GetBySlug(ctx context.Context, slug string) (apis.Organization, error) {
+	res, err := s.c.api.GetOrganizationWithResponse(ctx, &apis.GetOrganizationParams{OrganizationSlug: &slug})
+	if err != nil {
+		return apis.Organization{}, err
+	}
+
+	return result(res.StatusCode(), res.Body, res.JSON200)
+}
+
+// List returns the organizations of the signed-in user.
+func (s *OrganizationsService) List(ctx context.Context) ([]apis.Organization, error) {
+	res, err := s.c.api.GetOrganizationListWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result(res.StatusCode(), res.Body, res.JSON200)
+}
+
+// Update updates the organization.
+func (s *OrganizationsService) Update(ctx context.Context, body apis.PostOrganizationUpdateJSONRequestBody) (apis.Organization, error) {
+	res, err := s.c.api.PostOrganizationUpdateWithResponse(ctx, body)
+	if err != nil {
+		return apis.Organization{}, err
+	}
+
+	return result(res.StatusCode(), res.Body, res.JSON200)
+}
+
+// Delete deletes the organization by ID.
+func (s *OrganizationsService) Delete(ctx context.Context, id string) error {
+	res, err := s.c.api.PostOrganizationDeleteWithResponse(ctx, apis.PostOrganizationDeleteJSONRequestBody{OrganizationId: id})
+	if err != nil {
+		return err
+	}
+
+	_, err = result(res.StatusCode(), res.Body, res.JSON200)
+
+	return err
+}
+
+// SetActive sets the active organization of the session. An empty ID unsets the active organization.
+func (s *OrganizationsService) SetActive(ctx context.Context, id string) (apis.Organization, error) {
+	body := apis.SetActiveOrganizationJSONRequestBody{}
+	if id != "" {
+		body.OrganizationId = &id
+	}
+
+	res, err := s.c.api.SetActiveOrganizationWithResponse(ctx, body)
+	if err != nil {
+		return apis.Organization{}, err
+	}
+
+	return result(res.StatusCode(), res.Body, res.JSON200)
+}
+
+// AcceptInvitation accepts the invitation and returns the membership of the signed-in user.
+func (s *OrganizationsService) AcceptInvitation(ctx context.Context, invitationID string) (apis.Member, error) {
+	res, err := s.c.api.PostOrganizationAcceptInvitationWithResponse(ctx, apis.PostOrganizationAcceptInvitationJSONRequestBody{InvitationId: invitationID})
+	if err != nil {
+		return apis.Member{}, err
+	}
+
+	body, err := result(res.StatusCode(), res.Body, res.JSON200)
+	if err != nil {
+		return apis.Member{}, err
+	}
+
+	if body.Member == nil {
+		return apis.Member{}, ErrUnexpectedResponse
+	}
+
+	return *body.Member, nil
+}
+
+// RejectInvitation rejects the invitation.
+func (s *OrganizationsService) RejectInvitation(ctx context.Context, invitationID string) error {
+	res, err := s.c.api.PostOrganizationRejectInvitationWithResponse(ctx, apis.PostOrganizationRejectInvitationJSONRequestBody{InvitationId: invitationID})
+	if err != nil {
+		return err
+	}
+
+	_, err = result(res.StatusCode(), res.Body, res.JSON200)
+
+	return err
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+
+	"github.com/katallaxie/fiber-goth/pkg/apis"
+)
+
+// CurrentSession is the session of the signed-in user and the user.
+type CurrentSession struct {
+	Session apis.Session `json:"session"`
+	User    apis.User    `json:"user"`
+}
+
+// SessionsService manages the sessions of the signed-in user.
+type SessionsService struct {
+	c *Client
+}
+
+// Get returns the current session and the signed-in user.
+func (s *SessionsService) Get(ctx context.Context) (CurrentSession, error) {
+	res, err := s.c.api.GetSessionWithResponse(ctx)
+	if err != nil {
+		return CurrentSession{}, err
+	}
+
+	body, err := result(res.StatusCode(), res.Body, res.JSON200)
+	if err != nil {
+		return CurrentSession{}, err
+	}
+
+	return CurrentSession(body), nil
+}
+
+// List returns the sessions of the signed-in user.
+func (s *SessionsService) List(ctx context.Context) ([]apis.Session, error) {
+	res, err := s.c.api.ListUserSessionsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result(res.StatusCode(), res.Body, res.JSON200)
+}
+
+// Revoke revokes the session with the ID.
+func (s *SessionsService) Revoke(ctx context.Context, id string) error {
+	res, err := s.c.api.PostRevokeSessionWithResponse(ctx, apis.PostRevokeSessionJSONRequestBody{Id: id})
+	if err != nil {
+		return err
+	}
+
+	_, err = result(res.StatusCode(), res.Body, res.JSON200)
+
+	return err
+}
+
+// RevokeOthers revokes all sessions of the signed-in user except the current session.
+func (s *SessionsService) RevokeOthers(ctx context.Context) error {
+	res, err := s.c.api.PostRevokeOtherSessionsWithResponse(ctx, apis.PostRevokeOtherSessionsJSONRequestBody{})
+	if err != nil {
+		return err
+	}
+
+	_, err = result(res.StatusCode(), res.Body, res.JSON200)
+
+	return err
+}
+
+// RevokeAll revokes all sessions of the signed-in user.
+func (s *SessionsService) RevokeAll(ctx context.Context) error {
+	res, err := s.c.api.PostRevokeSessionsWithResponse(ctx, apis.PostRevokeSessionsJSONRequestBody{})
+	if err != nil {
+		return err
+	}
+
+	_, err = result(res.StatusCode(), res.Body, res.JSON200)
+
+	return err
+}
@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/katallaxie/fiber-goth/adapters"
+	"github.com/katallaxie/fiber-goth/providers"
+
+	"github.com/katallaxie/pkg/utilx"
+)
+
+// deleteUserIdentifier returns the identifier of the deletion verification token of the user.
+func deleteUserIdentifier(userID uuid.UUID) string {
+	return "delete-user:" + userID.String()
+}
+
+// hashToken returns the hash of a token which is stored instead of the token.
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(h[:])
+}
+
+// sendDeletionVerification creates a verification token and sends the URL to confirm the deletion to the user.
+func (c *APIController) sendDeletionVerification(ctx context.Context, userID uuid.UUID, callbackURL string) error {
+	user, err := c.adapter.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+	token := hex.EncodeToString(b)
+
+	_, err = c.adapter.CreateVerificationToken(ctx, adapters.GothVerificationToken{
+		Token:      hashToken(token),
+		Identifier: deleteUserIdentifier(userID),
+		ExpiresAt:  time.Now().Add(DefaultDeleteTokenExpiry),
+	})
+	if err != nil {
+		return err
+	}
+
+	q := url.Values{}
+	q.Set("token", token)
+
+	if utilx.NotEmpty(callbackURL) {
+		q.Set("callbackURL", callbackURL)
+	}
+
+	return c.sendDeleteUserVerification(ctx, user, c.baseURL+"/delete-user/callback?"+q.Encode())
+}
+
+// verifyDeletionToken uses the deletion verification token of the user.
+func (c *APIController) verifyDeletionToken(ctx context.Context, userID uuid.UUID, token string) error {
+	_, err := c.adapter.UseVerficationToken(ctx, deleteUserIdentifier(userID), hashToken(token))
+
+	return err
+}
+
+// deleteUser deletes the user including the accounts, sessions and tokens.
+// The provider tokens are revoked on a best-effort basis.
+func (c *APIController) deleteUser(ctx context.Context, userID uuid.UUID) error {
+	user, err := c.adapter.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if c.beforeDeleteUser != nil {
+		if err := c.beforeDeleteUser(ctx, user); err != nil {
+			return err
+		}
+	}
+
+	accounts, err := c.adapter.ListAccounts(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	_ = providers.RevokeTokens(ctx, accounts...)
+
+	if err := c.adapter.DeleteUser(ctx, userID); err != nil {
+		return err
+	}
+
+	if c.afterDeleteUser != nil {
+		return c.afterDeleteUser(ctx, user)
+	}
+
+	return nil
+}
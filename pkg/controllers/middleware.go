@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/katallaxie/fiber-goth/pkg/apis"
+	"github.com/katallaxie/pkg/slices"
+)
+
+// ErrNotImplemented is returned by the endpoints that are not supported, e.g. the sign-in with a password.
+var ErrNotImplemented = errors.New("not implemented")
+
+// NotImplemented returns a middleware of the strict handler that answers the endpoints
+// which are not supported with "501 Not Implemented".
+// The operations, e.g. "LinkSocialAccount", are disabled in addition to the endpoints of the controller.
+func NotImplemented(operations ...string) apis.StrictMiddlewareFunc {
+	return func(f apis.StrictHandlerFunc, operation string) apis.StrictHandlerFunc {
+		return func(c *fiber.Ctx, args any) (any, error) {
+			if slices.In(operation, operations...) {
+				return nil, notImplemented(c)
+			}
+
+			res, err := f(c, args)
+			if errors.Is(err, ErrNotImplemented) {
+				return nil, notImplemented(c)
+			}
+
+			return res, err
+		}
+	}
+}
+
+func notImplemented(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{"message": ErrNotImplemented.Error()})
+}
@@ -0,0 +1,91 @@
+package providers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+// The parameters to request a new authentication at an OpenID provider.
+const (
+	// ParamPrompt is the prompt parameter, e.g. "login" to force the user to authenticate again.
+	ParamPrompt = "prompt"
+	// ParamMaxAge is the maximum age in seconds of the authentication of the user.
+	ParamMaxAge = "max_age"
+	// ParamACRValues are the space-separated requested authentication context class references.
+	ParamACRValues = "acr_values"
+)
+
+// PromptLogin is the prompt to force the user to authenticate again.
+const PromptLogin = "login"
+
+// AuthContext is the context of an authentication at a provider, e.g. the claims of an OIDC ID token.
+type AuthContext struct {
+	// AuthTime is the time the user authenticated at the provider.
+	AuthTime time.Time
+	// ACR is the authentication context class reference.
+	ACR string
+	// AMR are the authentication method references.
+	AMR []string
+}
+
+// Reauthenticator is implemented by providers that authenticate the user again when the parameters request it,
+// e.g. with "prompt=login" of OpenID Connect or "ForceAuthn" of SAML.
+type Reauthenticator interface {
+	// Reauthenticates returns true if the provider forwards the request of a new authentication.
+	Reauthenticates() bool
+}
+
+// AuthContextRecorder is implemented by the parameters of the complete handler
+// to record the context of the authentication with the session.
+type AuthContextRecorder interface {
+	// RecordAuthContext records the context of the authentication.
+	RecordAuthContext(auth AuthContext)
+}
+
+// RecordAuthContext records the context of the authentication, if the parameters support it.
+func RecordAuthContext(params AuthParams, auth AuthContext) {
+	if r, ok := params.(AuthContextRecorder); ok {
+		r.RecordAuthContext(auth)
+	}
+}
+
+// AuthContextFromIDToken returns the context of the authentication from the claims of a verified ID token.
+func AuthContextFromIDToken(idToken *oidc.IDToken) (AuthContext, error) {
+	var claims struct {
+		AuthTime int64    `json:"auth_time"`
+		ACR      string   `json:"acr"`
+		AMR      []string `json:"amr"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return AuthContext{}, err
+	}
+
+	auth := AuthContext{
+		AuthTime: idToken.IssuedAt,
+		ACR:      claims.ACR,
+		AMR:      claims.AMR,
+	}
+
+	if claims.AuthTime > 0 {
+		auth.AuthTime = time.Unix(claims.AuthTime, 0)
+	}
+
+	return auth, nil
+}
+
+// AuthCodeOptions returns the options of the authorization request
+// that forward the parameters to request a new authentication.
+func AuthCodeOptions(params AuthParams) []oauth2.AuthCodeOption {
+	opts := []oauth2.AuthCodeOption{}
+
+	for _, key := range []string{ParamPrompt, ParamMaxAge, ParamACRValues} {
+		if v := strings.TrimSpace(params.Get(key)); v != "" {
+			opts = append(opts, oauth2.SetAuthURLParam(key, v))
+		}
+	}
+
+	return opts
+}
@@ -19,6 +19,12 @@ import (
 
 var DefaultScopes = []ScopeType{OpenIDScope, ProfileScope, EmailScope, UserReadScope}
 
+var (
+	_ providers.Provider        = (*entraIDProvider)(nil)
+	_ providers.TokenRefresher  = (*entraIDProvider)(nil)
+	_ providers.Reauthenticator = (*entraIDProvider)(nil)
+)
+
 // also https://docs.microsoft.com/en-us/azure/active-directory/develop/active-directory-v2-protocols#endpoints
 const (
 	GraphAPIURL string = "https://graph.microsoft.com/v1.0/"
@@ -50,6 +56,11 @@ func (a *authIntent) GetAuthURL() (string, error) {
 	return a.authURL, nil
 }
 
+// CodeVerifier returns the code verifier for PKCE, if applicable.
+func (a *authIntent) CodeVerifier() string {
+	return ""
+}
+
 // New creates a new GitHub provider.
 func New(clientKey, secret, callbackURL string, tenentType TenantType, scopes ...ScopeType) providers.Provider {
 	p := &entraIDProvider{
@@ -81,6 +92,11 @@ func (e *entraIDProvider) Type() providers.ProviderType {
 	return e.providerType
 }
 
+// Reauthenticates returns true, since the provider forwards "prompt=login" and "max_age" to the IdP.
+func (e *entraIDProvider) Reauthenticates() bool {
+	return true
+}
+
 func newConfig(e *entraIDProvider, tenant TenantType, scopes ...ScopeType) *oauth2.Config {
 	c := &oauth2.Config{
 		ClientID:     e.clientKey,
@@ -118,8 +134,9 @@ const (
 )
 
 // BeginAuth starts the authentication process.
-func (e *entraIDProvider) BeginAuth(_ context.Context, _ adapters.Adapter, state string, _ providers.AuthParams) (providers.AuthIntent, error) {
-	url := e.config.AuthCodeURL(state)
+// The prompt, max_age and acr_values parameters are forwarded to request a new authentication.
+func (e *entraIDProvider) BeginAuth(_ context.Context, _ adapters.Adapter, state string, params providers.AuthParams) (providers.AuthIntent, error) {
+	url := e.config.AuthCodeURL(state, providers.AuthCodeOptions(params)...)
 
 	return &authIntent{
 		authURL: url,
@@ -202,18 +219,9 @@ func (e *entraIDProvider) CompleteAuth(ctx context.Context, adapter adapters.Ada
 	return user, nil
 }
 
-// // RefreshTokenAvailable refresh token is provided by auth provider or not
-// func (p *Provider) RefreshTokenAvailable() bool {
-// 	return true
-// }
-
-// // RefreshToken get new access token based on the refresh token
-// func (p *Provider) RefreshToken(refreshToken string) (*oauth2.Token, error) {
-// 	token := &oauth2.Token{RefreshToken: refreshToken}
-// 	ts := p.config.TokenSource(goth.ContextForClient(p.Client()), token)
-// 	newToken, err := ts.Token()
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	return newToken, err
-// }
+// RefreshToken returns a new token by using the refresh token.
+func (e *entraIDProvider) RefreshToken(ctx context.Context, refreshToken string) (*oauth2.Token, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, e.client)
+
+	return e.config.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+}
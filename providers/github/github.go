@@ -29,7 +29,12 @@ var (
 
 const NoopEmail = ""
 
-var _ providers.Provider = (*githubProvider)(nil)
+var (
+	_ providers.Provider         = (*githubProvider)(nil)
+	_ providers.TokenRefresher   = (*githubProvider)(nil)
+	_ providers.TokenRevoker     = (*githubProvider)(nil)
+	_ providers.DeviceAuthorizer = (*githubProvider)(nil)
+)
 
 // DefaultScopes holds the default scopes used for GitHub.
 var DefaultScopes = []string{"user:email", "read:user"}
@@ -118,6 +123,11 @@ type authIntent struct {
 	codeVerifier string
 }
 
+// CodeVerifier returns the code verifier for PKCE.
+func (a *authIntent) CodeVerifier() string {
+	return a.codeVerifier
+}
+
 // GetAuthURL returns the URL for the authentication end-point.
 func (a *authIntent) GetAuthURL() (string, error) {
 	if a.authURL == "" {
@@ -143,31 +153,44 @@ func (g *githubProvider) BeginAuth(_ context.Context, _ adapters.Adapter, state
 }
 
 // CompleteAuth completes the authentication process.
-//
-//nolint:gocyclo
 func (g *githubProvider) CompleteAuth(ctx context.Context, adapter adapters.Adapter, params providers.AuthParams) (adapters.GothUser, error) {
-	u := struct {
-		ID       int    `json:"id"`
-		Email    string `json:"email"`
-		Bio      string `json:"bio"`
-		Name     string `json:"name"`
-		Login    string `json:"login"`
-		Picture  string `json:"avatar_url"`
-		Location string `json:"location"`
-	}{}
-
-	verifier := oauth2.GenerateVerifier()
-
 	code := params.Get("code")
 	if code == "" {
 		return adapters.GothUser{}, adapters.ErrUnimplemented
 	}
 
-	token, err := g.config.Exchange(ctx, code, oauth2.SetAuthURLParam("code_verifier", verifier))
+	token, err := g.config.Exchange(ctx, code, oauth2.SetAuthURLParam("code_verifier", params.CodeVerifier()))
 	if err != nil {
 		return adapters.GothUser{}, err
 	}
 
+	return g.completeAuth(ctx, adapter, token)
+}
+
+// DeviceAuth requests a device code and a user code to enter at https://github.com/login/device.
+// The device flow has to be enabled in the settings of the OAuth app.
+func (g *githubProvider) DeviceAuth(ctx context.Context) (*oauth2.DeviceAuthResponse, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, g.client)
+
+	return g.config.DeviceAuth(ctx)
+}
+
+// CompleteDeviceAuth completes the authentication of a device once the user has authorized it.
+func (g *githubProvider) CompleteDeviceAuth(ctx context.Context, adapter adapters.Adapter, params providers.AuthParams) (adapters.GothUser, error) {
+	token, err := providers.DeviceAccessToken(ctx, g.client, g.config, params.Get(providers.ParamDeviceCode))
+	if err != nil {
+		return adapters.GothUser{}, err
+	}
+
+	return g.completeAuth(ctx, adapter, token)
+}
+
+// completeAuth creates or updates the user of the token.
+//
+//nolint:gocyclo
+func (g *githubProvider) completeAuth(ctx context.Context, adapter adapters.Adapter, token *oauth2.Token) (adapters.GothUser, error) {
+	var err error
+
 	gc := github.NewClient(g.config.Client(ctx, token))
 
 	if utilx.NotEmpty(g.enterpriseURL) {
@@ -190,11 +213,11 @@ func (g *githubProvider) CompleteAuth(ctx context.Context, adapter adapters.Adap
 			{
 				Type:              adapters.AccountTypeOAuth2,
 				Provider:          g.ID(),
-				ProviderAccountID: cast.Ptr(strconv.Itoa(u.ID)),
+				ProviderAccountID: cast.Ptr(strconv.FormatInt(gu.GetID(), 10)),
 				AccessToken:       cast.Ptr(token.AccessToken),
 				RefreshToken:      cast.Ptr(token.RefreshToken),
 				ExpiresAt:         cast.Ptr(token.Expiry),
-				SessionState:      token.Extra("state").(string),
+				SessionState:      sessionState(token),
 			},
 		},
 	}
@@ -242,6 +265,38 @@ func (g *githubProvider) CompleteAuth(ctx context.Context, adapter adapters.Adap
 	return user, nil
 }
 
+// RefreshToken returns a new token by using the refresh token.
+// Only GitHub Apps with expiring user tokens issue refresh tokens.
+func (g *githubProvider) RefreshToken(ctx context.Context, refreshToken string) (*oauth2.Token, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, g.client)
+
+	return g.config.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+}
+
+// RevokeToken revokes the access token and the grant of the OAuth app for the user.
+func (g *githubProvider) RevokeToken(ctx context.Context, accessToken string) error {
+	tp := &github.BasicAuthTransport{
+		Username:  g.clientKey,
+		Password:  g.secret,
+		Transport: g.client.Transport,
+	}
+
+	gc := github.NewClient(tp.Client())
+
+	if utilx.NotEmpty(g.enterpriseURL) {
+		var err error
+
+		gc, err = gc.WithEnterpriseURLs(g.enterpriseURL, g.enterpriseURL)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := gc.Authorizations.DeleteGrant(ctx, g.clientKey, accessToken)
+
+	return err
+}
+
 func newConfig(p *githubProvider, scopes ...string) *oauth2.Config {
 	c := &oauth2.Config{
 		ClientID:     p.clientKey,
@@ -258,6 +313,13 @@ func newConfig(p *githubProvider, scopes ...string) *oauth2.Config {
 	return c
 }
 
+// sessionState returns the state of the token, tokens of the device flow have no state.
+func sessionState(token *oauth2.Token) string {
+	state, _ := token.Extra("state").(string)
+
+	return state
+}
+
 func checkScope(scope string) bool {
 	return strings.TrimSpace(scope) == "user" || strings.TrimSpace(scope) == "user:email"
 }
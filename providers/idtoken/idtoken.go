@@ -0,0 +1,289 @@
+package idtoken
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/katallaxie/fiber-goth/adapters"
+	"github.com/katallaxie/fiber-goth/providers"
+
+	"github.com/katallaxie/pkg/cast"
+	"github.com/katallaxie/pkg/utilx"
+)
+
+var (
+	ErrFailedVerifyToken = errors.New("goth: failed to verify id token")
+	ErrBadIssuer         = errors.New("goth: id token has an unknown issuer")
+	ErrBadAudience       = errors.New("goth: id token has an unknown audience")
+	ErrMissingNonce      = errors.New("goth: missing nonce")
+	ErrBadNonce          = errors.New("goth: nonce does not match the id token")
+	ErrInvalidNonce      = errors.New("goth: invalid or expired nonce")
+	ErrMissingEmail      = errors.New("goth: id token has no email")
+	ErrEmailNotVerified  = errors.New("goth: email of the id token is not verified")
+)
+
+var (
+	_ providers.Provider             = (*idTokenProvider)(nil)
+	_ providers.IDTokenAuthenticator = (*idTokenProvider)(nil)
+)
+
+const (
+	// DefaultNonceExpiry is the default duration a nonce can be used to sign in with.
+	DefaultNonceExpiry = 5 * time.Minute
+	// GoogleJWKSURL is the URL of the keys that sign the ID tokens of Google.
+	GoogleJWKSURL = "https://www.googleapis.com/oauth2/v3/certs"
+	// AppleJWKSURL is the URL of the keys that sign the ID tokens of Apple.
+	AppleJWKSURL = "https://appleid.apple.com/auth/keys"
+)
+
+// GoogleIssuers are the issuers of the ID tokens of Google.
+var GoogleIssuers = []string{"https://accounts.google.com", "accounts.google.com"}
+
+// AppleIssuers are the issuers of the ID tokens of Apple.
+var AppleIssuers = []string{"https://appleid.apple.com"}
+
+type idTokenProvider struct {
+	id           string
+	name         string
+	issuers      []string
+	audiences    []string
+	jwksURL      string
+	nonceExpiry  time.Duration
+	providerType providers.ProviderType
+	client       *http.Client
+	verifier     *oidc.IDTokenVerifier
+
+	providers.UnimplementedProvider
+}
+
+// Opt is a function that configures the ID token provider.
+type Opt func(*idTokenProvider)
+
+// WithName sets the name of the ID token provider.
+func WithName(name string) Opt {
+	return func(p *idTokenProvider) {
+		p.name = name
+	}
+}
+
+// WithClient sets the HTTP client to fetch the keys of the ID token provider.
+func WithClient(client *http.Client) Opt {
+	return func(p *idTokenProvider) {
+		p.client = client
+	}
+}
+
+// WithNonceExpiry sets the duration a nonce can be used to sign in with.
+func WithNonceExpiry(expiry time.Duration) Opt {
+	return func(p *idTokenProvider) {
+		p.nonceExpiry = expiry
+	}
+}
+
+// New creates a new provider that signs in users with ID tokens of the issuers, which are signed by the keys at the JWKS URL.
+// The audiences are the client IDs of the apps the ID tokens are issued to, at least one of them has to be in the ID token.
+// The provider only supports the sign-in with ID tokens, it does not support the sign-in with a browser redirect.
+func New(id string, issuers []string, jwksURL string, audiences []string, opts ...Opt) providers.Provider {
+	p := &idTokenProvider{
+		id:           id,
+		name:         id,
+		issuers:      issuers,
+		audiences:    audiences,
+		jwksURL:      jwksURL,
+		nonceExpiry:  DefaultNonceExpiry,
+		providerType: providers.ProviderTypeOIDC,
+		client:       providers.DefaultClient,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	// The key set fetches the keys in the background, so it must not use a request context.
+	keys := oidc.NewRemoteKeySet(oidc.ClientContext(context.Background(), p.client), p.jwksURL)
+
+	// The issuers and audiences are checked against the lists of the provider.
+	p.verifier = oidc.NewVerifier("", keys, &oidc.Config{SkipClientIDCheck: true, SkipIssuerCheck: true})
+
+	return p
+}
+
+// Google creates a new provider that signs in users with ID tokens of the Google sign-in SDKs.
+// The client IDs are the OAuth client IDs of the apps, e.g. of the Android, iOS and web apps.
+func Google(clientIDs []string, opts ...Opt) providers.Provider {
+	return New("google", GoogleIssuers, GoogleJWKSURL, clientIDs, append([]Opt{WithName("Google")}, opts...)...)
+}
+
+// Apple creates a new provider that signs in users with ID tokens of Sign in with Apple.
+// The client IDs are the bundle IDs of the apps and the service IDs of the web apps.
+func Apple(clientIDs []string, opts ...Opt) providers.Provider {
+	return New("apple", AppleIssuers, AppleJWKSURL, clientIDs, append([]Opt{WithName("Apple")}, opts...)...)
+}
+
+// ID returns the provider's ID.
+func (p *idTokenProvider) ID() string {
+	return p.id
+}
+
+// Name returns the provider's name.
+func (p *idTokenProvider) Name() string {
+	return p.name
+}
+
+// Type returns the provider's type.
+func (p *idTokenProvider) Type() providers.ProviderType {
+	return p.providerType
+}
+
+// BeginIDTokenAuth issues a nonce that can be used once to request an ID token with.
+// Only the hash of the nonce is stored.
+func (p *idTokenProvider) BeginIDTokenAuth(ctx context.Context, adapter adapters.Adapter) (providers.IDTokenNonce, error) {
+	nonce := providers.IDTokenNonce{
+		Nonce:     rand.Text(),
+		ExpiresAt: time.Now().Add(p.nonceExpiry),
+	}
+
+	_, err := adapter.CreateVerificationToken(ctx, adapters.GothVerificationToken{
+		Token:      hashNonce(nonce.Nonce),
+		Identifier: p.nonceIdentifier(),
+		ExpiresAt:  nonce.ExpiresAt,
+	})
+	if err != nil {
+		return providers.IDTokenNonce{}, err
+	}
+
+	return nonce, nil
+}
+
+// CompleteIDTokenAuth verifies the ID token and its nonce, and creates or updates the user and account of the token.
+//
+//nolint:gocyclo
+func (p *idTokenProvider) CompleteIDTokenAuth(ctx context.Context, adapter adapters.Adapter, token providers.IDToken) (adapters.GothUser, error) {
+	idToken, err := p.verifier.Verify(ctx, token.Token)
+	if err != nil {
+		return adapters.GothUser{}, ErrFailedVerifyToken
+	}
+
+	if !slices.Contains(p.issuers, idToken.Issuer) {
+		return adapters.GothUser{}, ErrBadIssuer
+	}
+
+	if !slices.ContainsFunc(idToken.Audience, func(aud string) bool { return slices.Contains(p.audiences, aud) }) {
+		return adapters.GothUser{}, ErrBadAudience
+	}
+
+	if utilx.Empty(token.Nonce) {
+		return adapters.GothUser{}, ErrMissingNonce
+	}
+
+	if !validNonce(idToken.Nonce, token.Nonce) {
+		return adapters.GothUser{}, ErrBadNonce
+	}
+
+	// The nonce is used once, the ID token cannot be replayed.
+	if _, err := adapter.UseVerficationToken(ctx, p.nonceIdentifier(), hashNonce(token.Nonce)); err != nil {
+		return adapters.GothUser{}, ErrInvalidNonce
+	}
+
+	var claims struct {
+		Name     string    `json:"name"`
+		Email    string    `json:"email"`
+		Verified boolClaim `json:"email_verified"`
+		Picture  string    `json:"picture"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return adapters.GothUser{}, err
+	}
+
+	if utilx.Empty(claims.Email) {
+		return adapters.GothUser{}, ErrMissingEmail
+	}
+
+	if !claims.Verified {
+		return adapters.GothUser{}, ErrEmailNotVerified
+	}
+
+	user := adapters.GothUser{
+		Name:  claims.Name,
+		Email: claims.Email,
+	}
+
+	if !utilx.Empty(claims.Picture) {
+		user.Image = cast.Ptr(claims.Picture)
+	}
+
+	user, err = adapter.CreateUser(ctx, user)
+	if err != nil {
+		return adapters.GothUser{}, err
+	}
+
+	account := adapters.GothAccount{
+		Type:              adapters.AccountTypeOIDC,
+		Provider:          p.ID(),
+		ProviderAccountID: cast.Ptr(idToken.Subject),
+		IDToken:           cast.Ptr(token.Token),
+	}
+
+	if !utilx.Empty(token.AccessToken) {
+		account.AccessToken = cast.Ptr(token.AccessToken)
+	}
+
+	if !utilx.Empty(token.RefreshToken) {
+		account.RefreshToken = cast.Ptr(token.RefreshToken)
+	}
+
+	if !token.ExpiresAt.IsZero() {
+		account.ExpiresAt = cast.Ptr(token.ExpiresAt)
+	}
+
+	_, err = adapter.LinkAccount(ctx, user.ID, account)
+	if err != nil {
+		return adapters.GothUser{}, err
+	}
+
+	return user, nil
+}
+
+// validNonce returns true if the nonce of the ID token is the nonce of the client,
+// or the hex-encoded SHA-256 hash of it, which the Apple SDKs request the ID token with.
+func validNonce(tokenNonce, nonce string) bool {
+	return subtle.ConstantTimeCompare([]byte(tokenNonce), []byte(nonce)) == 1 ||
+		subtle.ConstantTimeCompare([]byte(tokenNonce), []byte(hashNonce(nonce))) == 1
+}
+
+// hashNonce returns the hex-encoded SHA-256 hash of the nonce.
+func hashNonce(nonce string) string {
+	h := sha256.Sum256([]byte(nonce))
+
+	return hex.EncodeToString(h[:])
+}
+
+// nonceIdentifier returns the identifier of the nonces of the provider.
+func (p *idTokenProvider) nonceIdentifier() string {
+	return "id-token-nonce:" + p.id
+}
+
+// boolClaim is a boolean claim that is also accepted as string, e.g. "email_verified" of Apple.
+type boolClaim bool
+
+// UnmarshalJSON unmarshals a boolean or a string of a boolean.
+func (b *boolClaim) UnmarshalJSON(data []byte) error {
+	v, err := strconv.ParseBool(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+
+	*b = boolClaim(v)
+
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/katallaxie/fiber-goth/adapters"
+
+	"golang.org/x/oauth2"
 )
 
 const (
@@ -26,11 +28,16 @@ var DefaultClient = &http.Client{
 	Timeout: defaultTimeout,
 }
 
-// ErrUnimplemented is returned when a method is not implemented.
-var ErrUnimplemented = errors.New("not implemented")
-
-// ErrNoAuthURL is returned when an AuthURL has not been set.
-var ErrNoAuthURL = errors.New("an AuthURL has not been set")
+var (
+	// ErrUnimplemented is returned when a method is not implemented.
+	ErrUnimplemented = errors.New("not implemented")
+	// ErrNoAuthURL is returned when an AuthURL has not been set.
+	ErrNoAuthURL = errors.New("an AuthURL has not been set")
+	// ErrFailedVerifyToken is returned when a token verification fails.
+	ErrFailedVerifyToken = errors.New("failed to verify token")
+	// ErrMissingPrimaryEmail is returned when a primary email is not found.
+	ErrMissingPrimaryEmail = errors.New("missing primary email")
+)
 
 // Provider needs to be implemented for each 3rd party authentication provider.
 type Provider interface {
@@ -48,15 +55,71 @@ type Provider interface {
 	CompleteAuth(ctx context.Context, adapter adapters.Adapter, params AuthParams) (adapters.GothUser, error)
 }
 
+// TokenRefresher is implemented by providers that are able to refresh access tokens.
+type TokenRefresher interface {
+	// RefreshToken returns a new token by using the refresh token.
+	RefreshToken(ctx context.Context, refreshToken string) (*oauth2.Token, error)
+}
+
+// TokenRevoker is implemented by providers that are able to revoke tokens.
+type TokenRevoker interface {
+	// RevokeToken revokes the access token and the grant it belongs to.
+	RevokeToken(ctx context.Context, accessToken string) error
+}
+
+// IDToken is an ID token that a client has received from the provider, e.g. with a native sign-in SDK,
+// and the tokens that have been issued with it.
+type IDToken struct {
+	// Token is the raw ID token.
+	Token string
+	// Nonce is the nonce the client has requested the ID token with, which has been issued by BeginIDTokenAuth.
+	Nonce string
+	// AccessToken is the access token that has been issued with the ID token, if any.
+	AccessToken string
+	// RefreshToken is the refresh token that has been issued with the ID token, if any.
+	RefreshToken string
+	// ExpiresAt is the expiry time of the access token, if any.
+	ExpiresAt time.Time
+}
+
+// IDTokenNonce is a nonce that a client requests an ID token with.
+type IDTokenNonce struct {
+	// Nonce is the value of the nonce.
+	Nonce string
+	// ExpiresAt is the time the nonce expires.
+	ExpiresAt time.Time
+}
+
+// IDTokenAuthenticator is implemented by providers that sign in users with an ID token of the provider,
+// e.g. of the native sign-in SDKs of Google or Apple on mobile devices.
+type IDTokenAuthenticator interface {
+	// BeginIDTokenAuth issues a nonce that can be used once to request an ID token with.
+	BeginIDTokenAuth(ctx context.Context, adapter adapters.Adapter) (IDTokenNonce, error)
+	// CompleteIDTokenAuth verifies the ID token and its nonce, and creates or updates the user and account of the token.
+	CompleteIDTokenAuth(ctx context.Context, adapter adapters.Adapter, token IDToken) (adapters.GothUser, error)
+}
+
 // AuthParams is the type of authentication parameters.
 type AuthParams interface {
+	//  Get returns the value of a parameter by name.
 	Get(string) string
+	// CodeVerifier returns the code verifier for PKCE, if applicable.
+	CodeVerifier() string
 }
 
 // AuthIntent is the type of authentication intent.
 type AuthIntent interface {
 	// GetAuthURL returns the URL for the authentication end-point.
 	GetAuthURL() (string, error)
+	// CodeVerifier returns the code verifier for PKCE, if applicable.
+	CodeVerifier() string
+}
+
+// AuthFormIntent is implemented by intents that send the user with a form instead of a redirect,
+// e.g. the HTTP-POST binding of SAML.
+type AuthFormIntent interface {
+	// GetAuthForm returns the HTML page that submits the form to the authentication end-point.
+	GetAuthForm() ([]byte, error)
 }
 
 // PrioviderType is the type of provider.
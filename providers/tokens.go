@@ -0,0 +1,107 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/katallaxie/fiber-goth/adapters"
+
+	"github.com/google/uuid"
+	"github.com/katallaxie/pkg/cast"
+	"github.com/katallaxie/pkg/utilx"
+)
+
+// TokenExpiryDelta is the time before its expiry at which an access token is refreshed.
+const TokenExpiryDelta = time.Minute
+
+var (
+	// ErrRefreshUnsupported is returned when a provider cannot refresh access tokens.
+	ErrRefreshUnsupported = errors.New("provider does not support refreshing tokens")
+	// ErrMissingRefreshToken is returned when an account has no refresh token.
+	ErrMissingRefreshToken = errors.New("missing refresh token")
+)
+
+// AccessToken returns the account of the user for the provider with a valid access token.
+// An access token that is about to expire is refreshed and written back through the adapter.
+func AccessToken(ctx context.Context, adapter adapters.Adapter, userID uuid.UUID, provider string) (adapters.GothAccount, error) {
+	account, err := adapter.GetAccount(ctx, userID, provider)
+	if err != nil {
+		return adapters.GothAccount{}, err
+	}
+
+	return ValidAccessToken(ctx, adapter, account)
+}
+
+// ValidAccessToken returns the account with a valid access token.
+// An access token that is about to expire is refreshed and written back through the adapter.
+func ValidAccessToken(ctx context.Context, adapter adapters.Adapter, account adapters.GothAccount) (adapters.GothAccount, error) {
+	if !account.TokenExpiresWithin(TokenExpiryDelta) {
+		return account, nil
+	}
+
+	return RefreshAccessToken(ctx, adapter, account)
+}
+
+// RefreshAccessToken refreshes the access token of the account and writes it back through the adapter.
+func RefreshAccessToken(ctx context.Context, adapter adapters.Adapter, account adapters.GothAccount) (adapters.GothAccount, error) {
+	p, err := GetProvider(account.Provider)
+	if err != nil {
+		return adapters.GothAccount{}, err
+	}
+
+	refresher, ok := p.(TokenRefresher)
+	if !ok {
+		return adapters.GothAccount{}, ErrRefreshUnsupported
+	}
+
+	if utilx.Empty(cast.Value(account.RefreshToken)) {
+		return adapters.GothAccount{}, ErrMissingRefreshToken
+	}
+
+	token, err := refresher.RefreshToken(ctx, cast.Value(account.RefreshToken))
+	if err != nil {
+		return adapters.GothAccount{}, err
+	}
+
+	account.AccessToken = cast.Ptr(token.AccessToken)
+	account.ExpiresAt = cast.Ptr(token.Expiry)
+
+	// Providers may rotate the refresh token.
+	if utilx.NotEmpty(token.RefreshToken) {
+		account.RefreshToken = cast.Ptr(token.RefreshToken)
+	}
+
+	if utilx.NotEmpty(token.TokenType) {
+		account.TokenType = cast.Ptr(token.TokenType)
+	}
+
+	if idToken, ok := token.Extra("id_token").(string); ok {
+		account.IDToken = cast.Ptr(idToken)
+	}
+
+	return adapter.UpdateAccount(ctx, account)
+}
+
+// RevokeTokens revokes the access tokens of the accounts at the providers that support revocation.
+func RevokeTokens(ctx context.Context, accounts ...adapters.GothAccount) error {
+	var errs []error
+
+	for _, account := range accounts {
+		p, err := GetProvider(account.Provider)
+		if err != nil {
+			continue
+		}
+
+		revoker, ok := p.(TokenRevoker)
+		if !ok || utilx.Empty(cast.Value(account.AccessToken)) {
+			continue
+		}
+
+		if err := revoker.RevokeToken(ctx, cast.Value(account.AccessToken)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
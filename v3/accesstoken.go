@@ -0,0 +1,133 @@
+package goth
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+)
+
+// PersonalAccessToken returns a middleware that authenticates requests with a personal access token
+// in the "Authorization: Bearer pat_..." header. It attaches the same session and user to the request
+// as the Session middleware, and the scopes of the token for RequireScopes.
+// Requests without a personal access token are passed on.
+// Tokens that are limited to an organization are revoked once the user is no longer a member of it.
+// The adapter has to implement the personal access token adapter.
+func PersonalAccessToken(config ...Config) fiber.Handler {
+	cfg := configDefault(config...)
+
+	return func(c fiber.Ctx) error {
+		if cfg.Next != nil && cfg.Next(c) {
+			return c.Next()
+		}
+
+		value, ok := bearerToken(c)
+		if !ok || !strings.HasPrefix(value, adapters.PersonalAccessTokenPrefix) {
+			return c.Next()
+		}
+
+		store, ok := cfg.Adapter.(adapters.PersonalAccessTokenAdapter)
+		if !ok {
+			return ErrMissingAdapter
+		}
+
+		token, err := store.GetPersonalAccessToken(c, adapters.HashPersonalAccessToken(value))
+		if err != nil || !token.IsValid() {
+			return unauthorized(c, ErrBadPersonalAccessToken)
+		}
+
+		if token.OrganizationID != nil {
+			orgs, ok := cfg.Adapter.(adapters.OrganizationAdapter)
+			if !ok {
+				return ErrMissingAdapter
+			}
+
+			_, err := orgs.GetMember(c, *token.OrganizationID, token.UserID)
+			if err != nil {
+				err := store.DeletePersonalAccessToken(c, token.ID, token.UserID)
+				if err != nil {
+					return err
+				}
+
+				return unauthorized(c, ErrBadPersonalAccessToken)
+			}
+		}
+
+		now := time.Now()
+		token.LastUsedAt = &now
+
+		token, err = store.UpdatePersonalAccessToken(c, token)
+		if err != nil {
+			return err
+		}
+
+		// The session of the token lasts for the request.
+		session := adapters.GothSession{
+			ID:                   token.ID,
+			UserID:               token.UserID,
+			ActiveOrganizationID: token.OrganizationID,
+			ExpiresAt:            now.Add(time.Minute),
+		}
+
+		c.Locals(tokenKey, token.ID)
+		c.Locals(sessionKey, session)
+		c.Locals(userIDKey, token.UserID)
+		c.Locals(personalAccessTokenKey, token)
+		c.Locals(scopesKey, token.Scopes())
+
+		return c.Next()
+	}
+}
+
+// PersonalAccessTokenFromContext returns the personal access token the request has been authenticated with from the request context.
+func PersonalAccessTokenFromContext(c fiber.Ctx) (adapters.GothPersonalAccessToken, error) {
+	token, ok := c.Locals(personalAccessTokenKey).(adapters.GothPersonalAccessToken)
+	if !ok {
+		return adapters.GothPersonalAccessToken{}, ErrMissingPersonalAccessToken
+	}
+
+	return token, nil
+}
+
+// ScopesFromContext returns the scopes of the API key or personal access token the request has been authenticated with.
+// It returns false for requests of signed-in users, which are not limited by scopes.
+func ScopesFromContext(c fiber.Ctx) ([]string, bool) {
+	scopes, ok := c.Locals(scopesKey).([]string)
+	return scopes, ok
+}
+
+// RequireScopes returns a middleware that requires the API key or personal access token of the request
+// to have all of the scopes. A scope is also granted by its parent, e.g. "repo:read" by "repo".
+// Requests of signed-in users are not limited by scopes.
+// It depends on the session attached by the Session, APIKey or PersonalAccessToken middleware.
+func RequireScopes(scopes ...string) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if _, err := SessionFromContext(c); err != nil {
+			return err
+		}
+
+		granted, ok := ScopesFromContext(c)
+		if !ok {
+			return c.Next()
+		}
+
+		for _, scope := range scopes {
+			if !scopeGranted(granted, scope) {
+				c.Set(fiber.HeaderWWWAuthenticate, `Bearer error="insufficient_scope", scope="`+strings.Join(scopes, " ")+`"`)
+
+				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"message": ErrInsufficientScope.Error()})
+			}
+		}
+
+		return c.Next()
+	}
+}
+
+// scopeGranted returns true if the scope or one of its parents has been granted.
+func scopeGranted(granted []string, scope string) bool {
+	return slices.ContainsFunc(granted, func(g string) bool {
+		return g == scope || strings.HasPrefix(scope, g+":")
+	})
+}
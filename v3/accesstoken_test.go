@@ -0,0 +1,58 @@
+package goth_test
+
+import (
+	"net/http"
+	"testing"
+
+	goth "github.com/katallaxie/fiber-goth/v3"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+func TestRequireScopes(t *testing.T) {
+	tests := []struct {
+		name     string
+		granted  []string
+		required []string
+		want     int
+	}{
+		{"same scope", []string{"repo:read"}, []string{"repo:read"}, http.StatusOK},
+		{"parent scope", []string{"repo"}, []string{"repo:read"}, http.StatusOK},
+		{"grandparent scope", []string{"repo"}, []string{"repo:read:branches"}, http.StatusOK},
+		{"all scopes", []string{"repo", "user:email"}, []string{"repo:write", "user:email"}, http.StatusOK},
+		{"sibling scope", []string{"repo:read"}, []string{"repo:write"}, http.StatusForbidden},
+		{"child scope", []string{"repo:read"}, []string{"repo"}, http.StatusForbidden},
+		{"scope with the same prefix", []string{"repo"}, []string{"repository:read"}, http.StatusForbidden},
+		{"missing scope", []string{"repo"}, []string{"repo:read", "user"}, http.StatusForbidden},
+		{"no scopes", nil, []string{"repo"}, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter := newAdapter()
+			cfg := adapter.config()
+			token := adapter.createToken(adapter.createUser().ID, tt.granted...)
+
+			app := fiber.New()
+			app.Use(goth.PersonalAccessToken(cfg))
+			app.Get("/", goth.RequireScopes(tt.required...), func(c fiber.Ctx) error {
+				return c.SendStatus(http.StatusOK)
+			})
+
+			res, _ := request(t, app, fiber.MethodGet, "/", token, "")
+			if res.StatusCode != tt.want {
+				t.Errorf("status = %d, want %d", res.StatusCode, tt.want)
+			}
+		})
+	}
+}
+
+func (a *memAdapter) createToken(userID uuid.UUID, scopes ...string) string {
+	value, token := adapters.NewPersonalAccessToken(userID, "cli", scopes...)
+	token.ID = uuid.New()
+	a.tokens[token.TokenHash] = token
+
+	return value
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/gob"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,8 +22,14 @@ func init() {
 // AccountType represents the type of an account.
 type AccountType string
 
-// ErrUnimplemented is returned when a method is not implemented.
-var ErrUnimplemented = errors.New("not implemented")
+var (
+	// ErrUnimplemented is returned when a method is not implemented.
+	ErrUnimplemented = errors.New("not implemented")
+	// ErrAccountAlreadyLinked is returned when a provider account is linked to another user.
+	ErrAccountAlreadyLinked = errors.New("account is already linked to another user")
+	// ErrLastAccount is returned when the last sign-in method of a user would be removed.
+	ErrLastAccount = errors.New("cannot remove the last sign-in method")
+)
 
 const (
 	// AccountTypeOAuth2 represents an OAuth2 account type.
@@ -73,6 +80,16 @@ type GothAccount struct {
 	DeletedAt gorm.DeletedAt `json:"deleted_at"`
 }
 
+// TokenExpiresWithin returns true if the access token of the account expires within the duration.
+// Access tokens without an expiry time never expire.
+func (a *GothAccount) TokenExpiresWithin(d time.Duration) bool {
+	if a.ExpiresAt == nil || a.ExpiresAt.IsZero() {
+		return false
+	}
+
+	return a.ExpiresAt.Before(time.Now().Add(d))
+}
+
 // GothUser is a user of the application.
 type GothUser struct {
 	// ID is the unique identifier of the user.
@@ -113,6 +130,24 @@ type GothSession struct {
 	User GothUser `json:"user"`
 	// ExpiresAt is the expiry time of the session.
 	ExpiresAt time.Time `json:"expires_at"`
+	// IPAddress is the IP address of the client that created the session.
+	IPAddress string `json:"ip_address"`
+	// UserAgent is the user agent of the client that created the session.
+	UserAgent string `json:"user_agent"`
+	// ActiveOrganizationID is the ID of the active organization of the session.
+	ActiveOrganizationID *uuid.UUID `json:"active_organization_id"`
+	// ActiveTeamID is the ID of the active team of the session.
+	ActiveTeamID *uuid.UUID `json:"active_team_id"`
+	// TwoFactorPending is true until the user has verified the second factor of the session.
+	TwoFactorPending bool `json:"two_factor_pending"`
+	// AuthTime is the time the user authenticated, e.g. the auth_time claim of the ID token.
+	AuthTime time.Time `json:"auth_time"`
+	// AuthMethod is the ID of the provider the user authenticated with.
+	AuthMethod string `json:"auth_method"`
+	// ACR is the authentication context class reference of the authentication.
+	ACR string `json:"acr"`
+	// AMR are the comma-separated authentication method references of the authentication.
+	AMR string `json:"amr"`
 	// CreatedAt is the creation time of the session.
 	CreatedAt time.Time `json:"created_at"`
 	// UpdatedAt is the update time of the session.
@@ -121,6 +156,67 @@ type GothSession struct {
 	DeletedAt gorm.DeletedAt `json:"deleted_at"`
 }
 
+// SessionOpt is a function that configures a new session.
+type SessionOpt func(*GothSession)
+
+// WithIPAddress sets the IP address of the client for a new session.
+func WithIPAddress(ip string) SessionOpt {
+	return func(s *GothSession) {
+		s.IPAddress = ip
+	}
+}
+
+// WithUserAgent sets the user agent of the client for a new session.
+func WithUserAgent(ua string) SessionOpt {
+	return func(s *GothSession) {
+		s.UserAgent = ua
+	}
+}
+
+// WithTwoFactorPending creates a new session that is pending until the second factor is verified.
+func WithTwoFactorPending() SessionOpt {
+	return func(s *GothSession) {
+		s.TwoFactorPending = true
+	}
+}
+
+// WithAuthTime sets the time the user authenticated for a new session.
+func WithAuthTime(t time.Time) SessionOpt {
+	return func(s *GothSession) {
+		s.AuthTime = t
+	}
+}
+
+// WithAuthMethod sets the ID of the provider the user authenticated with for a new session.
+func WithAuthMethod(method string) SessionOpt {
+	return func(s *GothSession) {
+		s.AuthMethod = method
+	}
+}
+
+// WithACR sets the authentication context class reference for a new session.
+func WithACR(acr string) SessionOpt {
+	return func(s *GothSession) {
+		s.ACR = acr
+	}
+}
+
+// WithAMR sets the authentication method references for a new session.
+func WithAMR(amr ...string) SessionOpt {
+	return func(s *GothSession) {
+		s.AMR = strings.Join(amr, ",")
+	}
+}
+
+// AuthMethods returns the authentication method references of the session.
+func (s *GothSession) AuthMethods() []string {
+	if s.AMR == "" {
+		return []string{}
+	}
+
+	return strings.Split(s.AMR, ",")
+}
+
 // GetUser returns the user of the session.
 func (s *GothSession) GetUser() GothUser {
 	return s.User
@@ -147,6 +243,11 @@ func (s *GothSession) IsValid() bool {
 	return s.ExpiresAt.After(time.Now())
 }
 
+// IsFresh returns true if the session has been created within the given age.
+func (s *GothSession) IsFresh(age time.Duration) bool {
+	return time.Since(s.CreatedAt) <= age
+}
+
 // GetCsrfToken returns the CSRF token.
 func (s *GothSession) GetCsrfToken() GothCsrfToken {
 	return s.CsrfToken
@@ -168,6 +269,8 @@ type GothVerificationToken struct {
 	Token string `json:"token" gorm:"primaryKey"`
 	// Identifier is the identifier of the token.
 	Identifier string `json:"identifier"`
+	// Attempts is the number of attempts to use the token.
+	Attempts int `json:"attempts"`
 	// ExpiresAt is the expiry time of the token.
 	ExpiresAt time.Time `json:"expires_at"`
 	// CreatedAt is the creation time of the token.
@@ -178,6 +281,11 @@ type GothVerificationToken struct {
 	DeletedAt gorm.DeletedAt `json:"deleted_at"`
 }
 
+// HasExpired returns true if the token has expired.
+func (t GothVerificationToken) HasExpired() bool {
+	return t.ExpiresAt.Before(time.Now())
+}
+
 // Adapter is an interface that defines the methods for interacting with the underlying data storage.
 type Adapter interface {
 	// CreateUser creates a new user.
@@ -186,16 +294,24 @@ type Adapter interface {
 	GetUser(ctx context.Context, id uuid.UUID) (GothUser, error)
 	// GetUserByEmail retrieves a user by email.
 	GetUserByEmail(ctx context.Context, email string) (GothUser, error)
+	// GetUserByAccount retrieves the user of the account of a provider.
+	GetUserByAccount(ctx context.Context, provider, providerAccountID string) (GothUser, error)
 	// UpdateUser updates a user.
 	UpdateUser(ctx context.Context, user GothUser) (GothUser, error)
-	// DeleteUser deletes a user by ID.
+	// DeleteUser deletes a user by ID including the accounts, sessions and tokens of the user.
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	// GetAccount retrieves the account of a user for a provider.
+	GetAccount(ctx context.Context, userID uuid.UUID, provider string) (GothAccount, error)
+	// UpdateAccount updates an account.
+	UpdateAccount(ctx context.Context, account GothAccount) (GothAccount, error)
+	// ListAccounts retrieves all accounts of a user.
+	ListAccounts(ctx context.Context, userID uuid.UUID) ([]GothAccount, error)
 	// LinkAccount links an account to a user.
-	LinkAccount(ctx context.Context, accountID, userID uuid.UUID) error
+	LinkAccount(ctx context.Context, userID uuid.UUID, account GothAccount) (GothAccount, error)
 	// UnlinkAccount unlinks an account from a user.
 	UnlinkAccount(ctx context.Context, accountID, userID uuid.UUID) error
 	// CreateSession creates a new session.
-	CreateSession(ctx context.Context, userID uuid.UUID, expires time.Time) (GothSession, error)
+	CreateSession(ctx context.Context, userID uuid.UUID, expires time.Time, opts ...SessionOpt) (GothSession, error)
 	// GetSession retrieves a session by session token.
 	GetSession(ctx context.Context, sessionToken string) (GothSession, error)
 	// UpdateSession updates a session.
@@ -204,10 +320,20 @@ type Adapter interface {
 	RefreshSession(ctx context.Context, session GothSession) (GothSession, error)
 	// DeleteSession deletes a session by session token.
 	DeleteSession(ctx context.Context, sessionToken string) error
+	// ListSessions retrieves all active sessions of a user.
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]GothSession, error)
+	// DeleteSessionsByUser deletes all sessions of a user except the given session tokens.
+	DeleteSessionsByUser(ctx context.Context, userID uuid.UUID, except ...string) error
 	// CreateVerificationToken creates a new verification token.
 	CreateVerificationToken(ctx context.Context, verficationToken GothVerificationToken) (GothVerificationToken, error)
 	// UseVerficationToken uses a verification token.
 	UseVerficationToken(ctx context.Context, identifier, token string) (GothVerificationToken, error)
+	// GetVerificationToken retrieves the latest verification token of an identifier.
+	GetVerificationToken(ctx context.Context, identifier string) (GothVerificationToken, error)
+	// IncrementVerificationAttempts counts an attempt to use a verification token.
+	IncrementVerificationAttempts(ctx context.Context, identifier, token string) (GothVerificationToken, error)
+	// DeleteVerificationTokens deletes all verification tokens of an identifier.
+	DeleteVerificationTokens(ctx context.Context, identifier string) error
 }
 
 var _ Adapter = (*UnimplementedAdapter)(nil)
@@ -230,7 +356,7 @@ func (a *UnimplementedAdapter) GetUserByEmail(_ context.Context, _ string) (Goth
 	return GothUser{}, ErrUnimplemented
 }
 
-// GetUserByAccount retrieves a user by account.
+// GetUserByAccount retrieves the user of the account of a provider.
 func (a *UnimplementedAdapter) GetUserByAccount(_ context.Context, _, _ string) (GothUser, error) {
 	return GothUser{}, ErrUnimplemented
 }
@@ -245,9 +371,24 @@ func (a *UnimplementedAdapter) DeleteUser(_ context.Context, _ uuid.UUID) error
 	return ErrUnimplemented
 }
 
+// GetAccount retrieves the account of a user for a provider.
+func (a *UnimplementedAdapter) GetAccount(_ context.Context, _ uuid.UUID, _ string) (GothAccount, error) {
+	return GothAccount{}, ErrUnimplemented
+}
+
+// UpdateAccount updates an account.
+func (a *UnimplementedAdapter) UpdateAccount(_ context.Context, _ GothAccount) (GothAccount, error) {
+	return GothAccount{}, ErrUnimplemented
+}
+
+// ListAccounts retrieves all accounts of a user.
+func (a *UnimplementedAdapter) ListAccounts(_ context.Context, _ uuid.UUID) ([]GothAccount, error) {
+	return nil, ErrUnimplemented
+}
+
 // LinkAccount links an account to a user.
-func (a *UnimplementedAdapter) LinkAccount(_ context.Context, _, _ uuid.UUID) error {
-	return ErrUnimplemented
+func (a *UnimplementedAdapter) LinkAccount(_ context.Context, _ uuid.UUID, _ GothAccount) (GothAccount, error) {
+	return GothAccount{}, ErrUnimplemented
 }
 
 // UnlinkAccount unlinks an account from a user.
@@ -256,7 +397,7 @@ func (a *UnimplementedAdapter) UnlinkAccount(_ context.Context, _, _ uuid.UUID)
 }
 
 // CreateSession creates a new session.
-func (a *UnimplementedAdapter) CreateSession(_ context.Context, _ uuid.UUID, _ time.Time) (GothSession, error) {
+func (a *UnimplementedAdapter) CreateSession(_ context.Context, _ uuid.UUID, _ time.Time, _ ...SessionOpt) (GothSession, error) {
 	return GothSession{}, ErrUnimplemented
 }
 
@@ -280,6 +421,16 @@ func (a *UnimplementedAdapter) DeleteSession(_ context.Context, _ string) error
 	return ErrUnimplemented
 }
 
+// ListSessions retrieves all active sessions of a user.
+func (a *UnimplementedAdapter) ListSessions(_ context.Context, _ uuid.UUID) ([]GothSession, error) {
+	return nil, ErrUnimplemented
+}
+
+// DeleteSessionsByUser deletes all sessions of a user except the given session tokens.
+func (a *UnimplementedAdapter) DeleteSessionsByUser(_ context.Context, _ uuid.UUID, _ ...string) error {
+	return ErrUnimplemented
+}
+
 // CreateVerificationToken creates a new verification token.
 func (a *UnimplementedAdapter) CreateVerificationToken(_ context.Context, _ GothVerificationToken) (GothVerificationToken, error) {
 	return GothVerificationToken{}, ErrUnimplemented
@@ -289,3 +440,18 @@ func (a *UnimplementedAdapter) CreateVerificationToken(_ context.Context, _ Goth
 func (a *UnimplementedAdapter) UseVerficationToken(_ context.Context, _, _ string) (GothVerificationToken, error) {
 	return GothVerificationToken{}, ErrUnimplemented
 }
+
+// GetVerificationToken retrieves the latest verification token of an identifier.
+func (a *UnimplementedAdapter) GetVerificationToken(_ context.Context, _ string) (GothVerificationToken, error) {
+	return GothVerificationToken{}, ErrUnimplemented
+}
+
+// IncrementVerificationAttempts counts an attempt to use a verification token.
+func (a *UnimplementedAdapter) IncrementVerificationAttempts(_ context.Context, _, _ string) (GothVerificationToken, error) {
+	return GothVerificationToken{}, ErrUnimplemented
+}
+
+// DeleteVerificationTokens deletes all verification tokens of an identifier.
+func (a *UnimplementedAdapter) DeleteVerificationTokens(_ context.Context, _ string) error {
+	return ErrUnimplemented
+}
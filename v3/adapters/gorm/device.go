@@ -0,0 +1,79 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	goth "github.com/katallaxie/fiber-goth/v3"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+)
+
+// CreateTrustedDevice is a helper function to create a trusted device.
+func (a *gormAdapter) CreateTrustedDevice(ctx context.Context, device adapters.GothTrustedDevice) (adapters.GothTrustedDevice, error) {
+	err := a.db.WithContext(ctx).Omit(clause.Associations).Create(&device).Error
+	if err != nil {
+		return adapters.GothTrustedDevice{}, goth.ErrBadRequest
+	}
+
+	return device, nil
+}
+
+// GetTrustedDevice is a helper function to retrieve a trusted device by ID.
+func (a *gormAdapter) GetTrustedDevice(ctx context.Context, id uuid.UUID) (adapters.GothTrustedDevice, error) {
+	var device adapters.GothTrustedDevice
+	err := a.db.WithContext(ctx).Where("id = ?", id).First(&device).Error
+	if err != nil {
+		return adapters.GothTrustedDevice{}, goth.ErrMissingTrustedDevice
+	}
+
+	return device, nil
+}
+
+// UpdateTrustedDevice is a helper function to update the last use of a trusted device.
+func (a *gormAdapter) UpdateTrustedDevice(ctx context.Context, device adapters.GothTrustedDevice) (adapters.GothTrustedDevice, error) {
+	err := a.db.WithContext(ctx).Model(&adapters.GothTrustedDevice{}).Omit(clause.Associations).Where("id = ?", device.ID).
+		Select("last_used_at").Updates(&device).Error
+	if err != nil {
+		return adapters.GothTrustedDevice{}, goth.ErrBadRequest
+	}
+
+	return device, nil
+}
+
+// ListTrustedDevices is a helper function to list the unexpired trusted devices of a user.
+func (a *gormAdapter) ListTrustedDevices(ctx context.Context, userID uuid.UUID) ([]adapters.GothTrustedDevice, error) {
+	var devices []adapters.GothTrustedDevice
+	err := a.db.WithContext(ctx).Where("user_id = ? AND expires_at > ?", userID, time.Now()).Order("created_at").Find(&devices).Error
+	if err != nil {
+		return nil, goth.ErrBadRequest
+	}
+
+	return devices, nil
+}
+
+// DeleteTrustedDevice is a helper function to delete a trusted device of a user.
+func (a *gormAdapter) DeleteTrustedDevice(ctx context.Context, id, userID uuid.UUID) error {
+	res := a.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).Delete(&adapters.GothTrustedDevice{})
+	if res.Error != nil {
+		return goth.ErrBadRequest
+	}
+
+	if res.RowsAffected == 0 {
+		return goth.ErrMissingTrustedDevice
+	}
+
+	return nil
+}
+
+// DeleteTrustedDevices is a helper function to delete all trusted devices of a user.
+func (a *gormAdapter) DeleteTrustedDevices(ctx context.Context, userID uuid.UUID) error {
+	err := a.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&adapters.GothTrustedDevice{}).Error
+	if err != nil {
+		return goth.ErrBadRequest
+	}
+
+	return nil
+}
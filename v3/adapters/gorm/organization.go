@@ -0,0 +1,552 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	goth "github.com/katallaxie/fiber-goth/v3"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateOrganization is a helper function to create a new organization with the user as owner.
+func (a *gormAdapter) CreateOrganization(ctx context.Context, org adapters.GothOrganization, ownerID uuid.UUID) (adapters.GothOrganization, error) {
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := slugTaken(tx, org.Slug, uuid.Nil); err != nil {
+			return err
+		}
+
+		if err := tx.Omit(clause.Associations).Create(&org).Error; err != nil {
+			return err
+		}
+
+		owner := adapters.GothMember{
+			OrganizationID: org.ID,
+			UserID:         ownerID,
+			Role:           adapters.RoleOwner,
+		}
+
+		return tx.Omit(clause.Associations).Create(&owner).Error
+	})
+	if errors.Is(err, adapters.ErrSlugTaken) {
+		return adapters.GothOrganization{}, err
+	}
+
+	if err != nil {
+		return adapters.GothOrganization{}, goth.ErrBadRequest
+	}
+
+	return a.GetOrganization(ctx, org.ID)
+}
+
+// GetOrganization is a helper function to retrieve an organization by ID.
+func (a *gormAdapter) GetOrganization(ctx context.Context, id uuid.UUID) (adapters.GothOrganization, error) {
+	var org adapters.GothOrganization
+	err := a.db.WithContext(ctx).Preload("Members").Preload("Teams").Where("id = ?", id).First(&org).Error
+	if err != nil {
+		return adapters.GothOrganization{}, goth.ErrMissingOrganization
+	}
+
+	return org, nil
+}
+
+// GetOrganizationBySlug is a helper function to retrieve an organization by slug.
+func (a *gormAdapter) GetOrganizationBySlug(ctx context.Context, slug string) (adapters.GothOrganization, error) {
+	var org adapters.GothOrganization
+	err := a.db.WithContext(ctx).Preload("Members").Preload("Teams").Where("slug = ?", slug).First(&org).Error
+	if err != nil {
+		return adapters.GothOrganization{}, goth.ErrMissingOrganization
+	}
+
+	return org, nil
+}
+
+// UpdateOrganization is a helper function to update an organization.
+func (a *gormAdapter) UpdateOrganization(ctx context.Context, org adapters.GothOrganization) (adapters.GothOrganization, error) {
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := slugTaken(tx, org.Slug, org.ID); err != nil {
+			return err
+		}
+
+		return tx.Model(&adapters.GothOrganization{}).Omit(clause.Associations).Where("id = ?", org.ID).Updates(&org).Error
+	})
+	if errors.Is(err, adapters.ErrSlugTaken) {
+		return adapters.GothOrganization{}, err
+	}
+
+	if err != nil {
+		return adapters.GothOrganization{}, goth.ErrBadRequest
+	}
+
+	return a.GetOrganization(ctx, org.ID)
+}
+
+// DeleteOrganization is a helper function to delete an organization by ID.
+// The members, teams, invitations, API keys and personal access tokens of the organization are deleted as well.
+func (a *gormAdapter) DeleteOrganization(ctx context.Context, id uuid.UUID) error {
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("organization_id = ?", id).Delete(&adapters.GothInvitation{}).Error; err != nil {
+			return err
+		}
+
+		teams := tx.Unscoped().Model(&adapters.GothTeam{}).Select("id").Where("organization_id = ?", id)
+		if err := tx.Where("team_id IN (?)", teams).Delete(&adapters.GothTeamMember{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("organization_id = ?", id).Delete(&adapters.GothTeam{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("organization_id = ?", id).Delete(&adapters.GothMember{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("organization_id = ?", id).Delete(&adapters.GothPersonalAccessToken{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("organization_id = ?", id).Delete(&adapters.GothAPIKey{}).Error; err != nil {
+			return err
+		}
+
+		err := tx.Model(&adapters.GothSession{}).
+			Where("active_organization_id = ?", id).
+			Updates(map[string]any{"active_organization_id": nil, "active_team_id": nil}).Error
+		if err != nil {
+			return err
+		}
+
+		res := tx.Unscoped().Where("id = ?", id).Delete(&adapters.GothOrganization{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return goth.ErrMissingOrganization
+		}
+
+		return nil
+	})
+	if errors.Is(err, goth.ErrMissingOrganization) {
+		return err
+	}
+
+	if err != nil {
+		return goth.ErrBadRequest
+	}
+
+	return nil
+}
+
+// ListOrganizations is a helper function to retrieve all organizations a user is a member of.
+func (a *gormAdapter) ListOrganizations(ctx context.Context, userID uuid.UUID) ([]adapters.GothOrganization, error) {
+	var orgs []adapters.GothOrganization
+	members := a.db.Model(&adapters.GothMember{}).Select("organization_id").Where("user_id = ?", userID)
+
+	err := a.db.WithContext(ctx).Where("id IN (?)", members).Order("created_at asc").Find(&orgs).Error
+	if err != nil {
+		return nil, goth.ErrBadRequest
+	}
+
+	return orgs, nil
+}
+
+// GetMember is a helper function to retrieve the member of an organization by user ID.
+func (a *gormAdapter) GetMember(ctx context.Context, orgID, userID uuid.UUID) (adapters.GothMember, error) {
+	var member adapters.GothMember
+	err := a.db.WithContext(ctx).Where("organization_id = ? AND user_id = ?", orgID, userID).First(&member).Error
+	if err != nil {
+		return adapters.GothMember{}, goth.ErrMissingMember
+	}
+
+	return member, nil
+}
+
+// GetMemberByID is a helper function to retrieve a member by ID.
+func (a *gormAdapter) GetMemberByID(ctx context.Context, id uuid.UUID) (adapters.GothMember, error) {
+	var member adapters.GothMember
+	err := a.db.WithContext(ctx).Where("id = ?", id).First(&member).Error
+	if err != nil {
+		return adapters.GothMember{}, goth.ErrMissingMember
+	}
+
+	return member, nil
+}
+
+// UpdateMember is a helper function to update a member.
+func (a *gormAdapter) UpdateMember(ctx context.Context, member adapters.GothMember) (adapters.GothMember, error) {
+	err := a.db.WithContext(ctx).Model(&adapters.GothMember{}).Omit(clause.Associations).Where("id = ?", member.ID).Updates(&member).Error
+	if err != nil {
+		return adapters.GothMember{}, goth.ErrBadRequest
+	}
+
+	return a.GetMemberByID(ctx, member.ID)
+}
+
+// ListMembers is a helper function to retrieve all members of an organization.
+func (a *gormAdapter) ListMembers(ctx context.Context, orgID uuid.UUID) ([]adapters.GothMember, error) {
+	var members []adapters.GothMember
+	err := a.db.WithContext(ctx).Preload("User").Where("organization_id = ?", orgID).Order("created_at asc").Find(&members).Error
+	if err != nil {
+		return nil, goth.ErrBadRequest
+	}
+
+	return members, nil
+}
+
+// RemoveMember is a helper function to remove a user from an organization.
+// The user is removed from the teams of the organization, and the API keys and personal access tokens
+// of the user for the organization are deleted as well.
+func (a *gormAdapter) RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error {
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		teams := tx.Unscoped().Model(&adapters.GothTeam{}).Select("id").Where("organization_id = ?", orgID)
+		if err := tx.Where("team_id IN (?) AND user_id = ?", teams, userID).Delete(&adapters.GothTeamMember{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("organization_id = ? AND user_id = ?", orgID, userID).Delete(&adapters.GothPersonalAccessToken{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("organization_id = ? AND user_id = ?", orgID, userID).Delete(&adapters.GothAPIKey{}).Error; err != nil {
+			return err
+		}
+
+		err := tx.Model(&adapters.GothSession{}).
+			Where("active_organization_id = ? AND user_id = ?", orgID, userID).
+			Updates(map[string]any{"active_organization_id": nil, "active_team_id": nil}).Error
+		if err != nil {
+			return err
+		}
+
+		res := tx.Where("organization_id = ? AND user_id = ?", orgID, userID).Delete(&adapters.GothMember{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return goth.ErrMissingMember
+		}
+
+		return nil
+	})
+	if errors.Is(err, goth.ErrMissingMember) {
+		return err
+	}
+
+	if err != nil {
+		return goth.ErrBadRequest
+	}
+
+	return nil
+}
+
+// CreateTeam is a helper function to create a new team.
+func (a *gormAdapter) CreateTeam(ctx context.Context, team adapters.GothTeam) (adapters.GothTeam, error) {
+	err := a.db.WithContext(ctx).Omit(clause.Associations).Create(&team).Error
+	if err != nil {
+		return adapters.GothTeam{}, goth.ErrBadRequest
+	}
+
+	return team, nil
+}
+
+// GetTeam is a helper function to retrieve a team by ID.
+func (a *gormAdapter) GetTeam(ctx context.Context, id uuid.UUID) (adapters.GothTeam, error) {
+	var team adapters.GothTeam
+	err := a.db.WithContext(ctx).Preload("Members").Where("id = ?", id).First(&team).Error
+	if err != nil {
+		return adapters.GothTeam{}, goth.ErrMissingTeam
+	}
+
+	return team, nil
+}
+
+// UpdateTeam is a helper function to update a team.
+func (a *gormAdapter) UpdateTeam(ctx context.Context, team adapters.GothTeam) (adapters.GothTeam, error) {
+	err := a.db.WithContext(ctx).Model(&adapters.GothTeam{}).Omit(clause.Associations).Where("id = ?", team.ID).Updates(&team).Error
+	if err != nil {
+		return adapters.GothTeam{}, goth.ErrBadRequest
+	}
+
+	return a.GetTeam(ctx, team.ID)
+}
+
+// DeleteTeam is a helper function to delete a team by ID.
+// The members of the team are deleted and the team is removed from sessions and invitations.
+func (a *gormAdapter) DeleteTeam(ctx context.Context, id uuid.UUID) error {
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("team_id = ?", id).Delete(&adapters.GothTeamMember{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&adapters.GothSession{}).Where("active_team_id = ?", id).Update("active_team_id", nil).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&adapters.GothInvitation{}).Where("team_id = ?", id).Update("team_id", nil).Error; err != nil {
+			return err
+		}
+
+		res := tx.Unscoped().Where("id = ?", id).Delete(&adapters.GothTeam{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return goth.ErrMissingTeam
+		}
+
+		return nil
+	})
+	if errors.Is(err, goth.ErrMissingTeam) {
+		return err
+	}
+
+	if err != nil {
+		return goth.ErrBadRequest
+	}
+
+	return nil
+}
+
+// ListTeams is a helper function to retrieve all teams of an organization.
+func (a *gormAdapter) ListTeams(ctx context.Context, orgID uuid.UUID) ([]adapters.GothTeam, error) {
+	var teams []adapters.GothTeam
+	err := a.db.WithContext(ctx).Where("organization_id = ?", orgID).Order("created_at asc").Find(&teams).Error
+	if err != nil {
+		return nil, goth.ErrBadRequest
+	}
+
+	return teams, nil
+}
+
+// ListUserTeams is a helper function to retrieve all teams a user is a member of.
+func (a *gormAdapter) ListUserTeams(ctx context.Context, userID uuid.UUID) ([]adapters.GothTeam, error) {
+	var teams []adapters.GothTeam
+	members := a.db.Model(&adapters.GothTeamMember{}).Select("team_id").Where("user_id = ?", userID)
+
+	err := a.db.WithContext(ctx).Where("id IN (?)", members).Order("created_at asc").Find(&teams).Error
+	if err != nil {
+		return nil, goth.ErrBadRequest
+	}
+
+	return teams, nil
+}
+
+// AddTeamMember is a helper function to add a user to a team.
+func (a *gormAdapter) AddTeamMember(ctx context.Context, teamID, userID uuid.UUID) (adapters.GothTeamMember, error) {
+	member := adapters.GothTeamMember{TeamID: teamID, UserID: userID}
+
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&adapters.GothTeamMember{}).Where("team_id = ? AND user_id = ?", teamID, userID).Count(&count).Error; err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return adapters.ErrAlreadyTeamMember
+		}
+
+		return tx.Omit(clause.Associations).Create(&member).Error
+	})
+	if errors.Is(err, adapters.ErrAlreadyTeamMember) {
+		return adapters.GothTeamMember{}, err
+	}
+
+	if err != nil {
+		return adapters.GothTeamMember{}, goth.ErrBadRequest
+	}
+
+	return member, nil
+}
+
+// RemoveTeamMember is a helper function to remove a user from a team.
+// The team is removed from the sessions of the user that have it as active team.
+func (a *gormAdapter) RemoveTeamMember(ctx context.Context, teamID, userID uuid.UUID) error {
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("team_id = ? AND user_id = ?", teamID, userID).Delete(&adapters.GothTeamMember{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return goth.ErrMissingMember
+		}
+
+		return tx.Model(&adapters.GothSession{}).Where("active_team_id = ? AND user_id = ?", teamID, userID).Update("active_team_id", nil).Error
+	})
+	if errors.Is(err, goth.ErrMissingMember) {
+		return err
+	}
+
+	if err != nil {
+		return goth.ErrBadRequest
+	}
+
+	return nil
+}
+
+// ListTeamMembers is a helper function to retrieve all members of a team.
+func (a *gormAdapter) ListTeamMembers(ctx context.Context, teamID uuid.UUID) ([]adapters.GothTeamMember, error) {
+	var members []adapters.GothTeamMember
+	err := a.db.WithContext(ctx).Where("team_id = ?", teamID).Order("created_at asc").Find(&members).Error
+	if err != nil {
+		return nil, goth.ErrBadRequest
+	}
+
+	return members, nil
+}
+
+// CreateInvitation is a helper function to create a new invitation.
+func (a *gormAdapter) CreateInvitation(ctx context.Context, invitation adapters.GothInvitation) (adapters.GothInvitation, error) {
+	err := a.db.WithContext(ctx).Omit(clause.Associations).Create(&invitation).Error
+	if err != nil {
+		return adapters.GothInvitation{}, goth.ErrBadRequest
+	}
+
+	return invitation, nil
+}
+
+// GetInvitation is a helper function to retrieve an invitation by ID.
+func (a *gormAdapter) GetInvitation(ctx context.Context, id uuid.UUID) (adapters.GothInvitation, error) {
+	var invitation adapters.GothInvitation
+	err := a.db.WithContext(ctx).Preload("Organization").Where("id = ?", id).First(&invitation).Error
+	if err != nil {
+		return adapters.GothInvitation{}, goth.ErrMissingInvitation
+	}
+
+	return invitation, nil
+}
+
+// UpdateInvitation is a helper function to update an invitation.
+func (a *gormAdapter) UpdateInvitation(ctx context.Context, invitation adapters.GothInvitation) (adapters.GothInvitation, error) {
+	err := a.db.WithContext(ctx).Model(&adapters.GothInvitation{}).Omit(clause.Associations).Where("id = ?", invitation.ID).Updates(&invitation).Error
+	if err != nil {
+		return adapters.GothInvitation{}, goth.ErrBadRequest
+	}
+
+	return a.GetInvitation(ctx, invitation.ID)
+}
+
+// ListInvitations is a helper function to retrieve all invitations of an organization.
+func (a *gormAdapter) ListInvitations(ctx context.Context, orgID uuid.UUID) ([]adapters.GothInvitation, error) {
+	var invitations []adapters.GothInvitation
+	err := a.db.WithContext(ctx).Where("organization_id = ?", orgID).Order("created_at desc").Find(&invitations).Error
+	if err != nil {
+		return nil, goth.ErrBadRequest
+	}
+
+	return invitations, nil
+}
+
+// ListUserInvitations is a helper function to retrieve all pending invitations for an email.
+func (a *gormAdapter) ListUserInvitations(ctx context.Context, email string) ([]adapters.GothInvitation, error) {
+	var invitations []adapters.GothInvitation
+	err := a.db.WithContext(ctx).
+		Preload("Organization").
+		Where("LOWER(email) = LOWER(?) AND status = ? AND expires_at > ?", email, adapters.InvitationStatusPending, time.Now()).
+		Order("created_at desc").
+		Find(&invitations).Error
+	if err != nil {
+		return nil, goth.ErrBadRequest
+	}
+
+	return invitations, nil
+}
+
+// AcceptInvitation is a helper function to accept an invitation and to add the user as a member of the organization.
+// The user is added to the team of the invitation as well.
+func (a *gormAdapter) AcceptInvitation(ctx context.Context, id, userID uuid.UUID) (adapters.GothInvitation, adapters.GothMember, error) {
+	var invitation adapters.GothInvitation
+	var member adapters.GothMember
+
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ?", id).First(&invitation).Error; err != nil {
+			return goth.ErrMissingInvitation
+		}
+
+		if !invitation.IsPending() {
+			return goth.ErrMissingInvitation
+		}
+
+		var count int64
+		if err := tx.Model(&adapters.GothMember{}).Where("organization_id = ? AND user_id = ?", invitation.OrganizationID, userID).Count(&count).Error; err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return adapters.ErrAlreadyMember
+		}
+
+		member = adapters.GothMember{
+			OrganizationID: invitation.OrganizationID,
+			UserID:         userID,
+			Role:           invitation.Role,
+		}
+
+		if err := tx.Omit(clause.Associations).Create(&member).Error; err != nil {
+			return err
+		}
+
+		if invitation.TeamID != nil {
+			teamMember := adapters.GothTeamMember{TeamID: *invitation.TeamID, UserID: userID}
+			if err := tx.Omit(clause.Associations).Create(&teamMember).Error; err != nil {
+				return err
+			}
+		}
+
+		invitation.Status = adapters.InvitationStatusAccepted
+
+		return tx.Model(&invitation).Omit(clause.Associations).Update("status", invitation.Status).Error
+	})
+	if errors.Is(err, goth.ErrMissingInvitation) || errors.Is(err, adapters.ErrAlreadyMember) {
+		return adapters.GothInvitation{}, adapters.GothMember{}, err
+	}
+
+	if err != nil {
+		return adapters.GothInvitation{}, adapters.GothMember{}, goth.ErrBadRequest
+	}
+
+	return invitation, member, nil
+}
+
+// SetActiveOrganization is a helper function to set the active organization and team of a session.
+func (a *gormAdapter) SetActiveOrganization(ctx context.Context, sessionToken string, orgID, teamID *uuid.UUID) (adapters.GothSession, error) {
+	res := a.db.WithContext(ctx).Model(&adapters.GothSession{}).
+		Where("session_token = ?", sessionToken).
+		Updates(map[string]any{"active_organization_id": orgID, "active_team_id": teamID})
+	if res.Error != nil {
+		return adapters.GothSession{}, goth.ErrBadRequest
+	}
+
+	if res.RowsAffected == 0 {
+		return adapters.GothSession{}, goth.ErrMissingSession
+	}
+
+	return a.GetSession(ctx, sessionToken)
+}
+
+// slugTaken returns an error if the slug is used by another organization than the given one.
+func slugTaken(tx *gorm.DB, slug string, id uuid.UUID) error {
+	if slug == "" {
+		return nil
+	}
+
+	var count int64
+	err := tx.Unscoped().Model(&adapters.GothOrganization{}).Where("slug = ? AND id <> ?", slug, id).Count(&count).Error
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return adapters.ErrSlugTaken
+	}
+
+	return nil
+}
@@ -0,0 +1,374 @@
+package adapters
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func init() {
+	gob.Register(&GothOrganization{})
+	gob.Register(&GothMember{})
+	gob.Register(&GothTeam{})
+	gob.Register(&GothTeamMember{})
+	gob.Register(&GothInvitation{})
+}
+
+var (
+	// ErrSlugTaken is returned when the slug of an organization is already in use.
+	ErrSlugTaken = errors.New("organization slug is already taken")
+	// ErrAlreadyMember is returned when a user is already a member of an organization.
+	ErrAlreadyMember = errors.New("user is already a member of the organization")
+	// ErrAlreadyTeamMember is returned when a user is already a member of a team.
+	ErrAlreadyTeamMember = errors.New("user is already a member of the team")
+)
+
+// Role is the role of a member in an organization.
+type Role string
+
+const (
+	// RoleOwner is the role of the owner of an organization.
+	RoleOwner Role = "owner"
+	// RoleAdmin is the role of an administrator of an organization.
+	RoleAdmin Role = "admin"
+	// RoleMember is the role of a member of an organization.
+	RoleMember Role = "member"
+)
+
+// InvitationStatus is the status of an invitation.
+type InvitationStatus string
+
+const (
+	// InvitationStatusPending is the status of an open invitation.
+	InvitationStatusPending InvitationStatus = "pending"
+	// InvitationStatusAccepted is the status of an accepted invitation.
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	// InvitationStatusRejected is the status of a rejected invitation.
+	InvitationStatusRejected InvitationStatus = "rejected"
+	// InvitationStatusCanceled is the status of a canceled invitation.
+	InvitationStatusCanceled InvitationStatus = "canceled"
+)
+
+// GothOrganization is an organization that users are members of.
+type GothOrganization struct {
+	// ID is the unique identifier of the organization.
+	ID uuid.UUID `json:"id" gorm:"primaryKey;unique;type:uuid;column:id;default:gen_random_uuid()"`
+	// Name is the name of the organization.
+	Name string `json:"name" validate:"required,max=255"`
+	// Slug is the unique slug of the organization.
+	Slug string `json:"slug" gorm:"uniqueIndex" validate:"required,max=255"`
+	// Logo is the logo URL of the organization.
+	Logo *string `json:"logo" validate:"omitempty,url"`
+	// Metadata is the metadata of the organization.
+	Metadata *string `json:"metadata"`
+	// Members are the members of the organization.
+	Members []GothMember `json:"members" gorm:"foreignKey:OrganizationID;constraint:OnDelete:CASCADE"`
+	// Teams are the teams of the organization.
+	Teams []GothTeam `json:"teams" gorm:"foreignKey:OrganizationID;constraint:OnDelete:CASCADE"`
+	// Invitations are the invitations of the organization.
+	Invitations []GothInvitation `json:"invitations" gorm:"foreignKey:OrganizationID;constraint:OnDelete:CASCADE"`
+	// CreatedAt is the creation time of the organization.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is the update time of the organization.
+	UpdatedAt time.Time `json:"updated_at"`
+	// DeletedAt is the deletion time of the organization.
+	DeletedAt gorm.DeletedAt `json:"deleted_at"`
+}
+
+// GothMember is the membership of a user in an organization.
+type GothMember struct {
+	// ID is the unique identifier of the member.
+	ID uuid.UUID `json:"id" gorm:"primaryKey;unique;type:uuid;column:id;default:gen_random_uuid()"`
+	// OrganizationID is the organization ID of the member.
+	OrganizationID uuid.UUID `json:"organization_id" gorm:"uniqueIndex:idx_member_organization_user"`
+	// Organization is the organization of the member.
+	Organization GothOrganization `json:"organization" gorm:"foreignKey:OrganizationID;constraint:OnDelete:CASCADE"`
+	// UserID is the user ID of the member.
+	UserID uuid.UUID `json:"user_id" gorm:"uniqueIndex:idx_member_organization_user"`
+	// User is the user of the member.
+	User GothUser `json:"user" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	// Role is the role of the member.
+	Role Role `json:"role" validate:"required"`
+	// CreatedAt is the creation time of the member.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is the update time of the member.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GothTeam is a team within an organization.
+type GothTeam struct {
+	// ID is the unique identifier of the team.
+	ID uuid.UUID `json:"id" gorm:"primaryKey;unique;type:uuid;column:id;default:gen_random_uuid()"`
+	// Name is the name of the team.
+	Name string `json:"name" validate:"required,max=255"`
+	// OrganizationID is the organization ID of the team.
+	OrganizationID uuid.UUID `json:"organization_id"`
+	// Organization is the organization of the team.
+	Organization GothOrganization `json:"organization" gorm:"foreignKey:OrganizationID;constraint:OnDelete:CASCADE"`
+	// Members are the members of the team.
+	Members []GothTeamMember `json:"members" gorm:"foreignKey:TeamID;constraint:OnDelete:CASCADE"`
+	// CreatedAt is the creation time of the team.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is the update time of the team.
+	UpdatedAt time.Time `json:"updated_at"`
+	// DeletedAt is the deletion time of the team.
+	DeletedAt gorm.DeletedAt `json:"deleted_at"`
+}
+
+// GothTeamMember is the membership of a user in a team.
+type GothTeamMember struct {
+	// ID is the unique identifier of the team member.
+	ID uuid.UUID `json:"id" gorm:"primaryKey;unique;type:uuid;column:id;default:gen_random_uuid()"`
+	// TeamID is the team ID of the team member.
+	TeamID uuid.UUID `json:"team_id" gorm:"uniqueIndex:idx_team_member_team_user"`
+	// UserID is the user ID of the team member.
+	UserID uuid.UUID `json:"user_id" gorm:"uniqueIndex:idx_team_member_team_user"`
+	// User is the user of the team member.
+	User GothUser `json:"user" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	// CreatedAt is the creation time of the team member.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is the update time of the team member.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GothInvitation is an invitation of a user to an organization.
+type GothInvitation struct {
+	// ID is the unique identifier of the invitation.
+	ID uuid.UUID `json:"id" gorm:"primaryKey;unique;type:uuid;column:id;default:gen_random_uuid()"`
+	// OrganizationID is the organization ID of the invitation.
+	OrganizationID uuid.UUID `json:"organization_id"`
+	// Organization is the organization of the invitation.
+	Organization GothOrganization `json:"organization" gorm:"foreignKey:OrganizationID;constraint:OnDelete:CASCADE"`
+	// Email is the email of the invited user.
+	Email string `json:"email" validate:"required,email"`
+	// Role is the role of the invited user.
+	Role Role `json:"role" validate:"required"`
+	// Status is the status of the invitation.
+	Status InvitationStatus `json:"status"`
+	// TeamID is the optional team ID of the invitation.
+	TeamID *uuid.UUID `json:"team_id"`
+	// InviterID is the user ID of the inviter.
+	InviterID uuid.UUID `json:"inviter_id"`
+	// ExpiresAt is the expiry time of the invitation.
+	ExpiresAt time.Time `json:"expires_at"`
+	// CreatedAt is the creation time of the invitation.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is the update time of the invitation.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// HasExpired returns true if the invitation has expired.
+func (i GothInvitation) HasExpired() bool {
+	return i.ExpiresAt.Before(time.Now())
+}
+
+// IsPending returns true if the invitation is pending and has not expired.
+func (i GothInvitation) IsPending() bool {
+	return i.Status == InvitationStatusPending && !i.HasExpired()
+}
+
+// OrganizationAdapter is an interface that defines the methods for organizations.
+// Adapters implement it in addition to the Adapter interface to support organizations.
+type OrganizationAdapter interface {
+	// CreateOrganization creates a new organization with the user as owner.
+	CreateOrganization(ctx context.Context, org GothOrganization, ownerID uuid.UUID) (GothOrganization, error)
+	// GetOrganization retrieves an organization by ID.
+	GetOrganization(ctx context.Context, id uuid.UUID) (GothOrganization, error)
+	// GetOrganizationBySlug retrieves an organization by slug.
+	GetOrganizationBySlug(ctx context.Context, slug string) (GothOrganization, error)
+	// UpdateOrganization updates an organization.
+	UpdateOrganization(ctx context.Context, org GothOrganization) (GothOrganization, error)
+	// DeleteOrganization deletes an organization by ID including members, teams and invitations.
+	DeleteOrganization(ctx context.Context, id uuid.UUID) error
+	// ListOrganizations retrieves all organizations a user is a member of.
+	ListOrganizations(ctx context.Context, userID uuid.UUID) ([]GothOrganization, error)
+	// GetMember retrieves the member of an organization by user ID.
+	GetMember(ctx context.Context, orgID, userID uuid.UUID) (GothMember, error)
+	// GetMemberByID retrieves a member by ID.
+	GetMemberByID(ctx context.Context, id uuid.UUID) (GothMember, error)
+	// UpdateMember updates a member.
+	UpdateMember(ctx context.Context, member GothMember) (GothMember, error)
+	// ListMembers retrieves all members of an organization.
+	ListMembers(ctx context.Context, orgID uuid.UUID) ([]GothMember, error)
+	// RemoveMember removes a user from an organization including the teams of the organization,
+	// and deletes the API keys and personal access tokens of the user for the organization.
+	RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error
+	// CreateTeam creates a new team.
+	CreateTeam(ctx context.Context, team GothTeam) (GothTeam, error)
+	// GetTeam retrieves a team by ID.
+	GetTeam(ctx context.Context, id uuid.UUID) (GothTeam, error)
+	// UpdateTeam updates a team.
+	UpdateTeam(ctx context.Context, team GothTeam) (GothTeam, error)
+	// DeleteTeam deletes a team by ID including the members of the team.
+	DeleteTeam(ctx context.Context, id uuid.UUID) error
+	// ListTeams retrieves all teams of an organization.
+	ListTeams(ctx context.Context, orgID uuid.UUID) ([]GothTeam, error)
+	// ListUserTeams retrieves all teams a user is a member of.
+	ListUserTeams(ctx context.Context, userID uuid.UUID) ([]GothTeam, error)
+	// AddTeamMember adds a user to a team.
+	AddTeamMember(ctx context.Context, teamID, userID uuid.UUID) (GothTeamMember, error)
+	// RemoveTeamMember removes a user from a team and unsets the team as active team of the sessions of the user.
+	RemoveTeamMember(ctx context.Context, teamID, userID uuid.UUID) error
+	// ListTeamMembers retrieves all members of a team.
+	ListTeamMembers(ctx context.Context, teamID uuid.UUID) ([]GothTeamMember, error)
+	// CreateInvitation creates a new invitation.
+	CreateInvitation(ctx context.Context, invitation GothInvitation) (GothInvitation, error)
+	// GetInvitation retrieves an invitation by ID.
+	GetInvitation(ctx context.Context, id uuid.UUID) (GothInvitation, error)
+	// UpdateInvitation updates an invitation.
+	UpdateInvitation(ctx context.Context, invitation GothInvitation) (GothInvitation, error)
+	// ListInvitations retrieves all invitations of an organization.
+	ListInvitations(ctx context.Context, orgID uuid.UUID) ([]GothInvitation, error)
+	// ListUserInvitations retrieves all pending invitations for an email.
+	ListUserInvitations(ctx context.Context, email string) ([]GothInvitation, error)
+	// AcceptInvitation accepts an invitation and adds the user as a member of the organization
+	// and of the team of the invitation.
+	AcceptInvitation(ctx context.Context, id, userID uuid.UUID) (GothInvitation, GothMember, error)
+	// SetActiveOrganization sets the active organization and team of a session.
+	// A nil ID unsets the active organization or team.
+	SetActiveOrganization(ctx context.Context, sessionToken string, orgID, teamID *uuid.UUID) (GothSession, error)
+}
+
+var _ OrganizationAdapter = (*UnimplementedOrganizationAdapter)(nil)
+
+// UnimplementedOrganizationAdapter is an organization adapter that does not implement any of the methods.
+type UnimplementedOrganizationAdapter struct{}
+
+// CreateOrganization creates a new organization with the user as owner.
+func (a *UnimplementedOrganizationAdapter) CreateOrganization(_ context.Context, _ GothOrganization, _ uuid.UUID) (GothOrganization, error) {
+	return GothOrganization{}, ErrUnimplemented
+}
+
+// GetOrganization retrieves an organization by ID.
+func (a *UnimplementedOrganizationAdapter) GetOrganization(_ context.Context, _ uuid.UUID) (GothOrganization, error) {
+	return GothOrganization{}, ErrUnimplemented
+}
+
+// GetOrganizationBySlug retrieves an organization by slug.
+func (a *UnimplementedOrganizationAdapter) GetOrganizationBySlug(_ context.Context, _ string) (GothOrganization, error) {
+	return GothOrganization{}, ErrUnimplemented
+}
+
+// UpdateOrganization updates an organization.
+func (a *UnimplementedOrganizationAdapter) UpdateOrganization(_ context.Context, _ GothOrganization) (GothOrganization, error) {
+	return GothOrganization{}, ErrUnimplemented
+}
+
+// DeleteOrganization deletes an organization by ID.
+func (a *UnimplementedOrganizationAdapter) DeleteOrganization(_ context.Context, _ uuid.UUID) error {
+	return ErrUnimplemented
+}
+
+// ListOrganizations retrieves all organizations a user is a member of.
+func (a *UnimplementedOrganizationAdapter) ListOrganizations(_ context.Context, _ uuid.UUID) ([]GothOrganization, error) {
+	return nil, ErrUnimplemented
+}
+
+// GetMember retrieves the member of an organization by user ID.
+func (a *UnimplementedOrganizationAdapter) GetMember(_ context.Context, _, _ uuid.UUID) (GothMember, error) {
+	return GothMember{}, ErrUnimplemented
+}
+
+// GetMemberByID retrieves a member by ID.
+func (a *UnimplementedOrganizationAdapter) GetMemberByID(_ context.Context, _ uuid.UUID) (GothMember, error) {
+	return GothMember{}, ErrUnimplemented
+}
+
+// UpdateMember updates a member.
+func (a *UnimplementedOrganizationAdapter) UpdateMember(_ context.Context, _ GothMember) (GothMember, error) {
+	return GothMember{}, ErrUnimplemented
+}
+
+// ListMembers retrieves all members of an organization.
+func (a *UnimplementedOrganizationAdapter) ListMembers(_ context.Context, _ uuid.UUID) ([]GothMember, error) {
+	return nil, ErrUnimplemented
+}
+
+// RemoveMember removes a user from an organization.
+func (a *UnimplementedOrganizationAdapter) RemoveMember(_ context.Context, _, _ uuid.UUID) error {
+	return ErrUnimplemented
+}
+
+// CreateTeam creates a new team.
+func (a *UnimplementedOrganizationAdapter) CreateTeam(_ context.Context, _ GothTeam) (GothTeam, error) {
+	return GothTeam{}, ErrUnimplemented
+}
+
+// GetTeam retrieves a team by ID.
+func (a *UnimplementedOrganizationAdapter) GetTeam(_ context.Context, _ uuid.UUID) (GothTeam, error) {
+	return GothTeam{}, ErrUnimplemented
+}
+
+// UpdateTeam updates a team.
+func (a *UnimplementedOrganizationAdapter) UpdateTeam(_ context.Context, _ GothTeam) (GothTeam, error) {
+	return GothTeam{}, ErrUnimplemented
+}
+
+// DeleteTeam deletes a team by ID.
+func (a *UnimplementedOrganizationAdapter) DeleteTeam(_ context.Context, _ uuid.UUID) error {
+	return ErrUnimplemented
+}
+
+// ListTeams retrieves all teams of an organization.
+func (a *UnimplementedOrganizationAdapter) ListTeams(_ context.Context, _ uuid.UUID) ([]GothTeam, error) {
+	return nil, ErrUnimplemented
+}
+
+// ListUserTeams retrieves all teams a user is a member of.
+func (a *UnimplementedOrganizationAdapter) ListUserTeams(_ context.Context, _ uuid.UUID) ([]GothTeam, error) {
+	return nil, ErrUnimplemented
+}
+
+// AddTeamMember adds a user to a team.
+func (a *UnimplementedOrganizationAdapter) AddTeamMember(_ context.Context, _, _ uuid.UUID) (GothTeamMember, error) {
+	return GothTeamMember{}, ErrUnimplemented
+}
+
+// RemoveTeamMember removes a user from a team.
+func (a *UnimplementedOrganizationAdapter) RemoveTeamMember(_ context.Context, _, _ uuid.UUID) error {
+	return ErrUnimplemented
+}
+
+// ListTeamMembers retrieves all members of a team.
+func (a *UnimplementedOrganizationAdapter) ListTeamMembers(_ context.Context, _ uuid.UUID) ([]GothTeamMember, error) {
+	return nil, ErrUnimplemented
+}
+
+// CreateInvitation creates a new invitation.
+func (a *UnimplementedOrganizationAdapter) CreateInvitation(_ context.Context, _ GothInvitation) (GothInvitation, error) {
+	return GothInvitation{}, ErrUnimplemented
+}
+
+// GetInvitation retrieves an invitation by ID.
+func (a *UnimplementedOrganizationAdapter) GetInvitation(_ context.Context, _ uuid.UUID) (GothInvitation, error) {
+	return GothInvitation{}, ErrUnimplemented
+}
+
+// UpdateInvitation updates an invitation.
+func (a *UnimplementedOrganizationAdapter) UpdateInvitation(_ context.Context, _ GothInvitation) (GothInvitation, error) {
+	return GothInvitation{}, ErrUnimplemented
+}
+
+// ListInvitations retrieves all invitations of an organization.
+func (a *UnimplementedOrganizationAdapter) ListInvitations(_ context.Context, _ uuid.UUID) ([]GothInvitation, error) {
+	return nil, ErrUnimplemented
+}
+
+// ListUserInvitations retrieves all pending invitations for an email.
+func (a *UnimplementedOrganizationAdapter) ListUserInvitations(_ context.Context, _ string) ([]GothInvitation, error) {
+	return nil, ErrUnimplemented
+}
+
+// AcceptInvitation accepts an invitation and adds the user as a member of the organization.
+func (a *UnimplementedOrganizationAdapter) AcceptInvitation(_ context.Context, _, _ uuid.UUID) (GothInvitation, GothMember, error) {
+	return GothInvitation{}, GothMember{}, ErrUnimplemented
+}
+
+// SetActiveOrganization sets the active organization and team of a session.
+func (a *UnimplementedOrganizationAdapter) SetActiveOrganization(_ context.Context, _ string, _, _ *uuid.UUID) (GothSession, error) {
+	return GothSession{}, ErrUnimplemented
+}
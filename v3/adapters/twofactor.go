@@ -0,0 +1,127 @@
+package adapters
+
+import (
+	"context"
+	"encoding/gob"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func init() {
+	gob.Register(&GothTwoFactor{})
+	gob.Register(&GothBackupCode{})
+}
+
+// GothTwoFactor is the TOTP second factor of a user.
+type GothTwoFactor struct {
+	// ID is the unique identifier of the second factor.
+	ID uuid.UUID `json:"id" gorm:"primaryKey;unique;type:uuid;column:id;default:gen_random_uuid()"`
+	// UserID is the user ID of the second factor.
+	UserID uuid.UUID `json:"user_id" gorm:"uniqueIndex"`
+	// User is the user of the second factor.
+	User GothUser `json:"user" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	// Secret is the encrypted TOTP secret.
+	Secret string `json:"-"`
+	// Enabled is true once the user has verified the first code of the secret.
+	Enabled bool `json:"enabled"`
+	// LastUsedStep is the time step of the last code that has been used, codes are used once.
+	LastUsedStep int64 `json:"last_used_step"`
+	// Attempts is the number of attempts to enter a code since the last successful attempt.
+	Attempts int `json:"attempts"`
+	// CreatedAt is the creation time of the second factor.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is the update time of the second factor.
+	UpdatedAt time.Time `json:"updated_at"`
+	// DeletedAt is the deletion time of the second factor.
+	DeletedAt gorm.DeletedAt `json:"deleted_at"`
+}
+
+// GothBackupCode is a one-time backup code of a user to use instead of the second factor.
+type GothBackupCode struct {
+	// ID is the unique identifier of the backup code.
+	ID uuid.UUID `json:"id" gorm:"primaryKey;unique;type:uuid;column:id;default:gen_random_uuid()"`
+	// UserID is the user ID of the backup code.
+	UserID uuid.UUID `json:"user_id" gorm:"index"`
+	// User is the user of the backup code.
+	User GothUser `json:"user" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	// CodeHash is the hash of the backup code.
+	CodeHash string `json:"-"`
+	// CreatedAt is the creation time of the backup code.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TwoFactorAdapter is an interface that defines the methods for the second factor of users.
+// Adapters implement it in addition to the Adapter interface to support two-factor authentication.
+type TwoFactorAdapter interface {
+	// CreateTwoFactor creates the second factor of a user, which replaces the second factor and backup codes of the user.
+	CreateTwoFactor(ctx context.Context, twoFactor GothTwoFactor) (GothTwoFactor, error)
+	// GetTwoFactor retrieves the second factor of a user.
+	GetTwoFactor(ctx context.Context, userID uuid.UUID) (GothTwoFactor, error)
+	// UpdateTwoFactor updates the enabled state and the attempts of a second factor.
+	UpdateTwoFactor(ctx context.Context, twoFactor GothTwoFactor) (GothTwoFactor, error)
+	// DeleteTwoFactor deletes the second factor and the backup codes of a user.
+	DeleteTwoFactor(ctx context.Context, userID uuid.UUID) error
+	// IncrementTwoFactorAttempts counts an attempt to enter a code of the second factor of a user.
+	IncrementTwoFactorAttempts(ctx context.Context, userID uuid.UUID) (GothTwoFactor, error)
+	// UseTwoFactorStep records the time step of a code that has been entered and resets the attempts.
+	// It fails if a code of the same or a later time step has been used before.
+	UseTwoFactorStep(ctx context.Context, userID uuid.UUID, step int64) error
+	// CreateBackupCodes creates backup codes from their hashes, which replace the backup codes of the user.
+	CreateBackupCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error
+	// UseBackupCode uses the backup code of a user with the hash once.
+	UseBackupCode(ctx context.Context, userID uuid.UUID, codeHash string) error
+	// CountBackupCodes returns the number of unused backup codes of a user.
+	CountBackupCodes(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+var _ TwoFactorAdapter = (*UnimplementedTwoFactorAdapter)(nil)
+
+// UnimplementedTwoFactorAdapter is a two-factor adapter that does not implement any of the methods.
+type UnimplementedTwoFactorAdapter struct{}
+
+// CreateTwoFactor creates the second factor of a user.
+func (a *UnimplementedTwoFactorAdapter) CreateTwoFactor(_ context.Context, _ GothTwoFactor) (GothTwoFactor, error) {
+	return GothTwoFactor{}, ErrUnimplemented
+}
+
+// GetTwoFactor retrieves the second factor of a user.
+func (a *UnimplementedTwoFactorAdapter) GetTwoFactor(_ context.Context, _ uuid.UUID) (GothTwoFactor, error) {
+	return GothTwoFactor{}, ErrUnimplemented
+}
+
+// UpdateTwoFactor updates a second factor.
+func (a *UnimplementedTwoFactorAdapter) UpdateTwoFactor(_ context.Context, _ GothTwoFactor) (GothTwoFactor, error) {
+	return GothTwoFactor{}, ErrUnimplemented
+}
+
+// DeleteTwoFactor deletes the second factor of a user.
+func (a *UnimplementedTwoFactorAdapter) DeleteTwoFactor(_ context.Context, _ uuid.UUID) error {
+	return ErrUnimplemented
+}
+
+// IncrementTwoFactorAttempts counts an attempt to enter a code.
+func (a *UnimplementedTwoFactorAdapter) IncrementTwoFactorAttempts(_ context.Context, _ uuid.UUID) (GothTwoFactor, error) {
+	return GothTwoFactor{}, ErrUnimplemented
+}
+
+// UseTwoFactorStep records the time step of a code that has been entered.
+func (a *UnimplementedTwoFactorAdapter) UseTwoFactorStep(_ context.Context, _ uuid.UUID, _ int64) error {
+	return ErrUnimplemented
+}
+
+// CreateBackupCodes creates backup codes.
+func (a *UnimplementedTwoFactorAdapter) CreateBackupCodes(_ context.Context, _ uuid.UUID, _ []string) error {
+	return ErrUnimplemented
+}
+
+// UseBackupCode uses a backup code.
+func (a *UnimplementedTwoFactorAdapter) UseBackupCode(_ context.Context, _ uuid.UUID, _ string) error {
+	return ErrUnimplemented
+}
+
+// CountBackupCodes returns the number of unused backup codes of a user.
+func (a *UnimplementedTwoFactorAdapter) CountBackupCodes(_ context.Context, _ uuid.UUID) (int64, error) {
+	return 0, ErrUnimplemented
+}
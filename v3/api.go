@@ -0,0 +1,168 @@
+package goth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"time"
+
+	fiberv2 "github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/filesystem"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/gofiber/fiber/v3"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+	"github.com/katallaxie/fiber-goth/v3/pkg/apis"
+	"github.com/katallaxie/fiber-goth/v3/pkg/controllers"
+	"github.com/katallaxie/fiber-goth/v3/pkg/spec"
+)
+
+// MountAPI registers the REST API of the auth handlers at the API URL of the config.
+// The OpenAPI document of the API is served at "/openapi.json" and "/openapi.yaml",
+// and a page to explore the API at "/reference" below the API URL.
+// The session of the request is passed to the controller, which answers requests
+// without a valid session with an unauthorized error. The API is not available to
+// requests authenticated with an API key or a personal access token.
+// Users that sign in with the ID token of a provider get a session like with the
+// handlers of the provider, which is pending if the user has enabled a second factor.
+//
+// The generated server of the API is built for Fiber v2. It runs as a sub-app
+// that serves the requests of the Fiber v3 app on the same request context.
+// The sub-app routes the full path of the request, so the API is mounted on the app
+// and not on a group; the prefix of a group is part of the API URL instead.
+//
+// The API does not check CSRF tokens. Apps that authenticate the API with the session
+// cookie mount the CSRF middleware in front of it for the requests with the cookie, e.g.
+//
+//	app.Use(cfg.APIURL, csrf.New(csrf.Config{
+//		Adapter: adapter,
+//		Next:    func(c fiber.Ctx) bool { return c.Cookies(cfg.SessionCookieName()) == "" },
+//	}))
+func MountAPI(app *fiber.App, config Config, opts ...controllers.Opt) {
+	cfg := configDefault(config)
+
+	opts = append([]controllers.Opt{
+		controllers.WithBaseURL(cfg.APIURL),
+		controllers.WithPolicy(cfg.Policy),
+		controllers.WithCreateSession(apiCreateSession),
+	}, opts...)
+
+	api := fiberv2.New(fiberv2.Config{
+		DisableStartupMessage: true,
+		ErrorHandler:          apiErrorHandler,
+	})
+
+	handler := apis.NewStrictHandler(controllers.NewAPIController(cfg.Adapter, opts...), []apis.StrictMiddlewareFunc{controllers.NotImplemented()})
+	router := api.Group(cfg.APIURL, apiSession)
+	apis.RegisterHandlers(router, handler)
+	mountReference(router, cfg.APIURL)
+
+	serve := api.Handler()
+
+	app.Use(cfg.APIURL, func(c fiber.Ctx) error {
+		// The locals are shared with the API, the session of a key or token is removed.
+		if _, scoped := ScopesFromContext(c); scoped {
+			c.Locals(sessionKey, nil)
+		} else if session, err := currentSession(c, cfg); err == nil {
+			c.Locals(sessionKey, session)
+		}
+
+		c.Locals(sessionCreatorKey, sessionCreator(func(user adapters.GothUser, provider string) (adapters.GothSession, error) {
+			return createSession(c, cfg, user, provider)
+		}))
+
+		serve(c.RequestCtx())
+
+		return nil
+	})
+}
+
+// mountReference registers the handlers that serve the OpenAPI document and the page to explore it.
+func mountReference(router fiberv2.Router, baseURL string) {
+	doc, err := spec.JSON(baseURL)
+	router.Get("/openapi.json", sendBytes(fiberv2.MIMEApplicationJSONCharsetUTF8, doc, err))
+
+	docYAML, err := spec.YAML(baseURL)
+	router.Get("/openapi.yaml", sendBytes("application/yaml; charset=utf-8", docYAML, err))
+
+	page, err := spec.Explorer(path.Join(baseURL, "openapi.json"), path.Join(baseURL, "reference"))
+	router.Get("/reference", sendBytes(fiberv2.MIMETextHTMLCharsetUTF8, page, err))
+	router.Use("/reference", filesystem.New(filesystem.Config{Root: http.FS(spec.Assets())}))
+}
+
+// sendBytes returns a handler that sends the content, or the error if the content could not be created.
+func sendBytes(contentType string, b []byte, err error) fiberv2.Handler {
+	return func(c *fiberv2.Ctx) error {
+		if err != nil {
+			return err
+		}
+
+		c.Set(fiberv2.HeaderContentType, contentType)
+
+		return c.Send(b)
+	}
+}
+
+// apiSession attaches the session of the signed-in user to the context of the controller.
+// The values of the request context are shared with the Fiber v3 app.
+func apiSession(c *fiberv2.Ctx) error {
+	if session, ok := c.Locals(sessionKey).(adapters.GothSession); ok {
+		c.SetUserContext(controllers.ContextWithSession(c.UserContext(), session))
+	}
+
+	if create, ok := c.Locals(sessionCreatorKey).(sessionCreator); ok {
+		c.SetUserContext(context.WithValue(c.UserContext(), sessionCreatorKey, create))
+	}
+
+	return c.Next()
+}
+
+// sessionCreator creates the session of a user that signs in with the API on the request of the Fiber v3 app.
+type sessionCreator func(user adapters.GothUser, provider string) (adapters.GothSession, error)
+
+// apiCreateSession creates the session of a user that signs in with the API.
+func apiCreateSession(ctx context.Context, user adapters.GothUser, provider string) (adapters.GothSession, error) {
+	create, ok := ctx.Value(sessionCreatorKey).(sessionCreator)
+	if !ok {
+		return adapters.GothSession{}, ErrMissingSession
+	}
+
+	return create(user, provider)
+}
+
+// createSession creates the session of a user that has signed in with the provider without a redirect.
+// Users with a second factor get a pending session until the code is verified,
+// unless they sign in from a trusted device.
+func createSession(c fiber.Ctx, cfg Config, user adapters.GothUser, provider string) (adapters.GothSession, error) {
+	duration, err := time.ParseDuration(cfg.Expiry)
+	if err != nil {
+		return adapters.GothSession{}, err
+	}
+	expires := time.Now().Add(duration)
+	opts := append(sessionMetadata(c), adapters.WithAuthTime(time.Now()), adapters.WithAuthMethod(provider))
+
+	if requiresTwoFactor(c, cfg, user.ID) {
+		expires = time.Now().Add(DefaultTwoFactorTimeout)
+		opts = append(opts, adapters.WithTwoFactorPending())
+	}
+
+	return cfg.Adapter.CreateSession(c, user.ID, expires, opts...)
+}
+
+// apiErrorHandler maps the errors of the API handlers to the error schema of the API.
+func apiErrorHandler(c *fiberv2.Ctx, err error) error {
+	code := fiberv2.StatusInternalServerError
+	message := utils.StatusMessage(code)
+
+	var fe *fiberv2.Error
+	var ge *Error
+
+	switch {
+	case errors.As(err, &fe):
+		code, message = fe.Code, fe.Message
+	case errors.As(err, &ge):
+		code, message = ge.Code, ge.Message
+	}
+
+	return c.Status(code).JSON(fiberv2.Map{"message": message})
+}
@@ -0,0 +1,44 @@
+package goth_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	goth "github.com/katallaxie/fiber-goth/v3"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestMountAPIScopedSession(t *testing.T) {
+	adapter := newAdapter()
+	cfg := adapter.config()
+	cfg.Extractor = goth.TokenFromAuthorizationHeader()
+	user := adapter.createUser()
+
+	app := fiber.New()
+	app.Use(goth.PersonalAccessToken(cfg))
+	goth.MountAPI(app, cfg)
+
+	session, err := adapter.CreateSession(t.Context(), user.ID, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, body := request(t, app, fiber.MethodGet, "/api/auth/get-session", session.SessionToken, "")
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d for a session: %s", res.StatusCode, http.StatusOK, body)
+	}
+
+	token := adapter.createToken(user.ID, "repo")
+
+	res, _ = request(t, app, fiber.MethodGet, "/api/auth/get-session", token, "")
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d for a personal access token", res.StatusCode, http.StatusUnauthorized)
+	}
+
+	res, _ = request(t, app, fiber.MethodGet, "/api/auth/get-session", "", "")
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d without a session", res.StatusCode, http.StatusUnauthorized)
+	}
+}
@@ -0,0 +1,153 @@
+package goth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+)
+
+const (
+	// APIKeyPrefix is the prefix of API keys, which tells them apart from session tokens.
+	APIKeyPrefix = "sk_"
+	// DefaultAPIKeyRateLimitWindow is the default window of the rate limit of API keys.
+	DefaultAPIKeyRateLimitWindow = time.Minute
+)
+
+// apiKeyPrefixLen is the length of the visible prefix of API keys, e.g. "sk_abcd1234".
+const apiKeyPrefixLen = len(APIKeyPrefix) + 8
+
+// CreateAPIKey creates an API key with the name, user, organization, scopes, expiry and rate limit of the key.
+// It returns the key, which is only stored as hash and cannot be retrieved later.
+// The adapter has to implement the API key adapter.
+func CreateAPIKey(ctx context.Context, key adapters.GothAPIKey, config ...Config) (string, adapters.GothAPIKey, error) {
+	cfg := configDefault(config...)
+
+	store, ok := cfg.Adapter.(adapters.APIKeyAdapter)
+	if !ok {
+		return "", adapters.GothAPIKey{}, ErrMissingAdapter
+	}
+
+	token := APIKeyPrefix + strings.ToLower(rand.Text())
+
+	key.Prefix = token[:apiKeyPrefixLen]
+	key.KeyHash = hashAPIKey(token)
+
+	if key.RateLimitWindow <= 0 {
+		key.RateLimitWindow = DefaultAPIKeyRateLimitWindow
+	}
+
+	key, err := store.CreateAPIKey(ctx, key)
+	if err != nil {
+		return "", adapters.GothAPIKey{}, err
+	}
+
+	return token, key, nil
+}
+
+// APIKey returns a middleware that authenticates requests with an API key in the "Authorization: Bearer sk_..." header.
+// It attaches the same session and user to the request as the Session middleware,
+// with the organization of the key as active organization, and the scopes of the key for RequireScopes.
+// Requests without an API key are passed on.
+// Invalid and expired keys are rejected with 401, and keys that exceed their rate limit with 429.
+// Keys of organizations the user is no longer a member of are deleted and rejected with 401.
+// The adapter has to implement the API key adapter.
+func APIKey(config ...Config) fiber.Handler {
+	cfg := configDefault(config...)
+
+	return func(c fiber.Ctx) error {
+		if cfg.Next != nil && cfg.Next(c) {
+			return c.Next()
+		}
+
+		token, ok := bearerToken(c)
+		if !ok || !strings.HasPrefix(token, APIKeyPrefix) {
+			return c.Next()
+		}
+
+		store, ok := cfg.Adapter.(adapters.APIKeyAdapter)
+		if !ok {
+			return ErrMissingAdapter
+		}
+
+		key, err := store.GetAPIKey(c, hashAPIKey(token))
+		if err != nil || !key.IsValid() {
+			return unauthorized(c, ErrBadAPIKey)
+		}
+
+		// Keys of organizations are deleted once the user is no longer a member of the organization.
+		if key.OrganizationID != nil {
+			orgs, ok := cfg.Adapter.(adapters.OrganizationAdapter)
+			if !ok {
+				return ErrMissingAdapter
+			}
+
+			_, err := orgs.GetMember(c, *key.OrganizationID, key.UserID)
+			if err != nil {
+				err := store.DeleteAPIKey(c, key.ID)
+				if err != nil {
+					return err
+				}
+
+				return unauthorized(c, ErrBadAPIKey)
+			}
+		}
+
+		key, err = store.UseAPIKey(c, key)
+		if err != nil {
+			return unauthorized(c, ErrBadAPIKey)
+		}
+
+		if key.RateLimited() {
+			return rateLimited(c, key)
+		}
+
+		// The session of the key lasts for the request.
+		session := adapters.GothSession{
+			ID:                   key.ID,
+			UserID:               key.UserID,
+			ActiveOrganizationID: key.OrganizationID,
+			ExpiresAt:            time.Now().Add(time.Minute),
+		}
+
+		c.Locals(tokenKey, key.ID)
+		c.Locals(sessionKey, session)
+		c.Locals(userIDKey, key.UserID)
+		c.Locals(apiKeyKey, key)
+		c.Locals(scopesKey, key.Scopes())
+
+		return c.Next()
+	}
+}
+
+// APIKeyFromContext returns the API key the request has been authenticated with from the request context.
+func APIKeyFromContext(c fiber.Ctx) (adapters.GothAPIKey, error) {
+	key, ok := c.Locals(apiKeyKey).(adapters.GothAPIKey)
+	if !ok {
+		return adapters.GothAPIKey{}, ErrMissingAPIKey
+	}
+
+	return key, nil
+}
+
+// rateLimited responds with 429 and the time until the rate limit window of the key has passed.
+func rateLimited(c fiber.Ctx, key adapters.GothAPIKey) error {
+	if key.WindowStartedAt != nil {
+		retry := time.Until(key.WindowStartedAt.Add(key.RateLimitWindow))
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(retry.Seconds())+1))
+	}
+
+	return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{"message": ErrAPIKeyRateLimited.Error()})
+}
+
+// hashAPIKey returns the hash of an API key to store.
+func hashAPIKey(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,112 @@
+package goth_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	goth "github.com/katallaxie/fiber-goth/v3"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+func TestAPIKeyScopes(t *testing.T) {
+	adapter := newAdapter()
+	cfg := adapter.config()
+	user := adapter.createUser()
+
+	key, _, err := goth.CreateAPIKey(t.Context(), adapters.GothAPIKey{Name: "ci", UserID: user.ID, Scope: "repo"}, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := fiber.New()
+	app.Use(goth.APIKey(cfg))
+	app.Get("/read", goth.RequireScopes("repo:read"), func(c fiber.Ctx) error {
+		return c.SendStatus(http.StatusOK)
+	})
+	app.Get("/user", goth.RequireScopes("user"), func(c fiber.Ctx) error {
+		return c.SendStatus(http.StatusOK)
+	})
+	goth.MountAPI(app, cfg)
+
+	tests := []struct {
+		target string
+		want   int
+	}{
+		{"/read", http.StatusOK},
+		{"/user", http.StatusForbidden},
+		{"/api/auth/get-session", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		res, _ := request(t, app, fiber.MethodGet, tt.target, key, "")
+		if res.StatusCode != tt.want {
+			t.Errorf("%s: status = %d, want %d", tt.target, res.StatusCode, tt.want)
+		}
+	}
+}
+
+func TestAPIKeyRateLimit(t *testing.T) {
+	adapter := newAdapter()
+	cfg := adapter.config()
+	user := adapter.createUser()
+
+	key, _, err := goth.CreateAPIKey(t.Context(), adapters.GothAPIKey{Name: "ci", UserID: user.ID, RateLimit: 2}, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := fiber.New()
+	app.Use(goth.APIKey(cfg))
+	app.Get("/", func(c fiber.Ctx) error {
+		return c.SendStatus(http.StatusOK)
+	})
+
+	for _, want := range []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+		res, _ := request(t, app, fiber.MethodGet, "/", key, "")
+		if res.StatusCode != want {
+			t.Errorf("status = %d, want %d", res.StatusCode, want)
+		}
+	}
+
+	res, _ := request(t, app, fiber.MethodGet, "/", "sk_unknown", "")
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d for an unknown key", res.StatusCode, http.StatusUnauthorized)
+	}
+}
+
+func (a *memAdapter) CreateAPIKey(_ context.Context, key adapters.GothAPIKey) (adapters.GothAPIKey, error) {
+	key.ID = uuid.New()
+	a.apiKeys[key.KeyHash] = key
+
+	return key, nil
+}
+
+func (a *memAdapter) GetAPIKey(_ context.Context, keyHash string) (adapters.GothAPIKey, error) {
+	key, ok := a.apiKeys[keyHash]
+	if !ok {
+		return adapters.GothAPIKey{}, errors.New("missing key")
+	}
+
+	return key, nil
+}
+
+func (a *memAdapter) UseAPIKey(_ context.Context, key adapters.GothAPIKey) (adapters.GothAPIKey, error) {
+	now := time.Now()
+	key.LastUsedAt = &now
+
+	if key.WindowStartedAt == nil || key.WindowStartedAt.Add(key.RateLimitWindow).Before(now) {
+		key.WindowStartedAt = &now
+		key.RequestCount = 0
+	}
+
+	key.RequestCount++
+	a.apiKeys[key.KeyHash] = key
+
+	return key, nil
+}
@@ -65,6 +65,13 @@ type Config struct {
 
 	// TokenGenerator is a function that generates a CSRF token.
 	TokenGenerator TokenGenerator
+
+	// ResponseHeader is the header of the response the current token is sent in.
+	// Requests with ignored methods issue a new token if the token of the session has expired.
+	// Clients use it to learn the token after it has been rotated.
+	//
+	// Optional. Default: ""
+	ResponseHeader string
 }
 
 const defaultIdleTimeout = 30 * time.Minute
@@ -152,6 +159,19 @@ func New(config ...Config) fiber.Handler {
 
 		// Skip middleware if the method is ignored
 		if slices.Any(func(method string) bool { return method == c.Method() }, cfg.IgnoredMethods...) {
+			if utilx.Empty(cfg.ResponseHeader) {
+				return c.Next()
+			}
+
+			if session.GetCsrfToken().HasExpired() {
+				session, err = issueToken(c, cfg, session)
+				if err != nil {
+					return cfg.ErrorHandler(c, err)
+				}
+			}
+
+			c.Set(cfg.ResponseHeader, session.GetCsrfToken().Token)
+
 			return c.Next()
 		}
 
@@ -174,17 +194,7 @@ func New(config ...Config) fiber.Handler {
 			return cfg.ErrorHandler(c, ErrTokenNotFound)
 		}
 
-		t, err := cfg.TokenGenerator()
-		if err != nil {
-			return cfg.ErrorHandler(c, ErrGenerateToken)
-		}
-
-		session.CsrfToken = adapters.GothCsrfToken{
-			Token:     t,
-			ExpiresAt: time.Now().Add(cfg.IdleTimeout),
-		}
-
-		session, err = cfg.Adapter.UpdateSession(c, session)
+		session, err = issueToken(c, cfg, session)
 		if err != nil {
 			return cfg.ErrorHandler(c, err)
 		}
@@ -192,11 +202,30 @@ func New(config ...Config) fiber.Handler {
 		// Set the session in the context
 		c.Locals(csrfTokenKey, session.CsrfToken)
 
+		if utilx.NotEmpty(cfg.ResponseHeader) {
+			c.Set(cfg.ResponseHeader, session.CsrfToken.Token)
+		}
+
 		// continue stack
 		return c.Next()
 	}
 }
 
+// issueToken generates a new token and stores it in the session.
+func issueToken(c fiber.Ctx, cfg Config, session adapters.GothSession) (adapters.GothSession, error) {
+	t, err := cfg.TokenGenerator()
+	if err != nil {
+		return adapters.GothSession{}, ErrGenerateToken
+	}
+
+	session.CsrfToken = adapters.GothCsrfToken{
+		Token:     t,
+		ExpiresAt: time.Now().Add(cfg.IdleTimeout),
+	}
+
+	return cfg.Adapter.UpdateSession(c, session)
+}
+
 // TokenFromContext returns the CSRF token from the context.
 func TokenFromContext(c fiber.Ctx) (string, error) {
 	token, ok := c.Locals(csrfTokenKey).(adapters.GothCsrfToken)
@@ -0,0 +1,134 @@
+package goth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+	"github.com/katallaxie/fiber-goth/v3/providers"
+	"golang.org/x/oauth2"
+)
+
+// The error codes of the device authorization grant that are not returned by the provider, see RFC 6749 section 5.2.
+const (
+	deviceInvalidRequest       = "invalid_request"
+	deviceUnsupportedGrantType = "unsupported_grant_type"
+	deviceServerError          = "server_error"
+)
+
+// DeviceToken is the session of a device that the user has authorized.
+type DeviceToken struct {
+	// AccessToken is the token of the session.
+	AccessToken string `json:"access_token"`
+	// TokenType is the type of the token, which is "Bearer".
+	TokenType string `json:"token_type"`
+	// ExpiresIn is the lifetime of the session in seconds.
+	ExpiresIn int64 `json:"expires_in"`
+	// TwoFactorPending is true if the session waits for the second factor of the user.
+	TwoFactorPending bool `json:"two_factor_pending,omitempty"`
+}
+
+// deviceError is the error of the device authorization grant, see RFC 8628 section 3.5.
+type deviceError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+type deviceAuth struct {
+	cfg Config
+}
+
+// MountDeviceAuth registers the JSON endpoints of the device authorization grant (RFC 8628) at the router,
+// e.g. to sign in on a CLI with the browser of another device.
+//
+//	POST /:provider/code   requests a device code and a user code of the provider
+//	POST /:provider/token  polls for the session of the "device_code" form value
+//
+// The CLI shows the user code and the verification URI of the provider, and polls the token endpoint
+// with the interval of the device code until the user has authorized the device.
+// The endpoint responds with the errors of the provider, e.g. "authorization_pending" or "slow_down",
+// and once with the session token. The provider has to implement the device authorizer.
+func MountDeviceAuth(router fiber.Router, config Config) {
+	d := &deviceAuth{cfg: configDefault(config)}
+
+	router.Post("/:provider/code", d.code)
+	router.Post("/:provider/token", d.token)
+}
+
+func (d *deviceAuth) code(c fiber.Ctx) error {
+	_, device, ok := deviceProvider(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(deviceError{Error: deviceUnsupportedGrantType})
+	}
+
+	res, err := device.DeviceAuth(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadGateway).JSON(deviceError{Error: deviceServerError})
+	}
+
+	return c.JSON(res)
+}
+
+func (d *deviceAuth) token(c fiber.Ctx) error {
+	provider, device, ok := deviceProvider(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(deviceError{Error: deviceUnsupportedGrantType})
+	}
+
+	params := &Params{ctx: c}
+	if params.Get(providers.ParamDeviceCode) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(deviceError{Error: deviceInvalidRequest})
+	}
+
+	user, err := device.CompleteDeviceAuth(c, d.cfg.Adapter, params)
+
+	var re *oauth2.RetrieveError
+
+	switch {
+	case errors.As(err, &re) && re.ErrorCode != "":
+		return c.Status(fiber.StatusBadRequest).JSON(deviceError{Error: re.ErrorCode, ErrorDescription: re.ErrorDescription})
+	case err != nil:
+		return c.Status(fiber.StatusBadRequest).JSON(deviceError{Error: providers.DeviceAccessDenied})
+	}
+
+	duration, err := time.ParseDuration(d.cfg.Expiry)
+	if err != nil {
+		return err
+	}
+	expires := time.Now().Add(duration)
+	opts := append(sessionMetadata(c), authMetadata(provider, params, false)...)
+
+	// Users with a second factor verify the code with the pending session.
+	pending := requiresTwoFactor(c, d.cfg, user.ID)
+	if pending {
+		expires = time.Now().Add(DefaultTwoFactorTimeout)
+		opts = append(opts, adapters.WithTwoFactorPending())
+	}
+
+	session, err := d.cfg.Adapter.CreateSession(c, user.ID, expires, opts...)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(deviceError{Error: deviceServerError})
+	}
+
+	c.Set(fiber.HeaderCacheControl, "no-store")
+
+	return c.JSON(DeviceToken{
+		AccessToken:      session.SessionToken,
+		TokenType:        "Bearer",
+		ExpiresIn:        int64(time.Until(expires).Seconds()),
+		TwoFactorPending: pending,
+	})
+}
+
+// deviceProvider returns the provider of the request if it supports the device authorization grant.
+func deviceProvider(c fiber.Ctx) (providers.Provider, providers.DeviceAuthorizer, bool) {
+	p, err := providers.GetProvider(c.Params(provider))
+	if err != nil {
+		return nil, nil, false
+	}
+
+	d, ok := p.(providers.DeviceAuthorizer)
+
+	return p, d, ok
+}
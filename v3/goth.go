@@ -5,9 +5,11 @@
 package goth
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
@@ -17,9 +19,14 @@ import (
 
 	"github.com/gofiber/fiber/v2/utils"
 	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	"github.com/katallaxie/fiber-goth/v3/access"
 	"github.com/katallaxie/fiber-goth/v3/adapters"
+	"github.com/katallaxie/fiber-goth/v3/pkg/controllers"
 	"github.com/katallaxie/fiber-goth/v3/providers"
+	"github.com/katallaxie/pkg/cast"
 	"github.com/katallaxie/pkg/utilx"
+	"golang.org/x/oauth2"
 )
 
 var _ Handler = (*BeginAuthHandler)(nil)
@@ -29,10 +36,23 @@ const charset = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz-
 // Environment represents the environment the application is running in.
 type Environment string
 
+// Intent is the intent of an authentication process.
+type Intent string
+
+const (
+	// IntentSignIn signs in the user and creates a new session.
+	IntentSignIn Intent = "signin"
+	// IntentLink links the account of the provider to the signed-in user.
+	IntentLink Intent = "link"
+)
+
 // StateCtx holds the state of the authentication process.
 type StateCtx struct {
 	Nounce      string `json:"nounce"`
 	RedirectURL string `json:"redirect_url"`
+	Intent      Intent `json:"intent,omitempty"`
+	SessionID   string `json:"session_id,omitempty"`
+	Reauth      bool   `json:"reauth,omitempty"`
 }
 
 const (
@@ -52,11 +72,17 @@ const (
 type Params struct {
 	ctx          fiber.Ctx
 	codeVerifier string
+	auth         providers.AuthContext
 }
 
 // Get returns the value of a query paramater.
+// It falls back to the form value of the request, e.g. of a POST to the complete handler.
 func (p *Params) Get(key string) string {
-	return p.ctx.Query(key)
+	if v := p.ctx.Query(key); v != "" {
+		return v
+	}
+
+	return p.ctx.FormValue(key)
 }
 
 // CodeVerifier returns the code verifier for PKCE, if applicable.
@@ -64,6 +90,11 @@ func (p *Params) CodeVerifier() string {
 	return p.codeVerifier
 }
 
+// RecordAuthContext records the context of the authentication at the provider for the session.
+func (p *Params) RecordAuthContext(auth providers.AuthContext) {
+	p.auth = auth
+}
+
 // The contextKey type is unexported to prevent collisions with context keys defined in
 // other packages.
 type contextKey int
@@ -74,11 +105,19 @@ const (
 	sessionKey
 	tokenKey
 	userIDKey
+	pendingSessionKey
+	beginAuthURLKey
+	apiKeyKey
+	personalAccessTokenKey
+	scopesKey
+	sessionCreatorKey
+	stateKey
 )
 
 const (
-	SessionScope      = "session"
-	CodeVerifierScope = "code_verifier"
+	SessionScope       = "session"
+	CodeVerifierScope  = "code_verifier"
+	TrustedDeviceScope = "trusted_device"
 )
 
 // Error is the default error type for the goth middleware.
@@ -115,15 +154,65 @@ var (
 	ErrBadSession = NewError(http.StatusBadRequest, "session is invalid")
 	// ErrMissingUser is thrown if the user is missing.
 	ErrMissingUser = NewError(http.StatusBadRequest, "missing user")
+	// ErrMissingAccount is thrown if the account is missing.
+	ErrMissingAccount = NewError(http.StatusBadRequest, "missing account")
+	// ErrAccountLinked is thrown if the account of the provider is linked to another user.
+	ErrAccountLinked = NewError(http.StatusConflict, "account is already linked to another user")
+	// ErrMissingOrganization is thrown if the organization is missing.
+	ErrMissingOrganization = NewError(http.StatusBadRequest, "missing organization")
+	// ErrMissingMember is thrown if the member is missing.
+	ErrMissingMember = NewError(http.StatusBadRequest, "missing member")
+	// ErrMissingTeam is thrown if the team is missing.
+	ErrMissingTeam = NewError(http.StatusBadRequest, "missing team")
+	// ErrMissingInvitation is thrown if the invitation is missing.
+	ErrMissingInvitation = NewError(http.StatusBadRequest, "missing invitation")
+	// ErrMissingCredential is thrown if the WebAuthn credential is missing.
+	ErrMissingCredential = NewError(http.StatusBadRequest, "missing credential")
+	// ErrMissingAdapter is thrown if no adapter is configured.
+	ErrMissingAdapter = NewError(http.StatusInternalServerError, "missing adapter")
 	// ErrMissingCookie is thrown if the cookie is missing.
 	ErrMissingCookie = NewError(http.StatusBadRequest, "missing session cookie")
+	// ErrBadToken is thrown if a verification token is invalid or has expired.
+	ErrBadToken = NewError(http.StatusBadRequest, "token is invalid or has expired")
 	// ErrBadRequest is thrown if the request is invalid.
 	ErrBadRequest = NewError(http.StatusBadRequest, "bad request")
+	// ErrForbidden is thrown if the user is not allowed to perform an action.
+	ErrForbidden = NewError(http.StatusForbidden, "forbidden")
+	// ErrTwoFactorRequired is thrown if the session waits for the second factor.
+	ErrTwoFactorRequired = NewError(http.StatusUnauthorized, "two-factor authentication required")
+	// ErrMissingTwoFactor is thrown if the user has not enabled two-factor authentication.
+	ErrMissingTwoFactor = NewError(http.StatusBadRequest, "two-factor authentication is not enabled")
+	// ErrTwoFactorEnabled is thrown if the user has already enabled two-factor authentication.
+	ErrTwoFactorEnabled = NewError(http.StatusConflict, "two-factor authentication is already enabled")
+	// ErrBadTwoFactorCode is thrown if a code of the second factor is invalid or has been used.
+	ErrBadTwoFactorCode = NewError(http.StatusBadRequest, "two-factor code is invalid")
+	// ErrTwoFactorLocked is thrown if there have been too many attempts to enter a code of the second factor.
+	ErrTwoFactorLocked = NewError(http.StatusTooManyRequests, "too many attempts, try again later")
+	// ErrMissingTrustedDevice is thrown if the trusted device could not be found.
+	ErrMissingTrustedDevice = NewError(http.StatusNotFound, "trusted device not found")
+	// ErrMissingPersonalAccessToken is thrown if the personal access token could not be found.
+	ErrMissingPersonalAccessToken = NewError(http.StatusNotFound, "personal access token not found")
+	// ErrRecentAuthRequired is thrown if the session requires a recent authentication that cannot be requested.
+	ErrRecentAuthRequired = NewError(http.StatusUnauthorized, "recent authentication required")
+	// ErrMissingToken is thrown if the request has no bearer token.
+	ErrMissingToken = NewError(http.StatusUnauthorized, "missing bearer token")
+	// ErrMissingAPIKey is thrown if the API key is not found.
+	ErrMissingAPIKey = NewError(http.StatusNotFound, "API key not found")
+	// ErrBadAPIKey is thrown if the API key is invalid or has expired.
+	ErrBadAPIKey = NewError(http.StatusUnauthorized, "API key is invalid or has expired")
+	// ErrAPIKeyRateLimited is thrown if the API key has exceeded its rate limit.
+	ErrAPIKeyRateLimited = NewError(http.StatusTooManyRequests, "API key rate limit exceeded")
+	// ErrBadPersonalAccessToken is thrown if the personal access token is invalid or has expired.
+	ErrBadPersonalAccessToken = NewError(http.StatusUnauthorized, "personal access token is invalid or has expired")
+	// ErrInsufficientScope is thrown if the API key or personal access token lacks a required scope.
+	ErrInsufficientScope = NewError(http.StatusForbidden, "insufficient scope")
 )
 
 const (
-	state    = "state"
-	provider = "provider"
+	state      = "state"
+	relayState = "RelayState"
+	provider   = "provider"
+	intent     = "intent"
 )
 
 // ProviderFromContext returns the provider from the request context.
@@ -131,6 +220,13 @@ func ProviderFromContext(c fiber.Ctx) string {
 	return c.Get(fmt.Sprint(providerKey))
 }
 
+// StateFromContext returns the state of the authentication that is completed by the request.
+// The state is sent by the provider either as query parameter or, e.g. by SAML, as relay state.
+func StateFromContext(c fiber.Ctx) (*StateCtx, bool) {
+	s, ok := c.Locals(stateKey).(*StateCtx)
+	return s, ok
+}
+
 // SessionHandler is the default handler for the session.
 type SessionHandler struct{}
 
@@ -141,23 +237,30 @@ func (SessionHandler) New(cfg Config) fiber.Handler {
 			return c.Next()
 		}
 
-		cookie := c.Cookies(cfg.CookieName(SessionScope))
-		if cookie == "" {
-			return cfg.ErrorHandler(c, ErrMissingCookie)
+		// API clients authenticate with a bearer token instead of the cookie.
+		bearer := bearerRequest(c)
+
+		cookie, err := sessionToken(c, cfg, bearer)
+		if err != nil {
+			return sessionError(c, cfg, bearer, err)
 		}
 
 		session, err := cfg.Adapter.GetSession(c, cookie)
 		if err != nil {
-			return cfg.ErrorHandler(c, err)
+			return sessionError(c, cfg, bearer, err)
 		}
 
 		if !session.IsValid() {
-			err := cfg.ErrorHandler(c, err)
+			err := sessionError(c, cfg, bearer, ErrBadSession)
 			if err != nil {
 				return err
 			}
 		}
 
+		if session.TwoFactorPending {
+			return sessionError(c, cfg, bearer, ErrTwoFactorRequired)
+		}
+
 		duration, err := time.ParseDuration(cfg.Expiry)
 		if err != nil {
 			return cfg.ErrorHandler(c, err)
@@ -167,7 +270,11 @@ func (SessionHandler) New(cfg Config) fiber.Handler {
 
 		session, err = cfg.Adapter.RefreshSession(c, session)
 		if err != nil {
-			return cfg.ErrorHandler(c, err)
+			return sessionError(c, cfg, bearer, err)
+		}
+
+		if bearer {
+			return c.Next()
 		}
 
 		cookieValue := &fiber.Cookie{
@@ -213,7 +320,17 @@ func (BeginAuthHandler) New(cfg Config) fiber.Handler {
 			return err
 		}
 
-		state, err := stateFromContext(c)
+		var opts []stateOpt
+		if Intent(c.Query(intent)) == IntentLink {
+			session, err := currentSession(c, cfg)
+			if err != nil {
+				return ErrMissingSession
+			}
+
+			opts = append(opts, withLinkIntent(session))
+		}
+
+		state, err := stateFromContext(c, opts...)
 		if err != nil {
 			return err
 		}
@@ -223,6 +340,17 @@ func (BeginAuthHandler) New(cfg Config) fiber.Handler {
 			return err
 		}
 
+		if form, ok := intent.(providers.AuthFormIntent); ok {
+			page, err := form.GetAuthForm()
+			if err != nil {
+				return err
+			}
+
+			c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+
+			return c.Send(page)
+		}
+
 		url, err := intent.GetAuthURL()
 		if err != nil {
 			return err
@@ -276,22 +404,65 @@ func (CompleteAuthCompleteHandler) New(cfg Config) fiber.Handler {
 		}
 
 		codeVerifier, err := CodeVerifierFromCookie(c, cfg)
-		if err != nil {
+		if err != nil && usesCodeVerifier(provider) {
 			return cfg.ErrorHandler(c, err)
 		}
 
-		user, err := provider.CompleteAuth(c, cfg.Adapter, &Params{ctx: c, codeVerifier: codeVerifier})
+		params := &Params{ctx: c, codeVerifier: codeVerifier}
+
+		// SAML returns the state as relay state.
+		stateParam := params.Get(state)
+		if stateParam == "" {
+			stateParam = params.Get(relayState)
+		}
+
+		stateCtx, err := contextFromState(stateParam)
+		if err != nil {
+			return cfg.ErrorHandler(c, ErrBadRequest)
+		}
+		c.Locals(stateKey, stateCtx)
+
+		adapter := cfg.Adapter
+
+		// The link intent is bound to the session that started the authentication.
+		if stateCtx.Intent == IntentLink {
+			session, err := currentSession(c, cfg)
+			if err != nil || session.ID.String() != stateCtx.SessionID {
+				return cfg.ErrorHandler(c, ErrBadSession)
+			}
+
+			adapter = &linkAdapter{Adapter: cfg.Adapter, userID: session.UserID}
+		}
+
+		user, err := provider.CompleteAuth(c, adapter, params)
+		if errors.Is(err, adapters.ErrAccountAlreadyLinked) {
+			return cfg.ErrorHandler(c, ErrAccountLinked)
+		}
+
 		if err != nil {
 			return cfg.ErrorHandler(c, ErrMissingUser)
 		}
 
+		if stateCtx.Intent == IntentLink {
+			return cfg.CompletionFilter(c)
+		}
+
 		duration, err := time.ParseDuration(cfg.Expiry)
 		if err != nil {
 			return cfg.ErrorHandler(c, ErrMissingSession)
 		}
 		expires := time.Now().Add(duration)
+		opts := append(sessionMetadata(c), authMetadata(provider, params, stateCtx.Reauth)...)
+
+		// Users with a second factor sign in with a pending session until the code is verified,
+		// unless they sign in from a trusted device.
+		pending := requiresTwoFactor(c, cfg, user.ID)
+		if pending {
+			expires = time.Now().Add(DefaultTwoFactorTimeout)
+			opts = append(opts, adapters.WithTwoFactorPending())
+		}
 
-		session, err := cfg.Adapter.CreateSession(c, user.ID, expires)
+		session, err := cfg.Adapter.CreateSession(c, user.ID, expires, opts...)
 		if err != nil {
 			return cfg.ErrorHandler(c, ErrMissingSession)
 		}
@@ -310,6 +481,10 @@ func (CompleteAuthCompleteHandler) New(cfg Config) fiber.Handler {
 		c.Vary(fiber.HeaderCookie)
 		c.Cookie(cookieValue)
 
+		if pending {
+			return redirectToTwoFactor(c, cfg, stateCtx.RedirectURL)
+		}
+
 		return cfg.CompletionFilter(c)
 	}
 }
@@ -366,6 +541,19 @@ func Protect(config ...Config) fiber.Handler {
 			return c.Next()
 		}
 
+		// API clients are not redirected to sign in.
+		if bearerRequest(c) {
+			if pendingTwoFactor(c) {
+				return unauthorized(c, ErrTwoFactorRequired)
+			}
+
+			return unauthorized(c, ErrBadSession)
+		}
+
+		if pendingTwoFactor(c) {
+			return redirectToTwoFactor(c, cfg, c.FullURL())
+		}
+
 		u, err := url.Parse(cfg.LoginURL)
 		if err != nil {
 			return c.Next()
@@ -397,6 +585,12 @@ func Session(config ...Config) fiber.Handler {
 			return c.Next()
 		}
 
+		// A pending session is not attached until the second factor is verified.
+		if session.TwoFactorPending {
+			c.Locals(pendingSessionKey, session)
+			return c.Next()
+		}
+
 		duration, err := time.ParseDuration(cfg.Expiry)
 		if err != nil {
 			return c.Next()
@@ -409,6 +603,16 @@ func Session(config ...Config) fiber.Handler {
 			return c.Next()
 		}
 
+		c.Locals(tokenKey, session.ID)
+		c.Locals(sessionKey, session)
+		c.Locals(userIDKey, session.UserID)
+		c.Locals(beginAuthURLKey, cfg.BeginAuthURL)
+
+		// The session of API clients is not written to a cookie.
+		if bearerRequest(c) {
+			return c.Next()
+		}
+
 		cookieValue := &fiber.Cookie{
 			Name:     cfg.SessionCookieName(),
 			Value:    session.SessionToken,
@@ -422,10 +626,6 @@ func Session(config ...Config) fiber.Handler {
 
 		c.Cookie(cookieValue)
 
-		c.Locals(tokenKey, session.ID)
-		c.Locals(sessionKey, session)
-		c.Locals(userIDKey, session.UserID)
-
 		return c.Next()
 	}
 }
@@ -442,6 +642,19 @@ func ProtectedHandler(handler fiber.Handler, config ...Config) fiber.Handler {
 			return handler(c)
 		}
 
+		// API clients are not redirected to sign in.
+		if bearerRequest(c) {
+			if pendingTwoFactor(c) {
+				return unauthorized(c, ErrTwoFactorRequired)
+			}
+
+			return unauthorized(c, ErrBadSession)
+		}
+
+		if pendingTwoFactor(c) {
+			return redirectToTwoFactor(c, cfg, c.FullURL())
+		}
+
 		u, err := url.Parse(cfg.LoginURL)
 		if err != nil {
 			return c.Next()
@@ -456,8 +669,13 @@ func ProtectedHandler(handler fiber.Handler, config ...Config) fiber.Handler {
 }
 
 // GetStateFromContext return the state that is returned during the callback.
+// SAML returns the state as relay state.
 func GetStateFromContext(ctx fiber.Ctx) string {
-	return ctx.Query(state)
+	if s := ctx.Query(state); s != "" {
+		return s
+	}
+
+	return ctx.FormValue(relayState)
 }
 
 // ContextWithProvider returns a new request context containing the provider.
@@ -477,12 +695,181 @@ func SessionFromContext(c fiber.Ctx) (adapters.GothSession, error) {
 	return session, nil
 }
 
+// ActiveOrganizationFromContext returns the ID of the active organization of the session from the request context.
+func ActiveOrganizationFromContext(c fiber.Ctx) (uuid.UUID, error) {
+	session, err := SessionFromContext(c)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if session.ActiveOrganizationID == nil {
+		return uuid.Nil, ErrMissingOrganization
+	}
+
+	return *session.ActiveOrganizationID, nil
+}
+
+// RequirePermission returns a middleware that requires the member of the active organization
+// to be allowed to perform the action on the resource.
+// The adapter has to implement the organization adapter.
+func RequirePermission(resource, action string, config ...Config) fiber.Handler {
+	cfg := configDefault(config...)
+
+	return func(c fiber.Ctx) error {
+		session, err := SessionFromContext(c)
+		if err != nil {
+			return err
+		}
+
+		if session.ActiveOrganizationID == nil {
+			return ErrMissingOrganization
+		}
+
+		orgs, ok := cfg.Adapter.(adapters.OrganizationAdapter)
+		if !ok {
+			return ErrMissingAdapter
+		}
+
+		member, err := orgs.GetMember(c, *session.ActiveOrganizationID, session.UserID)
+		if err != nil {
+			return ErrForbidden
+		}
+
+		if !cfg.Policy.Can(member.Role, resource, action) {
+			return ErrForbidden
+		}
+
+		return c.Next()
+	}
+}
+
+// ActiveTeamFromContext returns the ID of the active team of the session from the request context.
+func ActiveTeamFromContext(c fiber.Ctx) (uuid.UUID, error) {
+	session, err := SessionFromContext(c)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if session.ActiveTeamID == nil {
+		return uuid.Nil, ErrMissingTeam
+	}
+
+	return *session.ActiveTeamID, nil
+}
+
+// currentSession returns the valid session of the signed-in user.
+// It falls back to the token of the request if the session is not attached to the context.
+func currentSession(c fiber.Ctx, cfg Config) (adapters.GothSession, error) {
+	if session, err := SessionFromContext(c); err == nil {
+		return session, nil
+	}
+
+	token, err := cfg.Extractor(c)
+	if err != nil {
+		return adapters.GothSession{}, err
+	}
+
+	session, err := cfg.Adapter.GetSession(c, token)
+	if err != nil {
+		return adapters.GothSession{}, err
+	}
+
+	if !session.IsValid() {
+		return adapters.GothSession{}, ErrBadSession
+	}
+
+	if session.TwoFactorPending {
+		return adapters.GothSession{}, ErrTwoFactorRequired
+	}
+
+	return session, nil
+}
+
 // ValidSession returns true if the session is valid.
 func ValidSession(c fiber.Ctx) bool {
 	_, ok := c.Locals(sessionKey).(adapters.GothSession)
 	return ok
 }
 
+// pendingTwoFactor returns true if the session of the request waits for the second factor.
+func pendingTwoFactor(c fiber.Ctx) bool {
+	_, ok := c.Locals(pendingSessionKey).(adapters.GothSession)
+	return ok
+}
+
+// bearerToken returns the token of the "Authorization: Bearer" header.
+func bearerToken(c fiber.Ctx) (string, bool) {
+	scheme, token, ok := strings.Cut(c.Get(fiber.HeaderAuthorization), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+
+	return token, token != ""
+}
+
+// bearerRequest returns true if the request is authenticated with a bearer token, e.g. by an API client.
+func bearerRequest(c fiber.Ctx) bool {
+	_, ok := bearerToken(c)
+	return ok
+}
+
+// sessionToken returns the session token of the bearer token or the session cookie.
+func sessionToken(c fiber.Ctx, cfg Config, bearer bool) (string, error) {
+	if bearer {
+		token, _ := bearerToken(c)
+		return token, nil
+	}
+
+	cookie := c.Cookies(cfg.SessionCookieName())
+	if cookie == "" {
+		return "", ErrMissingCookie
+	}
+
+	return cookie, nil
+}
+
+// sessionError responds to bearer requests with 401 and to all other requests with the error handler.
+func sessionError(c fiber.Ctx, cfg Config, bearer bool, err error) error {
+	if bearer {
+		return unauthorized(c, err)
+	}
+
+	return cfg.ErrorHandler(c, err)
+}
+
+// unauthorized responds with 401 and the error as JSON instead of redirecting the client to sign in.
+func unauthorized(c fiber.Ctx, err error) error {
+	c.Set(fiber.HeaderWWWAuthenticate, `Bearer realm="goth"`)
+
+	return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"message": err.Error()})
+}
+
+// AccessTokenFor returns a valid access token of the user for the provider.
+// The token is transparently refreshed and written back through the adapter when it is about to expire.
+func AccessTokenFor(ctx context.Context, userID uuid.UUID, provider string, config ...Config) (*oauth2.Token, error) {
+	cfg := configDefault(config...)
+
+	if cfg.Adapter == nil {
+		return nil, ErrMissingAdapter
+	}
+
+	account, err := providers.AccessToken(ctx, cfg.Adapter, userID, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &oauth2.Token{
+		AccessToken:  cast.Value(account.AccessToken),
+		TokenType:    cast.Value(account.TokenType),
+		RefreshToken: cast.Value(account.RefreshToken),
+		Expiry:       cast.Value(account.ExpiresAt),
+	}
+
+	return token, nil
+}
+
 // Config caputes the configuration for running the goth middleware.
 type Config struct {
 	// Next defines a function to skip this middleware when returned true.
@@ -543,6 +930,11 @@ type Config struct {
 	// LoginURL is the URL to redirect to when the user is not authenticated.
 	LoginURL string
 
+	// BeginAuthURL is the URL of the handler to begin authentication, it has to contain the ":provider" parameter.
+	//
+	// Optional. Default: controllers.DefaultBeginAuthURL
+	BeginAuthURL string
+
 	// LogoutURL is the URL to redirect to when the user logs out.
 	LogoutURL string
 
@@ -552,6 +944,22 @@ type Config struct {
 	// CompletionURL is the default url after completion
 	CompletionURL string
 
+	// TwoFactorURL is the URL to redirect to when the session waits for the second factor.
+	//
+	// Optional. Default: "/two-factor"
+	TwoFactorURL string
+
+	// TrustedOrigins are the origins of absolute URLs that the verification of the second factor
+	// may redirect to, e.g. "https://app.example.com". Relative paths are always allowed.
+	//
+	// Optional. Default: nil
+	TrustedOrigins []string
+
+	// APIURL is the URL the REST API is mounted at.
+	//
+	// Optional. Default: controllers.DefaultBaseURL
+	APIURL string
+
 	// ErrorHandler is executed when an error is returned from fiber.Handler.
 	//
 	// Optional. Default: DefaultErrorHandler
@@ -560,6 +968,11 @@ type Config struct {
 	// Extractor is the function used to extract the token from the request.
 	Extractor func(c fiber.Ctx) (string, error)
 
+	// Policy maps the roles of organization members to their permissions.
+	//
+	// Optional. Default: access.DefaultPolicy
+	Policy access.Policy
+
 	// Environment is the environment the application is running in.
 	Environment Environment
 }
@@ -585,6 +998,11 @@ func (cfg *Config) CodeVerifierCookieName() string {
 	return cfg.CookieName(CodeVerifierScope)
 }
 
+// TrustedDeviceCookieName returns the trusted device cookie name with the prefix.
+func (cfg *Config) TrustedDeviceCookieName() string {
+	return cfg.CookieName(TrustedDeviceScope)
+}
+
 // ConfigDefault is the default config.
 var ConfigDefault = Config{
 	ErrorHandler:        defaultErrorHandler,
@@ -597,11 +1015,15 @@ var ConfigDefault = Config{
 	Decryptor:           DecryptCookie,
 	Expiry:              "7h",
 	Extractor:           TokenFromCookie("fiber_goth.session"),
+	Policy:              access.DefaultPolicy,
 	CookieSameSite:      "lax",
 	CompletionURL:       "/",
+	TwoFactorURL:        "/two-factor",
 	LoginURL:            "/login",
+	BeginAuthURL:        controllers.DefaultBeginAuthURL,
 	LogoutURL:           "/logout",
 	CallbackURL:         "/auth",
+	APIURL:              controllers.DefaultBaseURL,
 	CookiePrefix:        "fiber_goth",
 	CookieSecure:        false,
 	Environment:         Development,
@@ -615,9 +1037,9 @@ func defaultErrorHandler(_ fiber.Ctx, err error) error {
 // default filter for response that process default return.
 func defaultCompletionFilter() fiber.Handler {
 	return func(c fiber.Ctx) error {
-		state, err := contextFromState(c.Query("state"))
-		if err != nil {
-			return NewError(http.StatusBadRequest, err.Error())
+		state, ok := StateFromContext(c)
+		if !ok {
+			state = &StateCtx{}
 		}
 
 		return c.Redirect().Status(http.StatusTemporaryRedirect).To(state.RedirectURL)
@@ -692,6 +1114,10 @@ func configDefault(config ...Config) Config {
 		cfg.LoginURL = ConfigDefault.LoginURL
 	}
 
+	if cfg.BeginAuthURL == "" {
+		cfg.BeginAuthURL = ConfigDefault.BeginAuthURL
+	}
+
 	if cfg.LogoutURL == "" {
 		cfg.LogoutURL = ConfigDefault.LogoutURL
 	}
@@ -704,6 +1130,14 @@ func configDefault(config ...Config) Config {
 		cfg.CallbackURL = ConfigDefault.CallbackURL
 	}
 
+	if cfg.TwoFactorURL == "" {
+		cfg.TwoFactorURL = ConfigDefault.TwoFactorURL
+	}
+
+	if cfg.APIURL == "" {
+		cfg.APIURL = ConfigDefault.APIURL
+	}
+
 	if utilx.Empty(cfg.CookiePrefix) {
 		cfg.CookiePrefix = ConfigDefault.CookiePrefix
 	}
@@ -720,9 +1154,22 @@ func configDefault(config ...Config) Config {
 		cfg.Environment = ConfigDefault.Environment
 	}
 
+	if cfg.Policy == nil {
+		cfg.Policy = ConfigDefault.Policy
+	}
+
 	return cfg
 }
 
+// sessionMetadata returns the metadata of the client to record on a new session.
+// The IP address respects the trusted proxy settings of the app.
+func sessionMetadata(c fiber.Ctx) []adapters.SessionOpt {
+	return []adapters.SessionOpt{
+		adapters.WithIPAddress(c.IP()),
+		adapters.WithUserAgent(c.Get(fiber.HeaderUserAgent)),
+	}
+}
+
 func contextFromState(state string) (*StateCtx, error) {
 	if state == "" {
 		return &StateCtx{}, nil
@@ -741,7 +1188,18 @@ func contextFromState(state string) (*StateCtx, error) {
 	return &s, nil
 }
 
-func stateFromContext(ctx fiber.Ctx) (string, error) {
+// stateOpt is a function that configures the state of the authentication process.
+type stateOpt func(*StateCtx)
+
+// withLinkIntent links the account of the provider to the user of the session.
+func withLinkIntent(session adapters.GothSession) stateOpt {
+	return func(s *StateCtx) {
+		s.Intent = IntentLink
+		s.SessionID = session.ID.String()
+	}
+}
+
+func stateFromContext(ctx fiber.Ctx, opts ...stateOpt) (string, error) {
 	nonce, err := generateRandomString(64) //nolint:mnd
 	if err != nil {
 		return "", err
@@ -750,6 +1208,12 @@ func stateFromContext(ctx fiber.Ctx) (string, error) {
 	s := &StateCtx{
 		Nounce:      string(nonce),
 		RedirectURL: ctx.Query("redirect_uri"),
+		Intent:      IntentSignIn,
+		Reauth:      ctx.Query(providers.ParamPrompt) == providers.PromptLogin,
+	}
+
+	for _, opt := range opts {
+		opt(s)
 	}
 
 	state, err := json.Marshal(s)
@@ -784,6 +1248,36 @@ func TokenFromContext(c fiber.Ctx) string {
 	return token
 }
 
+// TokenFromAuthorizationHeader returns a function that extracts the token from the "Authorization: Bearer" header.
+func TokenFromAuthorizationHeader() func(c fiber.Ctx) (string, error) {
+	return func(c fiber.Ctx) (string, error) {
+		token, ok := bearerToken(c)
+		if !ok {
+			return "", ErrMissingToken
+		}
+
+		return token, nil
+	}
+}
+
+// TokenFromChain returns a function that extracts the token with the first extractor that finds one,
+// e.g. of the Authorization header and then of the cookie.
+func TokenFromChain(extractors ...func(c fiber.Ctx) (string, error)) func(c fiber.Ctx) (string, error) {
+	return func(c fiber.Ctx) (string, error) {
+		var err error = ErrMissingToken
+
+		for _, extractor := range extractors {
+			token, e := extractor(c)
+			if e == nil {
+				return token, nil
+			}
+			err = e
+		}
+
+		return "", err
+	}
+}
+
 // TokenFromCookie returns a function that extracts token from the cookie header.
 func TokenFromCookie(param string) func(c fiber.Ctx) (string, error) {
 	return func(c fiber.Ctx) (string, error) {
@@ -796,6 +1290,19 @@ func TokenFromCookie(param string) func(c fiber.Ctx) (string, error) {
 	}
 }
 
+// usesCodeVerifier returns true if the provider completes the authentication with a code verifier.
+// Email providers complete it with the link or code that has been sent, which may be used in another browser,
+// WebAuthn providers start the authentication without the begin handler,
+// and SAML providers receive the response with a cross-site post that does not carry the cookie.
+func usesCodeVerifier(p providers.Provider) bool {
+	switch p.Type() {
+	case providers.ProviderTypeEmail, providers.ProviderTypeWebAuthn, providers.ProviderTypeSAML:
+		return false
+	default:
+		return true
+	}
+}
+
 // CodeVerifierFromCookie returns the code verifier from the cookie.
 func CodeVerifierFromCookie(c fiber.Ctx, cfg Config) (string, error) {
 	cookie := c.Cookies(cfg.CodeVerifierCookieName())
@@ -0,0 +1,170 @@
+package goth_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	goth "github.com/katallaxie/fiber-goth/v3"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// request sends a request to the app, the body is sent as JSON and the token as bearer token.
+func request(t *testing.T, app *fiber.App, method, target, token, body string) (*http.Response, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, target, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+	if token != "" {
+		req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+	}
+
+	res, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return res, string(b)
+}
+
+type memAdapter struct {
+	users      map[uuid.UUID]adapters.GothUser
+	sessions   map[string]adapters.GothSession
+	twoFactors map[uuid.UUID]adapters.GothTwoFactor
+	tokens     map[string]adapters.GothPersonalAccessToken
+	apiKeys    map[string]adapters.GothAPIKey
+
+	adapters.UnimplementedAdapter
+	adapters.UnimplementedTwoFactorAdapter
+	adapters.UnimplementedPersonalAccessTokenAdapter
+	adapters.UnimplementedAPIKeyAdapter
+}
+
+func newAdapter() *memAdapter {
+	return &memAdapter{
+		users:      map[uuid.UUID]adapters.GothUser{},
+		sessions:   map[string]adapters.GothSession{},
+		twoFactors: map[uuid.UUID]adapters.GothTwoFactor{},
+		tokens:     map[string]adapters.GothPersonalAccessToken{},
+		apiKeys:    map[string]adapters.GothAPIKey{},
+	}
+}
+
+func (a *memAdapter) config() goth.Config {
+	return goth.Config{Adapter: a, Secret: goth.GenerateKey()}
+}
+
+func (a *memAdapter) createUser() adapters.GothUser {
+	user := adapters.GothUser{ID: uuid.New(), Name: "Jane", Email: "jane@example.com"}
+	a.users[user.ID] = user
+
+	return user
+}
+
+func (a *memAdapter) GetUser(_ context.Context, id uuid.UUID) (adapters.GothUser, error) {
+	user, ok := a.users[id]
+	if !ok {
+		return adapters.GothUser{}, errors.New("missing user")
+	}
+
+	return user, nil
+}
+
+func (a *memAdapter) CreateSession(_ context.Context, userID uuid.UUID, expires time.Time, opts ...adapters.SessionOpt) (adapters.GothSession, error) {
+	session := adapters.GothSession{ID: uuid.New(), UserID: userID, SessionToken: uuid.NewString(), ExpiresAt: expires}
+
+	for _, opt := range opts {
+		opt(&session)
+	}
+
+	a.sessions[session.SessionToken] = session
+
+	return session, nil
+}
+
+func (a *memAdapter) GetSession(_ context.Context, token string) (adapters.GothSession, error) {
+	session, ok := a.sessions[token]
+	if !ok {
+		return adapters.GothSession{}, errors.New("missing session")
+	}
+
+	return session, nil
+}
+
+func (a *memAdapter) DeleteSession(_ context.Context, token string) error {
+	delete(a.sessions, token)
+	return nil
+}
+
+func (a *memAdapter) GetTwoFactor(_ context.Context, userID uuid.UUID) (adapters.GothTwoFactor, error) {
+	tf, ok := a.twoFactors[userID]
+	if !ok {
+		return adapters.GothTwoFactor{}, errors.New("missing second factor")
+	}
+
+	return tf, nil
+}
+
+func (a *memAdapter) UpdateTwoFactor(_ context.Context, tf adapters.GothTwoFactor) (adapters.GothTwoFactor, error) {
+	tf.UpdatedAt = time.Now()
+	a.twoFactors[tf.UserID] = tf
+
+	return tf, nil
+}
+
+func (a *memAdapter) IncrementTwoFactorAttempts(ctx context.Context, userID uuid.UUID) (adapters.GothTwoFactor, error) {
+	tf, err := a.GetTwoFactor(ctx, userID)
+	if err != nil {
+		return adapters.GothTwoFactor{}, err
+	}
+
+	tf.Attempts++
+
+	return a.UpdateTwoFactor(ctx, tf)
+}
+
+func (a *memAdapter) UseTwoFactorStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	tf, err := a.GetTwoFactor(ctx, userID)
+	if err != nil || tf.LastUsedStep >= step {
+		return goth.ErrBadTwoFactorCode
+	}
+
+	tf.LastUsedStep = step
+	tf.Attempts = 0
+
+	_, err = a.UpdateTwoFactor(ctx, tf)
+
+	return err
+}
+
+func (a *memAdapter) GetPersonalAccessToken(_ context.Context, tokenHash string) (adapters.GothPersonalAccessToken, error) {
+	token, ok := a.tokens[tokenHash]
+	if !ok {
+		return adapters.GothPersonalAccessToken{}, errors.New("missing token")
+	}
+
+	return token, nil
+}
+
+func (a *memAdapter) UpdatePersonalAccessToken(_ context.Context, token adapters.GothPersonalAccessToken) (adapters.GothPersonalAccessToken, error) {
+	a.tokens[token.TokenHash] = token
+	return token, nil
+}
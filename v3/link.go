@@ -0,0 +1,48 @@
+package goth
+
+import (
+	"context"
+
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+
+	"github.com/google/uuid"
+)
+
+var _ adapters.Adapter = (*linkAdapter)(nil)
+
+// linkAdapter attaches the accounts of a completed authentication to the signed-in user
+// instead of creating or selecting a user by email.
+type linkAdapter struct {
+	adapters.Adapter
+	userID uuid.UUID
+}
+
+// CreateUser links the accounts of the user to the signed-in user.
+func (a *linkAdapter) CreateUser(ctx context.Context, user adapters.GothUser) (adapters.GothUser, error) {
+	for _, account := range user.Accounts {
+		if _, err := a.LinkAccount(ctx, a.userID, account); err != nil {
+			return adapters.GothUser{}, err
+		}
+	}
+
+	return a.GetUser(ctx, a.userID)
+}
+
+// GetUserByEmail does not select users by email, the accounts are always linked to the signed-in user.
+func (a *linkAdapter) GetUserByEmail(_ context.Context, _ string) (adapters.GothUser, error) {
+	return adapters.GothUser{}, ErrMissingUser
+}
+
+// GetUserByAccount returns the signed-in user if the account is linked to it.
+func (a *linkAdapter) GetUserByAccount(ctx context.Context, provider, providerAccountID string) (adapters.GothUser, error) {
+	user, err := a.Adapter.GetUserByAccount(ctx, provider, providerAccountID)
+	if err != nil {
+		return adapters.GothUser{}, err
+	}
+
+	if user.ID != a.userID {
+		return adapters.GothUser{}, adapters.ErrAccountAlreadyLinked
+	}
+
+	return user, nil
+}
@@ -0,0 +1,976 @@
+// Package apis provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package apis
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Account defines model for Account.
+type Account struct {
+	AccessToken           *string    `json:"accessToken,omitempty"`
+	AccessTokenExpiresAt  *time.Time `json:"accessTokenExpiresAt,omitempty"`
+	AccountId             string     `json:"accountId"`
+	CreatedAt             time.Time  `json:"createdAt"`
+	Id                    *string    `json:"id,omitempty"`
+	IdToken               *string    `json:"idToken,omitempty"`
+	Password              *string    `json:"password,omitempty"`
+	ProviderId            string     `json:"providerId"`
+	RefreshToken          *string    `json:"refreshToken,omitempty"`
+	RefreshTokenExpiresAt *time.Time `json:"refreshTokenExpiresAt,omitempty"`
+	Scope                 *string    `json:"scope,omitempty"`
+	UpdatedAt             time.Time  `json:"updatedAt"`
+	UserId                string     `json:"userId"`
+}
+
+// Invitation defines model for Invitation.
+type Invitation struct {
+	CreatedAt      time.Time `json:"createdAt"`
+	Email          string    `json:"email"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+	Id             *string   `json:"id,omitempty"`
+	InviterId      string    `json:"inviterId"`
+	OrganizationId string    `json:"organizationId"`
+	Role           *string   `json:"role,omitempty"`
+	Status         string    `json:"status"`
+	TeamId         *string   `json:"teamId,omitempty"`
+}
+
+// Member defines model for Member.
+type Member struct {
+	CreatedAt      time.Time `json:"createdAt"`
+	Id             *string   `json:"id,omitempty"`
+	OrganizationId string    `json:"organizationId"`
+	Role           string    `json:"role"`
+	UserId         string    `json:"userId"`
+}
+
+// Organization defines model for Organization.
+type Organization struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Id        *string   `json:"id,omitempty"`
+	Logo      *string   `json:"logo,omitempty"`
+	Metadata  *string   `json:"metadata,omitempty"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+}
+
+// PersonalAccessToken defines model for PersonalAccessToken.
+type PersonalAccessToken struct {
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	Id         string     `json:"id"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	Name       string     `json:"name"`
+
+	// OrganizationId The organization the token is limited to, it is revoked when the user leaves the organization
+	OrganizationId *string `json:"organizationId,omitempty"`
+
+	// Prefix The visible prefix of the token to recognize it
+	Prefix string   `json:"prefix"`
+	Scopes []string `json:"scopes"`
+	UserId string   `json:"userId"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	ActiveOrganizationId *string   `json:"activeOrganizationId,omitempty"`
+	ActiveTeamId         *string   `json:"activeTeamId,omitempty"`
+	CreatedAt            time.Time `json:"createdAt"`
+	ExpiresAt            time.Time `json:"expiresAt"`
+	Id                   string    `json:"id"`
+	IpAddress            *string   `json:"ipAddress,omitempty"`
+	UpdatedAt            time.Time `json:"updatedAt"`
+	UserAgent            *string   `json:"userAgent,omitempty"`
+	UserId               string    `json:"userId"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	CreatedAt      time.Time  `json:"createdAt"`
+	Id             *string    `json:"id,omitempty"`
+	Name           string     `json:"name"`
+	OrganizationId string     `json:"organizationId"`
+	UpdatedAt      *time.Time `json:"updatedAt,omitempty"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+	TeamId    string     `json:"teamId"`
+	UserId    string     `json:"userId"`
+}
+
+// TrustedDevice defines model for TrustedDevice.
+type TrustedDevice struct {
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	Id         string     `json:"id"`
+	IpAddress  *string    `json:"ipAddress,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	UserAgent  *string    `json:"userAgent,omitempty"`
+	UserId     string     `json:"userId"`
+}
+
+// User defines model for User.
+type User struct {
+	CreatedAt     time.Time `json:"createdAt"`
+	Email         string    `json:"email"`
+	EmailVerified *bool     `json:"emailVerified,omitempty"`
+	Id            *string   `json:"id,omitempty"`
+	Image         *string   `json:"image,omitempty"`
+	Name          string    `json:"name"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+// Verification defines model for Verification.
+type Verification struct {
+	CreatedAt  time.Time `json:"createdAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	Id         *string   `json:"id,omitempty"`
+	Identifier string    `json:"identifier"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	Value      string    `json:"value"`
+}
+
+// ChangeEmailJSONBody defines parameters for ChangeEmail.
+type ChangeEmailJSONBody struct {
+	// CallbackURL The URL to redirect to after email verification
+	CallbackURL *string `json:"callbackURL,omitempty"`
+
+	// NewEmail The new email address to set must be a valid email address
+	NewEmail string `json:"newEmail"`
+}
+
+// ChangePasswordJSONBody defines parameters for ChangePassword.
+type ChangePasswordJSONBody struct {
+	// CurrentPassword The current password is required
+	CurrentPassword string `json:"currentPassword"`
+
+	// NewPassword The new password to set
+	NewPassword string `json:"newPassword"`
+
+	// RevokeOtherSessions Must be a boolean value
+	RevokeOtherSessions *bool `json:"revokeOtherSessions,omitempty"`
+}
+
+// CreatePersonalAccessTokenJSONBody defines parameters for CreatePersonalAccessToken.
+type CreatePersonalAccessTokenJSONBody struct {
+	// ExpiresIn The lifetime of the token in seconds, tokens without it do not expire
+	ExpiresIn *int `json:"expiresIn,omitempty"`
+
+	// Name The name of the token
+	Name string `json:"name"`
+
+	// OrganizationId The organization to limit the token to, the user has to be a member
+	OrganizationId *string `json:"organizationId,omitempty"`
+
+	// Scopes The scopes of the token. Eg: "repo:read"
+	Scopes []string `json:"scopes"`
+}
+
+// DeleteUserJSONBody defines parameters for DeleteUser.
+type DeleteUserJSONBody struct {
+	// CallbackURL The callback URL to redirect to after the user is deleted
+	CallbackURL *string `json:"callbackURL,omitempty"`
+
+	// Password The user's password. Required if session is not fresh
+	Password *string `json:"password,omitempty"`
+
+	// Token The deletion verification token
+	Token *string `json:"token,omitempty"`
+}
+
+// GetDeleteUserCallbackParams defines parameters for GetDeleteUserCallback.
+type GetDeleteUserCallbackParams struct {
+	Token       *string `form:"token,omitempty" json:"token,omitempty"`
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty"`
+}
+
+// PostGetAccessTokenJSONBody defines parameters for PostGetAccessToken.
+type PostGetAccessTokenJSONBody struct {
+	// AccountId The account ID associated with the refresh token
+	AccountId *string `json:"accountId,omitempty"`
+
+	// ProviderId The provider ID for the OAuth provider
+	ProviderId string `json:"providerId"`
+
+	// UserId The user ID associated with the account
+	UserId *string `json:"userId,omitempty"`
+}
+
+// LinkSocialAccountJSONBody defines parameters for LinkSocialAccount.
+type LinkSocialAccountJSONBody struct {
+	AdditionalData *string `json:"additionalData,omitempty"`
+
+	// CallbackURL The URL to redirect to after the user has signed in
+	CallbackURL *string `json:"callbackURL,omitempty"`
+
+	// DisableRedirect Disable automatic redirection to the provider. Useful for handling the redirection yourself
+	DisableRedirect *bool `json:"disableRedirect,omitempty"`
+
+	// ErrorCallbackURL The URL to redirect to if there is an error during the link process
+	ErrorCallbackURL *string `json:"errorCallbackURL,omitempty"`
+	IdToken          *struct {
+		AccessToken  *string        `json:"accessToken,omitempty"`
+		Nonce        *string        `json:"nonce,omitempty"`
+		RefreshToken *string        `json:"refreshToken,omitempty"`
+		Scopes       *[]interface{} `json:"scopes,omitempty"`
+		Token        string         `json:"token"`
+	} `json:"idToken,omitempty"`
+	Provider      string `json:"provider"`
+	RequestSignUp *bool  `json:"requestSignUp,omitempty"`
+
+	// Scopes Additional scopes to request from the provider
+	Scopes *[]interface{} `json:"scopes,omitempty"`
+}
+
+// PostOrganizationAcceptInvitationJSONBody defines parameters for PostOrganizationAcceptInvitation.
+type PostOrganizationAcceptInvitationJSONBody struct {
+	// InvitationId The ID of the invitation to accept
+	InvitationId string `json:"invitationId"`
+}
+
+// PostOrganizationAddTeamMemberJSONBody defines parameters for PostOrganizationAddTeamMember.
+type PostOrganizationAddTeamMemberJSONBody struct {
+	// TeamId The team the user should be a member of.
+	TeamId string `json:"teamId"`
+
+	// UserId The user Id which represents the user to be added as a member.
+	UserId string `json:"userId"`
+}
+
+// PostOrganizationCancelInvitationJSONBody defines parameters for PostOrganizationCancelInvitation.
+type PostOrganizationCancelInvitationJSONBody struct {
+	// InvitationId The ID of the invitation to cancel
+	InvitationId string `json:"invitationId"`
+}
+
+// PostOrganizationCheckSlugJSONBody defines parameters for PostOrganizationCheckSlug.
+type PostOrganizationCheckSlugJSONBody struct {
+	// Slug The organization slug to check. Eg: "my-org"
+	Slug string `json:"slug"`
+}
+
+// PostOrganizationCreateJSONBody defines parameters for PostOrganizationCreate.
+type PostOrganizationCreateJSONBody struct {
+	// KeepCurrentActiveOrganization Whether to keep the current active organization active after creating a new one. Eg: true
+	KeepCurrentActiveOrganization *bool `json:"keepCurrentActiveOrganization,omitempty"`
+
+	// Logo The logo of the organization
+	Logo *string `json:"logo,omitempty"`
+
+	// Metadata The metadata of the organization
+	Metadata *string `json:"metadata,omitempty"`
+
+	// Name The name of the organization
+	Name string `json:"name"`
+
+	// Slug The slug of the organization
+	Slug string `json:"slug"`
+
+	// UserId The user id of the organization creator. If not provided, the current user will be used. Should only be used by admins or when called by the server. server-only. Eg: "user-id"
+	UserId *string `json:"userId,omitempty"`
+}
+
+// PostOrganizationCreateTeamJSONBody defines parameters for PostOrganizationCreateTeam.
+type PostOrganizationCreateTeamJSONBody struct {
+	// Name The name of the team. Eg: "my-team"
+	Name string `json:"name"`
+
+	// OrganizationId The organization ID which the team will be created in. Defaults to the active organization. Eg: "organization-id"
+	OrganizationId *string `json:"organizationId,omitempty"`
+}
+
+// PostOrganizationDeleteJSONBody defines parameters for PostOrganizationDelete.
+type PostOrganizationDeleteJSONBody struct {
+	// OrganizationId The organization id to delete
+	OrganizationId string `json:"organizationId"`
+}
+
+// GetOrganizationParams defines parameters for GetOrganization.
+type GetOrganizationParams struct {
+	OrganizationId   *string `form:"organizationId,omitempty" json:"organizationId,omitempty"`
+	OrganizationSlug *string `form:"organizationSlug,omitempty" json:"organizationSlug,omitempty"`
+}
+
+// GetOrganizationGetInvitationParams defines parameters for GetOrganizationGetInvitation.
+type GetOrganizationGetInvitationParams struct {
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+}
+
+// PostOrganizationHasPermissionJSONBody defines parameters for PostOrganizationHasPermission.
+type PostOrganizationHasPermissionJSONBody struct {
+	// Permission The permission to check
+	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
+	Permission *map[string]interface{} `json:"permission,omitempty"`
+
+	// Permissions The permission to check
+	Permissions map[string]interface{} `json:"permissions"`
+}
+
+// CreateOrganizationInvitationJSONBody defines parameters for CreateOrganizationInvitation.
+type CreateOrganizationInvitationJSONBody struct {
+	// Email The email address of the user to invite
+	Email string `json:"email"`
+
+	// OrganizationId The organization ID to invite the user to
+	OrganizationId *string `json:"organizationId,omitempty"`
+
+	// Resend Resend the invitation email, if the user is already invited. Eg: true
+	Resend *bool `json:"resend,omitempty"`
+
+	// Role The role(s) to assign to the user. It can be `admin`, `member`, owner. Eg: "member"
+	Role string `json:"role"`
+
+	// TeamId The optional team ID to add the user to once the invitation is accepted
+	TeamId *string `json:"teamId,omitempty"`
+}
+
+// PostOrganizationLeaveJSONBody defines parameters for PostOrganizationLeave.
+type PostOrganizationLeaveJSONBody struct {
+	// OrganizationId The organization Id for the member to leave. Eg: "organization-id"
+	OrganizationId string `json:"organizationId"`
+}
+
+// GetOrganizationListInvitationsParams defines parameters for GetOrganizationListInvitations.
+type GetOrganizationListInvitationsParams struct {
+	OrganizationId *string `form:"organizationId,omitempty" json:"organizationId,omitempty"`
+}
+
+// GetOrganizationListTeamMembersParams defines parameters for GetOrganizationListTeamMembers.
+type GetOrganizationListTeamMembersParams struct {
+	TeamId *string `form:"teamId,omitempty" json:"teamId,omitempty"`
+}
+
+// GetOrganizationListTeamsParams defines parameters for GetOrganizationListTeams.
+type GetOrganizationListTeamsParams struct {
+	OrganizationId *string `form:"organizationId,omitempty" json:"organizationId,omitempty"`
+}
+
+// PostOrganizationRejectInvitationJSONBody defines parameters for PostOrganizationRejectInvitation.
+type PostOrganizationRejectInvitationJSONBody struct {
+	// InvitationId The ID of the invitation to reject
+	InvitationId string `json:"invitationId"`
+}
+
+// PostOrganizationRemoveMemberJSONBody defines parameters for PostOrganizationRemoveMember.
+type PostOrganizationRemoveMemberJSONBody struct {
+	// MemberIdOrEmail The ID or email of the member to remove
+	MemberIdOrEmail string `json:"memberIdOrEmail"`
+
+	// OrganizationId The ID of the organization to remove the member from. If not provided, the active organization will be used. Eg: "org-id"
+	OrganizationId *string `json:"organizationId,omitempty"`
+}
+
+// PostOrganizationRemoveTeamJSONBody defines parameters for PostOrganizationRemoveTeam.
+type PostOrganizationRemoveTeamJSONBody struct {
+	// OrganizationId The organization ID which the team falls under. If not provided, it will default to the user's active organization. Eg: "organization-id"
+	OrganizationId *string `json:"organizationId,omitempty"`
+
+	// TeamId The team ID of the team to remove. Eg: "team-id"
+	TeamId string `json:"teamId"`
+}
+
+// PostOrganizationRemoveTeamMemberJSONBody defines parameters for PostOrganizationRemoveTeamMember.
+type PostOrganizationRemoveTeamMemberJSONBody struct {
+	// TeamId The team the user should be removed from.
+	TeamId string `json:"teamId"`
+
+	// UserId The user which should be removed from the team.
+	UserId string `json:"userId"`
+}
+
+// SetActiveOrganizationJSONBody defines parameters for SetActiveOrganization.
+type SetActiveOrganizationJSONBody struct {
+	OrganizationId *string `json:"organizationId,omitempty"`
+
+	// OrganizationSlug The organization slug to set as active. It can be null to unset the active organization if organizationId is not provided. Eg: "org-slug"
+	OrganizationSlug *string `json:"organizationSlug,omitempty"`
+}
+
+// PostOrganizationSetActiveTeamJSONBody defines parameters for PostOrganizationSetActiveTeam.
+type PostOrganizationSetActiveTeamJSONBody struct {
+	TeamId *string `json:"teamId,omitempty"`
+}
+
+// PostOrganizationUpdateJSONBody defines parameters for PostOrganizationUpdate.
+type PostOrganizationUpdateJSONBody struct {
+	Data struct {
+		// Logo The logo of the organization
+		Logo *string `json:"logo,omitempty"`
+
+		// Metadata The metadata of the organization
+		Metadata *string `json:"metadata,omitempty"`
+
+		// Name The name of the organization
+		Name *string `json:"name,omitempty"`
+
+		// Slug The slug of the organization
+		Slug *string `json:"slug,omitempty"`
+	} `json:"data"`
+
+	// OrganizationId The organization ID. Eg: "org-id"
+	OrganizationId *string `json:"organizationId,omitempty"`
+}
+
+// UpdateOrganizationMemberRoleJSONBody defines parameters for UpdateOrganizationMemberRole.
+type UpdateOrganizationMemberRoleJSONBody struct {
+	// MemberId The member id to apply the role update to. Eg: "member-id"
+	MemberId string `json:"memberId"`
+
+	// OrganizationId An optional organization ID which the member is a part of to apply the role update. If not provided, you must provide session headers to get the active organization. Eg: "organization-id"
+	OrganizationId *string `json:"organizationId,omitempty"`
+
+	// Role The new role to be applied. This can be a string or array of strings representing the roles. Eg: ["admin", "sale"]
+	Role string `json:"role"`
+}
+
+// PostOrganizationUpdateTeamJSONBody defines parameters for PostOrganizationUpdateTeam.
+type PostOrganizationUpdateTeamJSONBody struct {
+	Data struct {
+		CreatedAt      *string `json:"createdAt,omitempty"`
+		Id             *string `json:"id,omitempty"`
+		Name           *string `json:"name,omitempty"`
+		OrganizationId *string `json:"organizationId,omitempty"`
+		UpdatedAt      *string `json:"updatedAt,omitempty"`
+	} `json:"data"`
+
+	// TeamId The ID of the team to be updated. Eg: "team-id"
+	TeamId string `json:"teamId"`
+}
+
+// PostRefreshTokenJSONBody defines parameters for PostRefreshToken.
+type PostRefreshTokenJSONBody struct {
+	// AccountId The account ID associated with the refresh token
+	AccountId *string `json:"accountId,omitempty"`
+
+	// ProviderId The provider ID for the OAuth provider
+	ProviderId string `json:"providerId"`
+
+	// UserId The user ID associated with the account
+	UserId *string `json:"userId,omitempty"`
+}
+
+// RequestPasswordResetJSONBody defines parameters for RequestPasswordReset.
+type RequestPasswordResetJSONBody struct {
+	// Email The email address of the user to send a password reset email to
+	Email string `json:"email"`
+
+	// RedirectTo The URL to redirect the user to reset their password. If the token isn't valid or expired, it'll be redirected with a query parameter `?error=INVALID_TOKEN`. If the token is valid, it'll be redirected with a query parameter `?token=VALID_TOKEN
+	RedirectTo *string `json:"redirectTo,omitempty"`
+}
+
+// ResetPasswordJSONBody defines parameters for ResetPassword.
+type ResetPasswordJSONBody struct {
+	// NewPassword The new password to set
+	NewPassword string `json:"newPassword"`
+
+	// Token The token to reset the password
+	Token *string `json:"token,omitempty"`
+}
+
+// ResetPasswordCallbackParams defines parameters for ResetPasswordCallback.
+type ResetPasswordCallbackParams struct {
+	// CallbackURL The URL to redirect the user to reset their password
+	CallbackURL string `form:"callbackURL" json:"callbackURL"`
+}
+
+// PostRevokeOtherSessionsJSONBody defines parameters for PostRevokeOtherSessions.
+type PostRevokeOtherSessionsJSONBody = map[string]interface{}
+
+// PostRevokePersonalAccessTokenJSONBody defines parameters for PostRevokePersonalAccessToken.
+type PostRevokePersonalAccessTokenJSONBody struct {
+	// Id The ID of the personal access token to revoke
+	Id string `json:"id"`
+}
+
+// PostRevokeSessionJSONBody defines parameters for PostRevokeSession.
+type PostRevokeSessionJSONBody struct {
+	// Id The ID of the session to revoke
+	Id string `json:"id"`
+}
+
+// PostRevokeSessionsJSONBody defines parameters for PostRevokeSessions.
+type PostRevokeSessionsJSONBody = map[string]interface{}
+
+// PostRevokeTrustedDeviceJSONBody defines parameters for PostRevokeTrustedDevice.
+type PostRevokeTrustedDeviceJSONBody struct {
+	// Id The ID of the trusted device to revoke
+	Id string `json:"id"`
+}
+
+// PostRevokeTrustedDevicesJSONBody defines parameters for PostRevokeTrustedDevices.
+type PostRevokeTrustedDevicesJSONBody = map[string]interface{}
+
+// SendVerificationEmailJSONBody defines parameters for SendVerificationEmail.
+type SendVerificationEmailJSONBody struct {
+	// CallbackURL The URL to use for email verification callback
+	CallbackURL *string `json:"callbackURL"`
+
+	// Email The email to send the verification email to
+	Email string `json:"email"`
+}
+
+// SignInEmailJSONBody defines parameters for SignInEmail.
+type SignInEmailJSONBody struct {
+	// CallbackURL Callback URL to use as a redirect for email verification
+	CallbackURL *string `json:"callbackURL,omitempty"`
+
+	// Email Email of the user
+	Email string `json:"email"`
+
+	// Password Password of the user
+	Password   string  `json:"password"`
+	RememberMe *string `json:"rememberMe,omitempty"`
+}
+
+// SocialSignInJSONBody defines parameters for SocialSignIn.
+type SocialSignInJSONBody struct {
+	AdditionalData *string `json:"additionalData,omitempty"`
+
+	// CallbackURL Callback URL to redirect to after the user has signed in
+	CallbackURL *string `json:"callbackURL,omitempty"`
+
+	// DisableRedirect Disable automatic redirection to the provider. Useful for handling the redirection yourself
+	DisableRedirect *bool `json:"disableRedirect,omitempty"`
+
+	// ErrorCallbackURL Callback URL to redirect to if an error happens
+	ErrorCallbackURL *string `json:"errorCallbackURL,omitempty"`
+	IdToken          *struct {
+		// AccessToken Access token from the provider
+		AccessToken *string `json:"accessToken,omitempty"`
+
+		// ExpiresAt Expiry date of the token in seconds since the epoch
+		ExpiresAt *float64 `json:"expiresAt,omitempty"`
+
+		// Nonce Nonce used to generate the token, which has been issued by /sign-in/social/nonce
+		Nonce *string `json:"nonce,omitempty"`
+
+		// RefreshToken Refresh token from the provider
+		RefreshToken *string `json:"refreshToken,omitempty"`
+
+		// Token ID token from the provider
+		Token string `json:"token"`
+	} `json:"idToken,omitempty"`
+
+	// LoginHint The login hint to use for the authorization code request
+	LoginHint          *string `json:"loginHint,omitempty"`
+	NewUserCallbackURL *string `json:"newUserCallbackURL,omitempty"`
+	Provider           string  `json:"provider"`
+
+	// RequestSignUp Explicitly request sign-up. Useful when disableImplicitSignUp is true for this provider
+	RequestSignUp *bool `json:"requestSignUp,omitempty"`
+
+	// Scopes Array of scopes to request from the provider. This will override the default scopes passed.
+	Scopes *[]interface{} `json:"scopes,omitempty"`
+}
+
+// CreateSocialSignInNonceJSONBody defines parameters for CreateSocialSignInNonce.
+type CreateSocialSignInNonceJSONBody struct {
+	Provider string `json:"provider"`
+}
+
+// SignOutJSONBody defines parameters for SignOut.
+type SignOutJSONBody = map[string]interface{}
+
+// SignUpWithEmailAndPasswordJSONBody defines parameters for SignUpWithEmailAndPassword.
+type SignUpWithEmailAndPasswordJSONBody struct {
+	// CallbackURL The URL to use for email verification callback
+	CallbackURL *string `json:"callbackURL,omitempty"`
+
+	// Email The email of the user
+	Email string `json:"email"`
+
+	// Image The profile image URL of the user
+	Image *string `json:"image,omitempty"`
+
+	// Name The name of the user
+	Name string `json:"name"`
+
+	// Password The password of the user
+	Password string `json:"password"`
+
+	// RememberMe If this is false, the session will not be remembered. Default is `true`.
+	RememberMe *bool `json:"rememberMe,omitempty"`
+}
+
+// PostUnlinkAccountJSONBody defines parameters for PostUnlinkAccount.
+type PostUnlinkAccountJSONBody struct {
+	AccountId  *string `json:"accountId,omitempty"`
+	ProviderId string  `json:"providerId"`
+}
+
+// UpdateUserJSONBody defines parameters for UpdateUser.
+type UpdateUserJSONBody struct {
+	// Image The image of the user
+	Image *string `json:"image"`
+
+	// Name The name of the user
+	Name *string `json:"name,omitempty"`
+}
+
+// GetVerifyEmailParams defines parameters for GetVerifyEmail.
+type GetVerifyEmailParams struct {
+	// Token The token to verify the email
+	Token string `form:"token" json:"token"`
+
+	// CallbackURL The URL to redirect to after email verification
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty"`
+}
+
+// ChangeEmailJSONRequestBody defines body for ChangeEmail for application/json ContentType.
+type ChangeEmailJSONRequestBody ChangeEmailJSONBody
+
+// ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
+type ChangePasswordJSONRequestBody ChangePasswordJSONBody
+
+// CreatePersonalAccessTokenJSONRequestBody defines body for CreatePersonalAccessToken for application/json ContentType.
+type CreatePersonalAccessTokenJSONRequestBody CreatePersonalAccessTokenJSONBody
+
+// DeleteUserJSONRequestBody defines body for DeleteUser for application/json ContentType.
+type DeleteUserJSONRequestBody DeleteUserJSONBody
+
+// PostGetAccessTokenJSONRequestBody defines body for PostGetAccessToken for application/json ContentType.
+type PostGetAccessTokenJSONRequestBody PostGetAccessTokenJSONBody
+
+// LinkSocialAccountJSONRequestBody defines body for LinkSocialAccount for application/json ContentType.
+type LinkSocialAccountJSONRequestBody LinkSocialAccountJSONBody
+
+// PostOrganizationAcceptInvitationJSONRequestBody defines body for PostOrganizationAcceptInvitation for application/json ContentType.
+type PostOrganizationAcceptInvitationJSONRequestBody PostOrganizationAcceptInvitationJSONBody
+
+// PostOrganizationAddTeamMemberJSONRequestBody defines body for PostOrganizationAddTeamMember for application/json ContentType.
+type PostOrganizationAddTeamMemberJSONRequestBody PostOrganizationAddTeamMemberJSONBody
+
+// PostOrganizationCancelInvitationJSONRequestBody defines body for PostOrganizationCancelInvitation for application/json ContentType.
+type PostOrganizationCancelInvitationJSONRequestBody PostOrganizationCancelInvitationJSONBody
+
+// PostOrganizationCheckSlugJSONRequestBody defines body for PostOrganizationCheckSlug for application/json ContentType.
+type PostOrganizationCheckSlugJSONRequestBody PostOrganizationCheckSlugJSONBody
+
+// PostOrganizationCreateJSONRequestBody defines body for PostOrganizationCreate for application/json ContentType.
+type PostOrganizationCreateJSONRequestBody PostOrganizationCreateJSONBody
+
+// PostOrganizationCreateTeamJSONRequestBody defines body for PostOrganizationCreateTeam for application/json ContentType.
+type PostOrganizationCreateTeamJSONRequestBody PostOrganizationCreateTeamJSONBody
+
+// PostOrganizationDeleteJSONRequestBody defines body for PostOrganizationDelete for application/json ContentType.
+type PostOrganizationDeleteJSONRequestBody PostOrganizationDeleteJSONBody
+
+// PostOrganizationHasPermissionJSONRequestBody defines body for PostOrganizationHasPermission for application/json ContentType.
+type PostOrganizationHasPermissionJSONRequestBody PostOrganizationHasPermissionJSONBody
+
+// CreateOrganizationInvitationJSONRequestBody defines body for CreateOrganizationInvitation for application/json ContentType.
+type CreateOrganizationInvitationJSONRequestBody CreateOrganizationInvitationJSONBody
+
+// PostOrganizationLeaveJSONRequestBody defines body for PostOrganizationLeave for application/json ContentType.
+type PostOrganizationLeaveJSONRequestBody PostOrganizationLeaveJSONBody
+
+// PostOrganizationRejectInvitationJSONRequestBody defines body for PostOrganizationRejectInvitation for application/json ContentType.
+type PostOrganizationRejectInvitationJSONRequestBody PostOrganizationRejectInvitationJSONBody
+
+// PostOrganizationRemoveMemberJSONRequestBody defines body for PostOrganizationRemoveMember for application/json ContentType.
+type PostOrganizationRemoveMemberJSONRequestBody PostOrganizationRemoveMemberJSONBody
+
+// PostOrganizationRemoveTeamJSONRequestBody defines body for PostOrganizationRemoveTeam for application/json ContentType.
+type PostOrganizationRemoveTeamJSONRequestBody PostOrganizationRemoveTeamJSONBody
+
+// PostOrganizationRemoveTeamMemberJSONRequestBody defines body for PostOrganizationRemoveTeamMember for application/json ContentType.
+type PostOrganizationRemoveTeamMemberJSONRequestBody PostOrganizationRemoveTeamMemberJSONBody
+
+// SetActiveOrganizationJSONRequestBody defines body for SetActiveOrganization for application/json ContentType.
+type SetActiveOrganizationJSONRequestBody SetActiveOrganizationJSONBody
+
+// PostOrganizationSetActiveTeamJSONRequestBody defines body for PostOrganizationSetActiveTeam for application/json ContentType.
+type PostOrganizationSetActiveTeamJSONRequestBody PostOrganizationSetActiveTeamJSONBody
+
+// PostOrganizationUpdateJSONRequestBody defines body for PostOrganizationUpdate for application/json ContentType.
+type PostOrganizationUpdateJSONRequestBody PostOrganizationUpdateJSONBody
+
+// UpdateOrganizationMemberRoleJSONRequestBody defines body for UpdateOrganizationMemberRole for application/json ContentType.
+type UpdateOrganizationMemberRoleJSONRequestBody UpdateOrganizationMemberRoleJSONBody
+
+// PostOrganizationUpdateTeamJSONRequestBody defines body for PostOrganizationUpdateTeam for application/json ContentType.
+type PostOrganizationUpdateTeamJSONRequestBody PostOrganizationUpdateTeamJSONBody
+
+// PostRefreshTokenJSONRequestBody defines body for PostRefreshToken for application/json ContentType.
+type PostRefreshTokenJSONRequestBody PostRefreshTokenJSONBody
+
+// RequestPasswordResetJSONRequestBody defines body for RequestPasswordReset for application/json ContentType.
+type RequestPasswordResetJSONRequestBody RequestPasswordResetJSONBody
+
+// ResetPasswordJSONRequestBody defines body for ResetPassword for application/json ContentType.
+type ResetPasswordJSONRequestBody ResetPasswordJSONBody
+
+// PostRevokeOtherSessionsJSONRequestBody defines body for PostRevokeOtherSessions for application/json ContentType.
+type PostRevokeOtherSessionsJSONRequestBody = PostRevokeOtherSessionsJSONBody
+
+// PostRevokePersonalAccessTokenJSONRequestBody defines body for PostRevokePersonalAccessToken for application/json ContentType.
+type PostRevokePersonalAccessTokenJSONRequestBody PostRevokePersonalAccessTokenJSONBody
+
+// PostRevokeSessionJSONRequestBody defines body for PostRevokeSession for application/json ContentType.
+type PostRevokeSessionJSONRequestBody PostRevokeSessionJSONBody
+
+// PostRevokeSessionsJSONRequestBody defines body for PostRevokeSessions for application/json ContentType.
+type PostRevokeSessionsJSONRequestBody = PostRevokeSessionsJSONBody
+
+// PostRevokeTrustedDeviceJSONRequestBody defines body for PostRevokeTrustedDevice for application/json ContentType.
+type PostRevokeTrustedDeviceJSONRequestBody PostRevokeTrustedDeviceJSONBody
+
+// PostRevokeTrustedDevicesJSONRequestBody defines body for PostRevokeTrustedDevices for application/json ContentType.
+type PostRevokeTrustedDevicesJSONRequestBody = PostRevokeTrustedDevicesJSONBody
+
+// SendVerificationEmailJSONRequestBody defines body for SendVerificationEmail for application/json ContentType.
+type SendVerificationEmailJSONRequestBody SendVerificationEmailJSONBody
+
+// SignInEmailJSONRequestBody defines body for SignInEmail for application/json ContentType.
+type SignInEmailJSONRequestBody SignInEmailJSONBody
+
+// SocialSignInJSONRequestBody defines body for SocialSignIn for application/json ContentType.
+type SocialSignInJSONRequestBody SocialSignInJSONBody
+
+// CreateSocialSignInNonceJSONRequestBody defines body for CreateSocialSignInNonce for application/json ContentType.
+type CreateSocialSignInNonceJSONRequestBody CreateSocialSignInNonceJSONBody
+
+// SignOutJSONRequestBody defines body for SignOut for application/json ContentType.
+type SignOutJSONRequestBody = SignOutJSONBody
+
+// SignUpWithEmailAndPasswordJSONRequestBody defines body for SignUpWithEmailAndPassword for application/json ContentType.
+type SignUpWithEmailAndPasswordJSONRequestBody SignUpWithEmailAndPasswordJSONBody
+
+// PostUnlinkAccountJSONRequestBody defines body for PostUnlinkAccount for application/json ContentType.
+type PostUnlinkAccountJSONRequestBody PostUnlinkAccountJSONBody
+
+// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
+type UpdateUserJSONRequestBody UpdateUserJSONBody
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+x9a28judXmXyG0C2QXkOx+k+yHbeDFrtPuJEZ6phu+TLDINHZoFSUxLpE1JMtuJej/",
+	"/uLwUsWqYl0ky3LPzPnUbRUvh9fn4eHhOf+eLeW2kIIJo2dv/z3Tyw3bUvvfi+VSlsLAfwslC6YMZ/YD",
+	"XS6Z1rfygQn40+wKNns700ZxsZ59ncff338puGL6wpaykmpLzeztLKOGLQzfstk8mRuqvcqSZS8Vo4Zl",
+	"rsCMrWiZQ4l/YYIp+ECoIaoUvvBpNfJ0VTzrb2JBtX6SKp2xUPKRZ0z1NEGxlWJ60194nOCADtRLWbBk",
+	"wWWR1Z03rbBS97TDyvlzyRXLZm//EY1ao/1VAfHIxYJ8ruqU9/9kSwN1XolHbqjhUnTn3kuMP9tSnic7",
+	"jO3f+32TCdrUOyWkWlPB/2Xb3JNEyTw9qNpQU+pmdxRMZPA5IZ9hdDtlQFsihV6q6os7pzm4dVNTg/sd",
+	"294zNTKwz+rqPTqz7rGtE+sZK6DTYdXMt7XFfZTql49R9pfsnVyuZfLDlhmaUUOTHwXd9sy+vFyP943N",
+	"7hOPdcQnprQUNL9ooswJtoGjLfacanOn9xuu3h7uTueM6aXihZsps9sNI3EaYjaMGOg2wjXJ+ZZDfxg5",
+	"J9zAL4o9ygeWkacNc2lhnpKc0UemiWkVlpK0UGzFv6QFeeSa3+eMuDREriJpjCSKLeVa8H8xwk0vdNkB",
+	"5oZtdbI//A9UKbrbZ33ybOZ7uWpCtEZ9zWOT84ZpnVygdGn4I/s4vvW4hLd9u/D8G5/avLjIMsW0PibB",
+	"uFgzxzMP3nzt4PYBUi1VVWBqbGFMXnLn3WOFP79j0xtwB6OGZzv0yMvjtelfC1OH3xcxPLyq1IZll+yR",
+	"L9kvDVGGl90heHPEdVdtoukFmBqMO83Uicagn9zDlx+Y4ivOskaNK5prBg2l2UeR72ZvjSpZVfa9lDmj",
+	"Ymi0tnTN9tsDGgv8KE1P7wCBxU8/jbkeWp7yPHa8ZZMxYWB81Yk6fT57pHnJpiycSrKQZwqAdQfo63ym",
+	"2bJU3OxuQHfj6UjB/8Z276R84KzL1C4+XZG/sR2hpdmAGG5wySOnZOmyzGccElZ/uXnbLLamYvZXaPw9",
+	"o4qpi9JsupX+yX7zXLBZs+VfIDusLpusLnxjTDH7+tUeoVcy3ZZrtmKKiSUjK6nITpaK/JnfM0X+Is2G",
+	"XAltqFhagbnJodDo68WnKxgBphyvm/3H2ZuzNxaVCyZowWdvZ384e3P2x9l8VlCzsb177rUdiyDSmpmu",
+	"ZH9hxrJfn5hAYuJ1Ixm539mP/m9oMCyrigNAbq+Au4JKYPboQgrtxvf3b97AP0spjN/CaVHkvjvP/6nd",
+	"UnXKPPs1yzh8ovmnaPn6fa65osMZMJ2lsRPWm0SZ3M/32Htfbm9NMsVG9clFFeexrZu7nkkk/jpvjfxN",
+	"ac+uINQf9xyoZgdumdbp5rYkDAmnSPcnmpFr9nPJtDkjd7qkeb4jWcnggLblWnOxJgVVdMsMU3pOpCJc",
+	"PNKcZ9HPZ65x//GtNe5OwNYiFf8Xy87IZbNVUUuaG5BvzR9eqjWjYv9ZqnueZUyckf8nS5JJIqQhG/rI",
+	"SMGUlV/aE7TTrROzsSd5LUu1ZNAuIyEhQJT7RpdRu/74au36XhryZ1mK7IyAekC5aceyWvYnqm1bVzaV",
+	"lff3//vV5L2VknxHxS4sEO3Gww4E+7JkDLZu2LcVNcwpV87IrdoRuqZckJwapmwj/tebN6/WiCthmBI0",
+	"JzdMPTJF3islFQwA1wSmBoDOfc625ImbjW2NdgnNhhqAT7Kkwo4J/3LWYBizt//4dwPk//H5K6xKutaw",
+	"UC89hfoMec6XGyrWbFGBQCG17Ygmzr2zqd57WuonyJ9ktntG9y1pnt/T5cPd9Ye0muru+oNTR2VcsaWB",
+	"/9OVYYpYYcljTHlTujr29D60qlu4YE++HOrObFC8ZoZsS23IPSOUuD2okWicwIdK09tgnRQg+usz+ULv",
+	"dGwBnb0LIP57UPe5drnhh7m2dM0TZZ7T+5y1SET6JqM5nzOQlGnCXQV+lti9QzusXZX5LHVCC8Tkvyu2",
+	"mr2d/bfz+rrz3DVXn9vDaLu7vTBTMOd93N4gm283yyIJ8x1SAqQESAkOoAS/fzV574RfyrB3kffCcLM7",
+	"I27N0xx0RDvCvnBtNJKXXx15ie08An9pyuv4izvL+8QBB/2xMcV3PoVyj0Z5SqWYMJ8iebvMxCeqBbU7",
+	"ht9V0zxnuECgOlVhjuWkynFXjh/Nhil/gZZA+e8qduThmwS1WBvVu8Qo6s52R5yeLZlwZd3a99gT0Szs",
+	"2vZ6dkUk9En4WZMnpli4oJ3CmMopavRYiL83Ln1ho/WJ99end2dDk/Q2F0FVeFBAT1HHtyS3fVUTzA3V",
+	"5J4x4ek6yxJTJaiRusKWgv9cMlLrYVsC9yueumUVSq54zohNYg8XPY0vFZ8yrEGTlVhwdMvGBG1ptYeH",
+	"H66OiM9x2MUCj42D4luGH+ph2UOZ3dG7TaF4YbU3uLZn5BlybuTcyLlRDfdbZbJ2710U3qxu4abuomIp",
+	"PbTW5gIhfb5qykO+GIJq8df8kQlSWVK1eK8tMGXcdywK7G9Pr0QaOnO+YgBoTaM0LohmSykyPXe/aNse",
+	"WRrCTdgAXMk1EnJh2Jqp6VBtfEOPYNwn3XRv2NXN68EAUmSk49H9Nq21nV23OvetIfkZeb9+S36cKVbI",
+	"t3Di/XE2m0820euxBXUipMDkuHS8SNuTDikEU7MUmpUm9rehm+bkacOXG1jcUuQ7opgplWAZkf72ediG",
+	"yc+RlLx46YhsB9kOsh1kO2NsJ2M5M2xRKUaS5ObSJurX0bnvd+7Tia4kQ4L+u8kK47kmrplJpV0xqLGD",
+	"An6nK6XdGbn2ex5oo4J6ivvVoZjepKoYAEIrmDUmi+5Q+/jP1xcH/z1vLivp61vLTuO9imHCNWU1qcYv",
+	"KtuXj76O+X4wBJOj3QS8gER6gPQA6QHSgxY9OA+I22vA+84ngIkMp0TI6hC42mSttEmo61jz1qQilGtN",
+	"isPmZZvBodqfS6Z2tc11KLAel57jJ4hpRdk1scxP8BT8pmuMucpwvb1cJVSeqPTzaQD+nRQrDpcZ0AUh",
+	"1bPgvB7006F54z4jkD6EcIRwhHCE8N8mhDOothezL7kucrrThApiU5LCbfwdPLbij7+rMeyLOd+Ybd7s",
+	"2C4W/vX2uw/EZ6wsYWMJ2l2OGlzc33F/x/0d9/fG/r5mZuIdNTywDG8p4tvpOckkrFtKvCctYPDCDkIH",
+	"CD5JbdxTy6PfRzccmXXxwn8mV5eEai2X3L44rvo2iN57ddz0MpY0SrPfoQJ4FguFfoTuj9+cDjz8T+uO",
+	"+8T1rRm95YykPr1l5ss6rhvyF/fSPt/sLLndFYeu/QvyQ2cZRTykvVG4NdeYooAm1ZRbwuF7Xbp1hpCP",
+	"kI+Qj5A/DPm69qw16E0hvGEI6RMnu5vq07Owo22m3ez4SN4hU6Igy7OeIlbtmWwQjUdJxBXEFcSV3zau",
+	"5Fw8LOxZJe8/RX7g4oFQ4pJVpzIj+82DIMeNTX5RnXqOdGSsfO5c9nliPdy3QcNGWPM1WKby5NEy4/ax",
+	"67UvIqllhQSwh0m4YltW1flNInZtBFs8W5W5PYVuqMhy2AndGbfOtJOl0ixfJR9xWT3quwNa7q7uFCM8",
+	"0ghnpQoSwAwZMvaJznT7HSWFtfk96ChYG2e3XZ2anjxJS+KUL7cwJD2C2Sl8w9firkj7ZOqzG7+o5m0w",
+	"H7fjYMsjKyW3bV9XgxbiVbrTawhU75TvXAfbpaQ3sswzMLcPOVkW5n+gAbZm4i7TE11aOb3ofitVz0vL",
+	"TtHNeR+kM3JUH1M1GFklskpklcgqkVWOs0ptbyiA+elefcUHrg2hecUoteUaNTr0MEvryFddhNKfCW7V",
+	"E62Bm4lhB+TPcr86EgjlIG/vLxfChHdil/Q5EY+DnbQ9yKdoV4vtIK4iriKuIq4irnZxNflMfQLKJp+p",
+	"60FPTJA38dL3eKB7yBNjRApECkQKRApEijGk0JEnubETmOGPrPayFqyhBo9glaO6U6BBdEuMCIAIgAiA",
+	"CIAIMIoAxkX0WmQ2pNcEIPAZiM/Q8GJl5dMPvPBCL6XIyIoujUxjRCOc2GlQolElYgViBWIFYgVixRhW",
+	"yIEX/hu2fAgX+hBJjGvyJNWDi53dMSz9+DA7qrWBfOiK1LEz6Ig18tpcPkzaoFrFIkQgRCBEIET8RiEi",
+	"crEKwR1ZAbEdH7mpo54mbUcvbFKwLKxT26kv2lHUu68O41jhrpyrusZjGZTWYvU96ru6DMegVhOsSOPe",
+	"3+MKTm+v1xykocNT1Llf57NtFdV6KI+PfT1pJuNZC4EUgRSB9LcGpDGMJdE0yxaG0e2i3nLTWOrjCUHc",
+	"Dmd2VfsvHwHPLLtldPtdSH0c5ASZ+zATvqVswIPLdSJXZ4c9rs+8C3HFCsU0gFFdj3frnsF0pLqq7GwU",
+	"o31TqvpPAdM9nVYN6eSIRbd8y7Sh24I8heA1UUkHhTBKhQS66wsH1BS7U9boNKn5VTVrYvlheYpDHTFM",
+	"ETFl5FjZLFYzox6AKYB7GzUgLFZ0MoskCEkQkiAkQQkStKRiyfIelcIwu3lns35TqgHXmm9PNTD1+I+H",
+	"d8QtxC3ELcStUdyC+9GFzsv1HoAFeW4gy7GQKtQ/EhoNkll4AgFCyLLtbiHV2sYrG96zbCWnV2D3P//e",
+	"Q+ns1qptP0yXR8qt46IzxDPEM8QzxDPEs4BnVlk1HvV0z+tbl+1oePfAWPHOubm7sE8XGs3qj4wuCeRs",
+	"eMnzTx8aOOl/c06BbI84Z7kQzF8K5pATkC7prySXa9kTYFWuZTgwtrqvo93cMkMz7+KoW1L4OrW0aUFY",
+	"x0rppxnwZWopo3pbnqWKcgMBq+RqZbcj/xA9mzfG0wfczXO4Cig1QMyNu36wEUf9j+R+R2i25ULDzmz1",
+	"5uA2yn2ol9uZ/3cBeQNhghoWPJvAmEIg11MRp6HjfWOF4AEfCRESIiRESIgmEiJ7QT8hFjwwBEhqxeTi",
+	"QJ4Et4dH40rToB+EjjQC8OePs6PEY7+69Df2oZoKncPFKBdnxFsb6so1XJeWBfHi3/bA4dNrLg64s3/x",
+	"y/rQ58ZNsYlE8fvWTDlkYtR3JY3pUTX9nuVSrHXSJV/Lq9H0vsypNsTnndihKXMAT+RaTexzfzTZQAAt",
+	"A5CAIQFDAoYEbICAuZieo6Hq92VaLtvRWNbetIhbP4dZkGJ4M2uV/jrmiR3xYeBhJfaG2kctA4IcghyC",
+	"HILcGMi5kH5w5I1eAQxG+XHJSMYM5XnlqCNxbE4+046+29h+kCt6HnC812dpv7ZdtOwkUTJPRwfYy1mt",
+	"T5w4utny0aU7QhZCFkIWQtYRIGsRtuxB3IJEAa1ciJ0FF/7eVxwJw65BkKPiWA8atfY2xBTEFMQUxBTE",
+	"lGdgCtyCLGTLnmsQUiDHXnAxm8/qjcqKzKG8n0umduG25233yDCkHOu73ZJkzcyk682UEnBcMG9GPiRa",
+	"n22WFy5R72e0Q0JoRGhEaERo/Kagsfk6thcTm8627nfk6nLCAarxgHYCPPLpkNh8HPsymNOcBGxLeZ5U",
+	"H7IvBVdMX5jk1x59pW0AU+97S/UJrg5Wd8ZJvvc2OIOJbrwt9nTFaech1ZDi1HWgLy2hPq0bXBUc922i",
+	"QfMUb2l0LJ6ckR4gPUB6gPRgf3qwoXpRT+cBS+XYn3MV6zzKOWY381eqP8Wpj2M+0xQ9Y4ViS2qCLcs8",
+	"wS6aa9e+aJ6l4nlXyXSapUwqpx1+Oyo0tXMe14yYwRxLI7oHw/Tb6FjikBIhFiEWIRYhFiF2f4h1Z5VR",
+	"L531C+n9PF67fLEQL+DSqjoXd4HQfgLnmQqWaCPwj3RNYcd6D1QVGNeRKtx6+EwUem1/b+sWbAvmDXID",
+	"EyZXjGY7X2M28ng7nJ67jYAv/0P/TzuUGm6u4zjlZ+TKwLyDZ00/2YfFP83JT26q/DQn8kkw5Wr+0XvW",
+	"Tr+uGnKWKe0fNK+8ZoIkWdYYJymWrN0tXHtX5UkT3SZYNY79r/xiqtM5L6PUuTq+idppNS37eSZFdofs",
+	"Dtkdsjtkd012lzP6OPDK6AN83vuRkc31em+MrrIqkq83FzeS2IYe/pb6FZ4itedfxb9/4abmbSSx7UIM",
+	"RwxHDEcMRww/AMO5g+7hGMdxFj1mGAG5ThK6uNE2jFyMGIAYgBiAGHAQBkSGcnHM+9GN/irKdkoTcZC5",
+	"pbUFDfyhZuOfT4FXzWiRiFaIVohWiFaIVgegldP87IVU3/ksrZ0e91bcW3Fvxb0V99awt0aBdfWwaqi+",
+	"JamsX9b8kQnnGneKnqiOsTvx+FAFF512bDA2PGp0XKjF7T0qpL21PvuI0AryD7TfShmFPXUzRgdPyJHr",
+	"2Op8gSFwfzUhcMePgLA6AEWM4uwRnd4id0HugtwFucsId9Hj91k2GZkQbKCHsrySrtOJ/XpaziEKk+Au",
+	"LWkqDvPtu/xHV/+ndPWPRAiJEBIhJEJIhI5HhFzEveSdbg8nihITWr9zVmzJ+KPFh1FqdAfn38aF8HEu",
+	"Vb+VFy9DpM0FUdzIOlxQ43J6Gic4zMfJ/o9qhvUrLQ0I1VouuW1TNZUbDRNlbkNDh5fnR3jBk3CF8qxH",
+	"PXjdjmwC2QSyCWQTh7OJvXQrVqxOkGHXEqoMKDGmMoqgcPkWVBdD5l0gJx5mEX4QfhB+EH6OBD+Kgcgt",
+	"N57p96bXNun+XkTaL1BdOS/gSaQWa/zk1WyC64VxbWxcwemfmTYHaboldP08teckGT3/xIeeCKQIpAik",
+	"CKR7A+lWPo674rq2yQgNdlQrJbcHICgUEgXHOwZ6OoGuso+1T+k0gCrvl8sjae3GwXXBoR65+u/nXblx",
+	"ZdBrZ+RqZVdXoeQjz1g277upr6L7l5plkZuJad4l2v2C7iXQvQSyDmQdyDqQdXwrrMNaRY1zDkj2HMZx",
+	"64yvXst11CV52vDlpr45XdE816QUGVMJNsCNA/7M2fLFvjF/p1M8YQ8PVAcY0AceE2qxz0AmMRBf1WsQ",
+	"j2rRtBzKSrHiauvGxaciXGS2YLGOVO6u0TSfQLOmQxLMQ9+bqN5HfoD8APkB8oMxfnCgaiJYXE/lB0fW",
+	"SozCbGUbpjeyzDM45gdksFqCg96oOZ6RLrGC9LOpwF1ViAjeQHA/zxDIEcgRyBHIEcgHgFwzs3BH1n4A",
+	"v/HBp9OP1ZoAfsPMhU3WCkL9Umf7SYEjRxQANmq0kUQzQ2g4wcdhLuB6GxKUQvd3BQTjaMoHIxurDuIr",
+	"Aqiz54j+4lCOMasRRxFHEUcRR18CR0eU5i0wnXQKrkD1qIry+gj8jWGQbSViD2IPYg9iD2LPKPY4Hw39",
+	"kHNnv+99N+uyHQ1uMmoSv+ZyLdNHNPiSstxKKV63zNBQfrek8HVqaWlvIVCSiDyGjJWiew+f8GVaKV8T",
+	"D2IPuN/e2zbN9uVnPIoiHUA6gHQA6cAvkA74a9lFMMkdpAbGB/0FVKruaSe4WXPZY4HcNe21cw9xXAPy",
+	"PnB3wmZ23RZFvqtbU/rWyWY84l7bqzFovRB1ZOJ+E7Lak2n1ZrpXtoR5GcyMbamrn4hmbl/aMJoxZV3H",
+	"rfvV0HsZm/WHgBbsyclpJGjA7XixzE9vrxanxJUEOyMNL7PdT7BtFoppJuytb2i1dtL948eZDRz942xO",
+	"fpxpmrMfZ59HSYn3OVJNBzSYR4N5JF1IupB0Ien6VkjXsO6/VsSwL1w7ZASDJS4OVM4c9T4graAZ9trW",
+	"g3yiz+XZBEhs+ESdoA/ZwyOa4zK+goPt5eenU498+x53fWfu6Xn3zucS6IH3WR54ewwgw6Cg5SMyNmRs",
+	"yNiQsTnGpthKMb1ZGPnABh0S2WRew+KmOOQgpV24lKiQwJaTomq+iFuf4DgEjS6XshSmj+z4z0B6Uq5f",
+	"20J3oNDrm3o1beE7VLCCVb5h5CP0fPXl0Lg9KXF9a0ZxNJL69IzMzY7bMJ06jY++v4+9GE8lYv0lq3iC",
+	"jSU4oG47S27trwct+4t43XhZ+hlJe8twsNiYsYAr1QxcwiuTdakqV1QI/gj+CP4I/gH8fZydCvdtFy4K",
+	"qvWTVNlCMc1MPwG4YSID4XxyYpN770TRa/8O8vuh+uTzXdtajoX+rN97kpOMZpmCFRk7trevGoYak7wV",
+	"YhlXbGlueyxz7q4/EOt2wCVr1OZKNxvGVVWlveSCRG4j51r8zhC3+UlFnCt461fhd86dUig40AFKbHym",
+	"+sBHfvo/DGbSf159/8PFh6vL/3/78W/vv/+pU4+rZM+Sbeb/jModpSDs1fw39a3NRAiBeylzRgU6Y0Sd",
+	"AdIGpA1IG6bQBnjVEVBsSF8QngWGtPZ8TPtIgmYVRTgaOxDs6VMkaNqgoxLPPXbsPfelS7CfGhhfFTgK",
+	"kbF4pwdKBEMEQwRDBEMEw2OB4fm/LRp87Q0rc+1PWrpxOoT/L2me39Plgz1GVjKnVekNrHznM3Zj+O6L",
+	"VRySFdRs6pC/JtLU1zAUR/7tDN0xjsZBmFYA4tBHd9cf9pLp81Fh0/QolxE0ETQRNBE0ETTHQfNRPrCF",
+	"NBumFt6OXw8dJCG5jcZmswTTf13dt1osgVEomlHapGA999BQ4kco7CZUf/iRs9PtL3VMaw+mdR/HNLjY",
+	"SXTOE1OwrqClrRvGeerAF++rvkq0fEdEQ0RDRENEm4hoBVMansEt3NQdt6hywEZCvqZdVXRjOYBin3ze",
+	"i8jo5mgh3kYNyNOS22MdCDfF3je11b0Cgva35olq3xzEUcRRxFHEUcTRl8VRf4aZgJywRvPqMfgATN5U",
+	"KU4FjV6mXywYBvkR/hD+EP4Q/hD+Tgx/01SiSWXoOBD+GhSeqOpEjEKMQoxCjDo9RhlVwogvMvbIl2zC",
+	"Sc1nIC7D3HsG8xuC9vIupcjIii6NVK7vB4Ds1hV46QQ43bmu2ZBf7PGu1Qw85SGCIoIigiKCvg6CTjvs",
+	"NTdtPfGKsIGUv4aDX7sb8PyH6IXoheiF6PXy6KWZyBaPTPGVb/aieoI/6DYgzjLBaQDk+iHK8t5WcqxT",
+	"XvySYOgxf6mZ1as6eRtNWNbvLtgXui1yNns72xhT6Lfn5/6Xs6XcnkcJIWIivc9ZeLLQeWc46s0geC6A",
+	"fkv2aEMc6Nn/G8nyjDf7r3RKdc2C3UQzYdrYXrW00Z2v/oyxWYNdpyHoc2N4fugOoPMCwQTMkmxqHMxe",
+	"IoBojmiOaI5oPoDmfC0WXJyPYThfC8KFE8b71RFZ/F6vhd58La5Oidnv4ueTHripJrR+c5iG8dl0ELat",
+	"aZ24O3mLXm8D4b3mWAmKubgD303YdJnv4OKUTgTa7M5te6FI5xXY++iDmRxCeMzmrfEMI+PKdJPy7Yrm",
+	"OoHkvR4Ybiojqx73jaWyQznKvOxwjITeuoM07TGomjGvXsvasvbQKJAFuWamVEITxuNHPCRjhvJcA3hU",
+	"ExnmP6ogkLQgaUHS8tsmLdY97lTWQolLHrvlbZEW+91Rl+N5J84y7mJjXfpAEh3o2YvYVDAAS3ZlWPQA",
+	"dgOnZL4WLCM8iYUZ14CB1w3gjSu7dAlgD5Nbaviyqs5vEiZyeAxbPFuVuWVWGyqyvIppFWXayVJplq9m",
+	"KVBnMH/eHdp8uAwQxJZBNrQomNB1LUl/wYNuigc89a6U3DbanqqHxe6EW9QRPu2IDXMiG84YhTd6gJET",
+	"SxdyjhVyuWmET5DlfR7ZF4gSCCLUKaRYJlQO38PPMCkyFxZNMBXi2dl6g/UFzJh7BnJoXbKM3O9Ia3Gd",
+	"uxrm466We5yFT++/HoZ3dTm9kBa8uxI/J6Kj5HLNxV+5ML1BdrkgGy5MrASEugMH8DpAmVXQk2qSYE/A",
+	"GVszvNfFePKjLx62pbsiObVyvuQm3wVB7B6wKItqfVpG7tf+1dYld8XBpg182DeP60TfRstVL2XBElq7",
+	"iyrKnU3g1qmTpTNoHi2eODxYf2RK8cxNTH8ECGXAkYZlZ7UcNpRer5/z38LJp7tinuSfra3UJyYy+C05",
+	"mcNZAiRy6UgpDCiLOyZXXPvDcax7jETxhyk8POHhCQ9PeHjCw9Mv/vB0XjHI9BHqCoghocQmi4HdOJvY",
+	"ylNB53hlm+Hml8vsw+NaVhpK0/EJLS6yczh7Z2N/xUe07z0zPc45bYCDvSblaArJ9o9YMnhC6BtPGI5x",
+	"57W+/2uh0IgKQRhBGEEYQXgSCMvSjOguZdn0Z5e2lOJr8bE037BZr9/y0dE4IgYiBiIGIsahiFEWkwx1",
+	"ysJH2fChOSca7NwVf+dmY81cLkR29Fgcx7W53d90dsTuhm+T1ps+xOeK54zYJFbMkbLS4b2hqDi09/42",
+	"RLdxMJW97Ihac3rl9jWuiVM7Nx3+gG4eZqwNDeZKsfu/19BzTX6Cs+1PZ+PPa3wo70mGSl9fxk17666i",
+	"AVXhakmquAemWGoHlffkWPR/D9HS7cI8JAL9IaHu4sLDIKQL/iEo/lOSW716bY9d3RsO3hb0PaUue6Pm",
+	"H39pVo0vFZ8yrM9buQNh87vDf6Rg+WFU45X2Qz0sk2PmN4ve9xbGWuSHGe36B/k68nXk6/vz9d+/mrx3",
+	"olASetgaAb0XhpsdLBKmCM0Vo9mOsC9cG3uluqI8d/YlbtXbRY9Hjl/RkaMUORcPixByvvfMcWfTgS1W",
+	"SJp6iu5SXVQpXiD4/0js/m84Yj6G4kPERcRFDRnC1TPgyp5uFtXBPI1VNtH4pYpLd+c+HQeqBk6x9lPr",
+	"cPmiR9WvL65+2cskEBEOEQ4RDhEOEW4Q4ay2dVf7W0mGmLW6v12krB3yEfYXZlz695WCfmoI2cdWPT0x",
+	"W18qgmx4/ZR80j0ePPZUwWIPcHQSdOpjjsyeYXbv5wL6PkMARgBGAEYAngLA7Uy04H9ju3dSPnAG2eaJ",
+	"YpwUDkvt4ynrIezt+XkulzTfSG3e/uHNmzfntODnsH5nUd2dx7lOFMJEVkgubAx5aghVjHCxzEsYR9v8",
+	"PzFjmCIgB7zo9C/M7HTVLMoOOaFPCqqMNeEXO1Lk5ZqLsxo2Qwd8/fz1vwYAAYLllQgDAgA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,8791 @@
+// Package apis provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package apis
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /account-info)
+	GetAccountInfo(c *fiber.Ctx) error
+
+	// (POST /change-email)
+	ChangeEmail(c *fiber.Ctx) error
+
+	// (POST /change-password)
+	ChangePassword(c *fiber.Ctx) error
+
+	// (POST /create-personal-access-token)
+	CreatePersonalAccessToken(c *fiber.Ctx) error
+
+	// (POST /delete-user)
+	DeleteUser(c *fiber.Ctx) error
+
+	// (GET /delete-user/callback)
+	GetDeleteUserCallback(c *fiber.Ctx, params GetDeleteUserCallbackParams) error
+
+	// (GET /error)
+	GetError(c *fiber.Ctx) error
+
+	// (POST /get-access-token)
+	PostGetAccessToken(c *fiber.Ctx) error
+
+	// (GET /get-session)
+	GetSession(c *fiber.Ctx) error
+
+	// (POST /link-social)
+	LinkSocialAccount(c *fiber.Ctx) error
+
+	// (GET /list-accounts)
+	ListUserAccounts(c *fiber.Ctx) error
+
+	// (GET /list-personal-access-tokens)
+	ListPersonalAccessTokens(c *fiber.Ctx) error
+
+	// (GET /list-sessions)
+	ListUserSessions(c *fiber.Ctx) error
+
+	// (GET /list-trusted-devices)
+	ListTrustedDevices(c *fiber.Ctx) error
+
+	// (GET /ok)
+	GetOk(c *fiber.Ctx) error
+
+	// (POST /organization/accept-invitation)
+	PostOrganizationAcceptInvitation(c *fiber.Ctx) error
+
+	// (POST /organization/add-team-member)
+	PostOrganizationAddTeamMember(c *fiber.Ctx) error
+
+	// (POST /organization/cancel-invitation)
+	PostOrganizationCancelInvitation(c *fiber.Ctx) error
+
+	// (POST /organization/check-slug)
+	PostOrganizationCheckSlug(c *fiber.Ctx) error
+
+	// (POST /organization/create)
+	PostOrganizationCreate(c *fiber.Ctx) error
+
+	// (POST /organization/create-team)
+	PostOrganizationCreateTeam(c *fiber.Ctx) error
+
+	// (POST /organization/delete)
+	PostOrganizationDelete(c *fiber.Ctx) error
+
+	// (GET /organization/get-active-member)
+	GetOrganizationGetActiveMember(c *fiber.Ctx) error
+
+	// (GET /organization/get-active-member-role)
+	GetOrganizationGetActiveMemberRole(c *fiber.Ctx) error
+
+	// (GET /organization/get-full-organization)
+	GetOrganization(c *fiber.Ctx, params GetOrganizationParams) error
+
+	// (GET /organization/get-invitation)
+	GetOrganizationGetInvitation(c *fiber.Ctx, params GetOrganizationGetInvitationParams) error
+
+	// (POST /organization/has-permission)
+	PostOrganizationHasPermission(c *fiber.Ctx) error
+
+	// (POST /organization/invite-member)
+	CreateOrganizationInvitation(c *fiber.Ctx) error
+
+	// (POST /organization/leave)
+	PostOrganizationLeave(c *fiber.Ctx) error
+
+	// (GET /organization/list)
+	GetOrganizationList(c *fiber.Ctx) error
+
+	// (GET /organization/list-invitations)
+	GetOrganizationListInvitations(c *fiber.Ctx, params GetOrganizationListInvitationsParams) error
+
+	// (GET /organization/list-members)
+	GetOrganizationListMembers(c *fiber.Ctx) error
+
+	// (GET /organization/list-team-members)
+	GetOrganizationListTeamMembers(c *fiber.Ctx, params GetOrganizationListTeamMembersParams) error
+
+	// (GET /organization/list-teams)
+	GetOrganizationListTeams(c *fiber.Ctx, params GetOrganizationListTeamsParams) error
+
+	// (GET /organization/list-user-invitations)
+	GetOrganizationListUserInvitations(c *fiber.Ctx) error
+
+	// (GET /organization/list-user-teams)
+	GetOrganizationListUserTeams(c *fiber.Ctx) error
+
+	// (POST /organization/reject-invitation)
+	PostOrganizationRejectInvitation(c *fiber.Ctx) error
+
+	// (POST /organization/remove-member)
+	PostOrganizationRemoveMember(c *fiber.Ctx) error
+
+	// (POST /organization/remove-team)
+	PostOrganizationRemoveTeam(c *fiber.Ctx) error
+
+	// (POST /organization/remove-team-member)
+	PostOrganizationRemoveTeamMember(c *fiber.Ctx) error
+
+	// (POST /organization/set-active)
+	SetActiveOrganization(c *fiber.Ctx) error
+
+	// (POST /organization/set-active-team)
+	PostOrganizationSetActiveTeam(c *fiber.Ctx) error
+
+	// (POST /organization/update)
+	PostOrganizationUpdate(c *fiber.Ctx) error
+
+	// (POST /organization/update-member-role)
+	UpdateOrganizationMemberRole(c *fiber.Ctx) error
+
+	// (POST /organization/update-team)
+	PostOrganizationUpdateTeam(c *fiber.Ctx) error
+
+	// (POST /refresh-token)
+	PostRefreshToken(c *fiber.Ctx) error
+
+	// (POST /request-password-reset)
+	RequestPasswordReset(c *fiber.Ctx) error
+
+	// (POST /reset-password)
+	ResetPassword(c *fiber.Ctx) error
+
+	// (GET /reset-password/{token})
+	ResetPasswordCallback(c *fiber.Ctx, token string, params ResetPasswordCallbackParams) error
+
+	// (POST /revoke-other-sessions)
+	PostRevokeOtherSessions(c *fiber.Ctx) error
+
+	// (POST /revoke-personal-access-token)
+	PostRevokePersonalAccessToken(c *fiber.Ctx) error
+
+	// (POST /revoke-session)
+	PostRevokeSession(c *fiber.Ctx) error
+
+	// (POST /revoke-sessions)
+	PostRevokeSessions(c *fiber.Ctx) error
+
+	// (POST /revoke-trusted-device)
+	PostRevokeTrustedDevice(c *fiber.Ctx) error
+
+	// (POST /revoke-trusted-devices)
+	PostRevokeTrustedDevices(c *fiber.Ctx) error
+
+	// (POST /send-verification-email)
+	SendVerificationEmail(c *fiber.Ctx) error
+
+	// (POST /sign-in/email)
+	SignInEmail(c *fiber.Ctx) error
+
+	// (POST /sign-in/social)
+	SocialSignIn(c *fiber.Ctx) error
+
+	// (POST /sign-in/social/nonce)
+	CreateSocialSignInNonce(c *fiber.Ctx) error
+
+	// (POST /sign-out)
+	SignOut(c *fiber.Ctx) error
+
+	// (POST /sign-up/email)
+	SignUpWithEmailAndPassword(c *fiber.Ctx) error
+
+	// (POST /unlink-account)
+	PostUnlinkAccount(c *fiber.Ctx) error
+
+	// (POST /update-user)
+	UpdateUser(c *fiber.Ctx) error
+
+	// (GET /verify-email)
+	GetVerifyEmail(c *fiber.Ctx, params GetVerifyEmailParams) error
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+type MiddlewareFunc fiber.Handler
+
+// GetAccountInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetAccountInfo(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetAccountInfo(c)
+}
+
+// ChangeEmail operation middleware
+func (siw *ServerInterfaceWrapper) ChangeEmail(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ChangeEmail(c)
+}
+
+// ChangePassword operation middleware
+func (siw *ServerInterfaceWrapper) ChangePassword(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ChangePassword(c)
+}
+
+// CreatePersonalAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) CreatePersonalAccessToken(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CreatePersonalAccessToken(c)
+}
+
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteUser(c)
+}
+
+// GetDeleteUserCallback operation middleware
+func (siw *ServerInterfaceWrapper) GetDeleteUserCallback(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDeleteUserCallbackParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "token", query, &params.Token)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter token: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "callbackURL" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "callbackURL", query, &params.CallbackURL)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter callbackURL: %w", err).Error())
+	}
+
+	return siw.Handler.GetDeleteUserCallback(c, params)
+}
+
+// GetError operation middleware
+func (siw *ServerInterfaceWrapper) GetError(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetError(c)
+}
+
+// PostGetAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) PostGetAccessToken(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostGetAccessToken(c)
+}
+
+// GetSession operation middleware
+func (siw *ServerInterfaceWrapper) GetSession(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetSession(c)
+}
+
+// LinkSocialAccount operation middleware
+func (siw *ServerInterfaceWrapper) LinkSocialAccount(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.LinkSocialAccount(c)
+}
+
+// ListUserAccounts operation middleware
+func (siw *ServerInterfaceWrapper) ListUserAccounts(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ListUserAccounts(c)
+}
+
+// ListPersonalAccessTokens operation middleware
+func (siw *ServerInterfaceWrapper) ListPersonalAccessTokens(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ListPersonalAccessTokens(c)
+}
+
+// ListUserSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListUserSessions(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ListUserSessions(c)
+}
+
+// ListTrustedDevices operation middleware
+func (siw *ServerInterfaceWrapper) ListTrustedDevices(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ListTrustedDevices(c)
+}
+
+// GetOk operation middleware
+func (siw *ServerInterfaceWrapper) GetOk(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOk(c)
+}
+
+// PostOrganizationAcceptInvitation operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationAcceptInvitation(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationAcceptInvitation(c)
+}
+
+// PostOrganizationAddTeamMember operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationAddTeamMember(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationAddTeamMember(c)
+}
+
+// PostOrganizationCancelInvitation operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationCancelInvitation(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationCancelInvitation(c)
+}
+
+// PostOrganizationCheckSlug operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationCheckSlug(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationCheckSlug(c)
+}
+
+// PostOrganizationCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationCreate(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationCreate(c)
+}
+
+// PostOrganizationCreateTeam operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationCreateTeam(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationCreateTeam(c)
+}
+
+// PostOrganizationDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationDelete(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationDelete(c)
+}
+
+// GetOrganizationGetActiveMember operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationGetActiveMember(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrganizationGetActiveMember(c)
+}
+
+// GetOrganizationGetActiveMemberRole operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationGetActiveMemberRole(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrganizationGetActiveMemberRole(c)
+}
+
+// GetOrganization operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganization(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "organizationId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organizationId", query, &params.OrganizationId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationId: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "organizationSlug" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organizationSlug", query, &params.OrganizationSlug)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationSlug: %w", err).Error())
+	}
+
+	return siw.Handler.GetOrganization(c, params)
+}
+
+// GetOrganizationGetInvitation operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationGetInvitation(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationGetInvitationParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", query, &params.Id)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	return siw.Handler.GetOrganizationGetInvitation(c, params)
+}
+
+// PostOrganizationHasPermission operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationHasPermission(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationHasPermission(c)
+}
+
+// CreateOrganizationInvitation operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganizationInvitation(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CreateOrganizationInvitation(c)
+}
+
+// PostOrganizationLeave operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationLeave(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationLeave(c)
+}
+
+// GetOrganizationList operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationList(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrganizationList(c)
+}
+
+// GetOrganizationListInvitations operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationListInvitations(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationListInvitationsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "organizationId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organizationId", query, &params.OrganizationId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationId: %w", err).Error())
+	}
+
+	return siw.Handler.GetOrganizationListInvitations(c, params)
+}
+
+// GetOrganizationListMembers operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationListMembers(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrganizationListMembers(c)
+}
+
+// GetOrganizationListTeamMembers operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationListTeamMembers(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationListTeamMembersParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "teamId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "teamId", query, &params.TeamId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter teamId: %w", err).Error())
+	}
+
+	return siw.Handler.GetOrganizationListTeamMembers(c, params)
+}
+
+// GetOrganizationListTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationListTeams(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationListTeamsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "organizationId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organizationId", query, &params.OrganizationId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationId: %w", err).Error())
+	}
+
+	return siw.Handler.GetOrganizationListTeams(c, params)
+}
+
+// GetOrganizationListUserInvitations operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationListUserInvitations(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrganizationListUserInvitations(c)
+}
+
+// GetOrganizationListUserTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationListUserTeams(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrganizationListUserTeams(c)
+}
+
+// PostOrganizationRejectInvitation operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationRejectInvitation(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationRejectInvitation(c)
+}
+
+// PostOrganizationRemoveMember operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationRemoveMember(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationRemoveMember(c)
+}
+
+// PostOrganizationRemoveTeam operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationRemoveTeam(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationRemoveTeam(c)
+}
+
+// PostOrganizationRemoveTeamMember operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationRemoveTeamMember(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationRemoveTeamMember(c)
+}
+
+// SetActiveOrganization operation middleware
+func (siw *ServerInterfaceWrapper) SetActiveOrganization(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.SetActiveOrganization(c)
+}
+
+// PostOrganizationSetActiveTeam operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationSetActiveTeam(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationSetActiveTeam(c)
+}
+
+// PostOrganizationUpdate operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationUpdate(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationUpdate(c)
+}
+
+// UpdateOrganizationMemberRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationMemberRole(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.UpdateOrganizationMemberRole(c)
+}
+
+// PostOrganizationUpdateTeam operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationUpdateTeam(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostOrganizationUpdateTeam(c)
+}
+
+// PostRefreshToken operation middleware
+func (siw *ServerInterfaceWrapper) PostRefreshToken(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostRefreshToken(c)
+}
+
+// RequestPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) RequestPasswordReset(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.RequestPasswordReset(c)
+}
+
+// ResetPassword operation middleware
+func (siw *ServerInterfaceWrapper) ResetPassword(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ResetPassword(c)
+}
+
+// ResetPasswordCallback operation middleware
+func (siw *ServerInterfaceWrapper) ResetPasswordCallback(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", c.Params("token"), &token, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter token: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResetPasswordCallbackParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "callbackURL" -------------
+
+	if paramValue := c.Query("callbackURL"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument callbackURL is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "callbackURL", query, &params.CallbackURL)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter callbackURL: %w", err).Error())
+	}
+
+	return siw.Handler.ResetPasswordCallback(c, token, params)
+}
+
+// PostRevokeOtherSessions operation middleware
+func (siw *ServerInterfaceWrapper) PostRevokeOtherSessions(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostRevokeOtherSessions(c)
+}
+
+// PostRevokePersonalAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) PostRevokePersonalAccessToken(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostRevokePersonalAccessToken(c)
+}
+
+// PostRevokeSession operation middleware
+func (siw *ServerInterfaceWrapper) PostRevokeSession(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostRevokeSession(c)
+}
+
+// PostRevokeSessions operation middleware
+func (siw *ServerInterfaceWrapper) PostRevokeSessions(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostRevokeSessions(c)
+}
+
+// PostRevokeTrustedDevice operation middleware
+func (siw *ServerInterfaceWrapper) PostRevokeTrustedDevice(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostRevokeTrustedDevice(c)
+}
+
+// PostRevokeTrustedDevices operation middleware
+func (siw *ServerInterfaceWrapper) PostRevokeTrustedDevices(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostRevokeTrustedDevices(c)
+}
+
+// SendVerificationEmail operation middleware
+func (siw *ServerInterfaceWrapper) SendVerificationEmail(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.SendVerificationEmail(c)
+}
+
+// SignInEmail operation middleware
+func (siw *ServerInterfaceWrapper) SignInEmail(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.SignInEmail(c)
+}
+
+// SocialSignIn operation middleware
+func (siw *ServerInterfaceWrapper) SocialSignIn(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.SocialSignIn(c)
+}
+
+// CreateSocialSignInNonce operation middleware
+func (siw *ServerInterfaceWrapper) CreateSocialSignInNonce(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CreateSocialSignInNonce(c)
+}
+
+// SignOut operation middleware
+func (siw *ServerInterfaceWrapper) SignOut(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.SignOut(c)
+}
+
+// SignUpWithEmailAndPassword operation middleware
+func (siw *ServerInterfaceWrapper) SignUpWithEmailAndPassword(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.SignUpWithEmailAndPassword(c)
+}
+
+// PostUnlinkAccount operation middleware
+func (siw *ServerInterfaceWrapper) PostUnlinkAccount(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostUnlinkAccount(c)
+}
+
+// UpdateUser operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUser(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.UpdateUser(c)
+}
+
+// GetVerifyEmail operation middleware
+func (siw *ServerInterfaceWrapper) GetVerifyEmail(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVerifyEmailParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := c.Query("token"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument token is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", query, &params.Token)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter token: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "callbackURL" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "callbackURL", query, &params.CallbackURL)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter callbackURL: %w", err).Error())
+	}
+
+	return siw.Handler.GetVerifyEmail(c, params)
+}
+
+// FiberServerOptions provides options for the Fiber server.
+type FiberServerOptions struct {
+	BaseURL     string
+	Middlewares []MiddlewareFunc
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router fiber.Router, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	for _, m := range options.Middlewares {
+		router.Use(fiber.Handler(m))
+	}
+
+	router.Get(options.BaseURL+"/account-info", wrapper.GetAccountInfo)
+
+	router.Post(options.BaseURL+"/change-email", wrapper.ChangeEmail)
+
+	router.Post(options.BaseURL+"/change-password", wrapper.ChangePassword)
+
+	router.Post(options.BaseURL+"/create-personal-access-token", wrapper.CreatePersonalAccessToken)
+
+	router.Post(options.BaseURL+"/delete-user", wrapper.DeleteUser)
+
+	router.Get(options.BaseURL+"/delete-user/callback", wrapper.GetDeleteUserCallback)
+
+	router.Get(options.BaseURL+"/error", wrapper.GetError)
+
+	router.Post(options.BaseURL+"/get-access-token", wrapper.PostGetAccessToken)
+
+	router.Get(options.BaseURL+"/get-session", wrapper.GetSession)
+
+	router.Post(options.BaseURL+"/link-social", wrapper.LinkSocialAccount)
+
+	router.Get(options.BaseURL+"/list-accounts", wrapper.ListUserAccounts)
+
+	router.Get(options.BaseURL+"/list-personal-access-tokens", wrapper.ListPersonalAccessTokens)
+
+	router.Get(options.BaseURL+"/list-sessions", wrapper.ListUserSessions)
+
+	router.Get(options.BaseURL+"/list-trusted-devices", wrapper.ListTrustedDevices)
+
+	router.Get(options.BaseURL+"/ok", wrapper.GetOk)
+
+	router.Post(options.BaseURL+"/organization/accept-invitation", wrapper.PostOrganizationAcceptInvitation)
+
+	router.Post(options.BaseURL+"/organization/add-team-member", wrapper.PostOrganizationAddTeamMember)
+
+	router.Post(options.BaseURL+"/organization/cancel-invitation", wrapper.PostOrganizationCancelInvitation)
+
+	router.Post(options.BaseURL+"/organization/check-slug", wrapper.PostOrganizationCheckSlug)
+
+	router.Post(options.BaseURL+"/organization/create", wrapper.PostOrganizationCreate)
+
+	router.Post(options.BaseURL+"/organization/create-team", wrapper.PostOrganizationCreateTeam)
+
+	router.Post(options.BaseURL+"/organization/delete", wrapper.PostOrganizationDelete)
+
+	router.Get(options.BaseURL+"/organization/get-active-member", wrapper.GetOrganizationGetActiveMember)
+
+	router.Get(options.BaseURL+"/organization/get-active-member-role", wrapper.GetOrganizationGetActiveMemberRole)
+
+	router.Get(options.BaseURL+"/organization/get-full-organization", wrapper.GetOrganization)
+
+	router.Get(options.BaseURL+"/organization/get-invitation", wrapper.GetOrganizationGetInvitation)
+
+	router.Post(options.BaseURL+"/organization/has-permission", wrapper.PostOrganizationHasPermission)
+
+	router.Post(options.BaseURL+"/organization/invite-member", wrapper.CreateOrganizationInvitation)
+
+	router.Post(options.BaseURL+"/organization/leave", wrapper.PostOrganizationLeave)
+
+	router.Get(options.BaseURL+"/organization/list", wrapper.GetOrganizationList)
+
+	router.Get(options.BaseURL+"/organization/list-invitations", wrapper.GetOrganizationListInvitations)
+
+	router.Get(options.BaseURL+"/organization/list-members", wrapper.GetOrganizationListMembers)
+
+	router.Get(options.BaseURL+"/organization/list-team-members", wrapper.GetOrganizationListTeamMembers)
+
+	router.Get(options.BaseURL+"/organization/list-teams", wrapper.GetOrganizationListTeams)
+
+	router.Get(options.BaseURL+"/organization/list-user-invitations", wrapper.GetOrganizationListUserInvitations)
+
+	router.Get(options.BaseURL+"/organization/list-user-teams", wrapper.GetOrganizationListUserTeams)
+
+	router.Post(options.BaseURL+"/organization/reject-invitation", wrapper.PostOrganizationRejectInvitation)
+
+	router.Post(options.BaseURL+"/organization/remove-member", wrapper.PostOrganizationRemoveMember)
+
+	router.Post(options.BaseURL+"/organization/remove-team", wrapper.PostOrganizationRemoveTeam)
+
+	router.Post(options.BaseURL+"/organization/remove-team-member", wrapper.PostOrganizationRemoveTeamMember)
+
+	router.Post(options.BaseURL+"/organization/set-active", wrapper.SetActiveOrganization)
+
+	router.Post(options.BaseURL+"/organization/set-active-team", wrapper.PostOrganizationSetActiveTeam)
+
+	router.Post(options.BaseURL+"/organization/update", wrapper.PostOrganizationUpdate)
+
+	router.Post(options.BaseURL+"/organization/update-member-role", wrapper.UpdateOrganizationMemberRole)
+
+	router.Post(options.BaseURL+"/organization/update-team", wrapper.PostOrganizationUpdateTeam)
+
+	router.Post(options.BaseURL+"/refresh-token", wrapper.PostRefreshToken)
+
+	router.Post(options.BaseURL+"/request-password-reset", wrapper.RequestPasswordReset)
+
+	router.Post(options.BaseURL+"/reset-password", wrapper.ResetPassword)
+
+	router.Get(options.BaseURL+"/reset-password/:token", wrapper.ResetPasswordCallback)
+
+	router.Post(options.BaseURL+"/revoke-other-sessions", wrapper.PostRevokeOtherSessions)
+
+	router.Post(options.BaseURL+"/revoke-personal-access-token", wrapper.PostRevokePersonalAccessToken)
+
+	router.Post(options.BaseURL+"/revoke-session", wrapper.PostRevokeSession)
+
+	router.Post(options.BaseURL+"/revoke-sessions", wrapper.PostRevokeSessions)
+
+	router.Post(options.BaseURL+"/revoke-trusted-device", wrapper.PostRevokeTrustedDevice)
+
+	router.Post(options.BaseURL+"/revoke-trusted-devices", wrapper.PostRevokeTrustedDevices)
+
+	router.Post(options.BaseURL+"/send-verification-email", wrapper.SendVerificationEmail)
+
+	router.Post(options.BaseURL+"/sign-in/email", wrapper.SignInEmail)
+
+	router.Post(options.BaseURL+"/sign-in/social", wrapper.SocialSignIn)
+
+	router.Post(options.BaseURL+"/sign-in/social/nonce", wrapper.CreateSocialSignInNonce)
+
+	router.Post(options.BaseURL+"/sign-out", wrapper.SignOut)
+
+	router.Post(options.BaseURL+"/sign-up/email", wrapper.SignUpWithEmailAndPassword)
+
+	router.Post(options.BaseURL+"/unlink-account", wrapper.PostUnlinkAccount)
+
+	router.Post(options.BaseURL+"/update-user", wrapper.UpdateUser)
+
+	router.Get(options.BaseURL+"/verify-email", wrapper.GetVerifyEmail)
+
+}
+
+type GetAccountInfoRequestObject struct {
+}
+
+type GetAccountInfoResponseObject interface {
+	VisitGetAccountInfoResponse(ctx *fiber.Ctx) error
+}
+
+type GetAccountInfo200JSONResponse struct {
+	Data map[string]interface{} `json:"data"`
+	User struct {
+		Email         *string `json:"email,omitempty"`
+		EmailVerified bool    `json:"emailVerified"`
+		Id            string  `json:"id"`
+		Image         *string `json:"image,omitempty"`
+		Name          *string `json:"name,omitempty"`
+	} `json:"user"`
+}
+
+func (response GetAccountInfo200JSONResponse) VisitGetAccountInfoResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetAccountInfo400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetAccountInfo400JSONResponse) VisitGetAccountInfoResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetAccountInfo401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetAccountInfo401JSONResponse) VisitGetAccountInfoResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetAccountInfo403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetAccountInfo403JSONResponse) VisitGetAccountInfoResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetAccountInfo404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetAccountInfo404JSONResponse) VisitGetAccountInfoResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetAccountInfo429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetAccountInfo429JSONResponse) VisitGetAccountInfoResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetAccountInfo500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetAccountInfo500JSONResponse) VisitGetAccountInfoResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type ChangeEmailRequestObject struct {
+	Body *ChangeEmailJSONRequestBody
+}
+
+type ChangeEmailResponseObject interface {
+	VisitChangeEmailResponse(ctx *fiber.Ctx) error
+}
+
+type ChangeEmail200JSONResponse struct {
+	// Message Status message of the email change process
+	Message *string `json:"message"`
+
+	// Status Indicates if the request was successful
+	Status bool  `json:"status"`
+	User   *User `json:"user,omitempty"`
+}
+
+func (response ChangeEmail200JSONResponse) VisitChangeEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ChangeEmail400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ChangeEmail400JSONResponse) VisitChangeEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ChangeEmail401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ChangeEmail401JSONResponse) VisitChangeEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ChangeEmail403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ChangeEmail403JSONResponse) VisitChangeEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type ChangeEmail404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ChangeEmail404JSONResponse) VisitChangeEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ChangeEmail422JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ChangeEmail422JSONResponse) VisitChangeEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type ChangeEmail429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ChangeEmail429JSONResponse) VisitChangeEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type ChangeEmail500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ChangeEmail500JSONResponse) VisitChangeEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type ChangePasswordRequestObject struct {
+	Body *ChangePasswordJSONRequestBody
+}
+
+type ChangePasswordResponseObject interface {
+	VisitChangePasswordResponse(ctx *fiber.Ctx) error
+}
+
+type ChangePassword200JSONResponse struct {
+	// Token New session token if other sessions were revoked
+	Token *string `json:"token"`
+	User  struct {
+		// CreatedAt When the user was created
+		CreatedAt time.Time `json:"createdAt"`
+
+		// Email The email address of the user
+		Email openapi_types.Email `json:"email"`
+
+		// EmailVerified Whether the email has been verified
+		EmailVerified bool `json:"emailVerified"`
+
+		// Id The unique identifier of the user
+		Id string `json:"id"`
+
+		// Image The profile image URL of the user
+		Image *string `json:"image"`
+
+		// Name The name of the user
+		Name string `json:"name"`
+
+		// UpdatedAt When the user was last updated
+		UpdatedAt time.Time `json:"updatedAt"`
+	} `json:"user"`
+}
+
+func (response ChangePassword200JSONResponse) VisitChangePasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ChangePassword400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ChangePassword400JSONResponse) VisitChangePasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ChangePassword401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ChangePassword401JSONResponse) VisitChangePasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ChangePassword403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ChangePassword403JSONResponse) VisitChangePasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type ChangePassword404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ChangePassword404JSONResponse) VisitChangePasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ChangePassword429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ChangePassword429JSONResponse) VisitChangePasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type ChangePassword500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ChangePassword500JSONResponse) VisitChangePasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type CreatePersonalAccessTokenRequestObject struct {
+	Body *CreatePersonalAccessTokenJSONRequestBody
+}
+
+type CreatePersonalAccessTokenResponseObject interface {
+	VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error
+}
+
+type CreatePersonalAccessToken200JSONResponse struct {
+	PersonalAccessToken PersonalAccessToken `json:"personalAccessToken"`
+
+	// Token The token, which is only returned once
+	Token string `json:"token"`
+}
+
+func (response CreatePersonalAccessToken200JSONResponse) VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type CreatePersonalAccessToken400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response CreatePersonalAccessToken400JSONResponse) VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreatePersonalAccessToken401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response CreatePersonalAccessToken401JSONResponse) VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreatePersonalAccessToken403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreatePersonalAccessToken403JSONResponse) VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type CreatePersonalAccessToken404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreatePersonalAccessToken404JSONResponse) VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type CreatePersonalAccessToken429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreatePersonalAccessToken429JSONResponse) VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type CreatePersonalAccessToken500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreatePersonalAccessToken500JSONResponse) VisitCreatePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUserRequestObject struct {
+	Body *DeleteUserJSONRequestBody
+}
+
+type DeleteUserResponseObject interface {
+	VisitDeleteUserResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteUser200JSONResponse struct {
+	// Message Status message of the deletion process
+	Message string `json:"message"`
+
+	// Success Indicates if the operation was successful
+	Success bool `json:"success"`
+}
+
+func (response DeleteUser200JSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response DeleteUser400JSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response DeleteUser401JSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response DeleteUser403JSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response DeleteUser404JSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response DeleteUser429JSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response DeleteUser500JSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetDeleteUserCallbackRequestObject struct {
+	Params GetDeleteUserCallbackParams
+}
+
+type GetDeleteUserCallbackResponseObject interface {
+	VisitGetDeleteUserCallbackResponse(ctx *fiber.Ctx) error
+}
+
+type GetDeleteUserCallback200JSONResponse struct {
+	// Message Confirmation message
+	Message string `json:"message"`
+
+	// Success Indicates if the deletion was successful
+	Success bool `json:"success"`
+}
+
+func (response GetDeleteUserCallback200JSONResponse) VisitGetDeleteUserCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetDeleteUserCallback400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetDeleteUserCallback400JSONResponse) VisitGetDeleteUserCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetDeleteUserCallback401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetDeleteUserCallback401JSONResponse) VisitGetDeleteUserCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetDeleteUserCallback403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetDeleteUserCallback403JSONResponse) VisitGetDeleteUserCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetDeleteUserCallback404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetDeleteUserCallback404JSONResponse) VisitGetDeleteUserCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetDeleteUserCallback429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetDeleteUserCallback429JSONResponse) VisitGetDeleteUserCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetDeleteUserCallback500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetDeleteUserCallback500JSONResponse) VisitGetDeleteUserCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetErrorRequestObject struct {
+}
+
+type GetErrorResponseObject interface {
+	VisitGetErrorResponse(ctx *fiber.Ctx) error
+}
+
+type GetError200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetError200TexthtmlResponse) VisitGetErrorResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type GetError400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetError400JSONResponse) VisitGetErrorResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetError401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetError401JSONResponse) VisitGetErrorResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetError403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetError403JSONResponse) VisitGetErrorResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetError404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetError404JSONResponse) VisitGetErrorResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetError429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetError429JSONResponse) VisitGetErrorResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetError500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetError500JSONResponse) VisitGetErrorResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostGetAccessTokenRequestObject struct {
+	Body *PostGetAccessTokenJSONRequestBody
+}
+
+type PostGetAccessTokenResponseObject interface {
+	VisitPostGetAccessTokenResponse(ctx *fiber.Ctx) error
+}
+
+type PostGetAccessToken200JSONResponse struct {
+	AccessToken           *string    `json:"accessToken,omitempty"`
+	AccessTokenExpiresAt  *time.Time `json:"accessTokenExpiresAt,omitempty"`
+	IdToken               *string    `json:"idToken,omitempty"`
+	RefreshToken          *string    `json:"refreshToken,omitempty"`
+	RefreshTokenExpiresAt *time.Time `json:"refreshTokenExpiresAt,omitempty"`
+	TokenType             *string    `json:"tokenType,omitempty"`
+}
+
+func (response PostGetAccessToken200JSONResponse) VisitPostGetAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostGetAccessToken400Response struct {
+}
+
+func (response PostGetAccessToken400Response) VisitPostGetAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Status(400)
+	return nil
+}
+
+type PostGetAccessToken401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostGetAccessToken401JSONResponse) VisitPostGetAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostGetAccessToken403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostGetAccessToken403JSONResponse) VisitPostGetAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostGetAccessToken404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostGetAccessToken404JSONResponse) VisitPostGetAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostGetAccessToken429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostGetAccessToken429JSONResponse) VisitPostGetAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostGetAccessToken500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostGetAccessToken500JSONResponse) VisitPostGetAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetSessionRequestObject struct {
+}
+
+type GetSessionResponseObject interface {
+	VisitGetSessionResponse(ctx *fiber.Ctx) error
+}
+
+type GetSession200JSONResponse struct {
+	Session Session `json:"session"`
+	User    User    `json:"user"`
+}
+
+func (response GetSession200JSONResponse) VisitGetSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetSession400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetSession400JSONResponse) VisitGetSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetSession401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetSession401JSONResponse) VisitGetSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetSession403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetSession403JSONResponse) VisitGetSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetSession404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetSession404JSONResponse) VisitGetSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetSession429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetSession429JSONResponse) VisitGetSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetSession500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetSession500JSONResponse) VisitGetSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type LinkSocialAccountRequestObject struct {
+	Body *LinkSocialAccountJSONRequestBody
+}
+
+type LinkSocialAccountResponseObject interface {
+	VisitLinkSocialAccountResponse(ctx *fiber.Ctx) error
+}
+
+type LinkSocialAccount200JSONResponse struct {
+	// Redirect Indicates if the user should be redirected to the authorization URL
+	Redirect bool  `json:"redirect"`
+	Status   *bool `json:"status,omitempty"`
+
+	// Url The authorization URL to redirect the user to
+	Url *string `json:"url,omitempty"`
+}
+
+func (response LinkSocialAccount200JSONResponse) VisitLinkSocialAccountResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type LinkSocialAccount400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response LinkSocialAccount400JSONResponse) VisitLinkSocialAccountResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type LinkSocialAccount401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response LinkSocialAccount401JSONResponse) VisitLinkSocialAccountResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type LinkSocialAccount403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response LinkSocialAccount403JSONResponse) VisitLinkSocialAccountResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type LinkSocialAccount404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response LinkSocialAccount404JSONResponse) VisitLinkSocialAccountResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type LinkSocialAccount429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response LinkSocialAccount429JSONResponse) VisitLinkSocialAccountResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type LinkSocialAccount500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response LinkSocialAccount500JSONResponse) VisitLinkSocialAccountResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type ListUserAccountsRequestObject struct {
+}
+
+type ListUserAccountsResponseObject interface {
+	VisitListUserAccountsResponse(ctx *fiber.Ctx) error
+}
+
+type ListUserAccounts200JSONResponse []struct {
+	AccountId  string    `json:"accountId"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Id         string    `json:"id"`
+	ProviderId string    `json:"providerId"`
+	Scopes     []string  `json:"scopes"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	UserId     string    `json:"userId"`
+}
+
+func (response ListUserAccounts200JSONResponse) VisitListUserAccountsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListUserAccounts400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ListUserAccounts400JSONResponse) VisitListUserAccountsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ListUserAccounts401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ListUserAccounts401JSONResponse) VisitListUserAccountsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListUserAccounts403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListUserAccounts403JSONResponse) VisitListUserAccountsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type ListUserAccounts404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListUserAccounts404JSONResponse) VisitListUserAccountsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ListUserAccounts429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListUserAccounts429JSONResponse) VisitListUserAccountsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type ListUserAccounts500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListUserAccounts500JSONResponse) VisitListUserAccountsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type ListPersonalAccessTokensRequestObject struct {
+}
+
+type ListPersonalAccessTokensResponseObject interface {
+	VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error
+}
+
+type ListPersonalAccessTokens200JSONResponse []PersonalAccessToken
+
+func (response ListPersonalAccessTokens200JSONResponse) VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListPersonalAccessTokens400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ListPersonalAccessTokens400JSONResponse) VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ListPersonalAccessTokens401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ListPersonalAccessTokens401JSONResponse) VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListPersonalAccessTokens403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListPersonalAccessTokens403JSONResponse) VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type ListPersonalAccessTokens404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListPersonalAccessTokens404JSONResponse) VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ListPersonalAccessTokens429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListPersonalAccessTokens429JSONResponse) VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type ListPersonalAccessTokens500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListPersonalAccessTokens500JSONResponse) VisitListPersonalAccessTokensResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type ListUserSessionsRequestObject struct {
+}
+
+type ListUserSessionsResponseObject interface {
+	VisitListUserSessionsResponse(ctx *fiber.Ctx) error
+}
+
+type ListUserSessions200JSONResponse []Session
+
+func (response ListUserSessions200JSONResponse) VisitListUserSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListUserSessions400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ListUserSessions400JSONResponse) VisitListUserSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ListUserSessions401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ListUserSessions401JSONResponse) VisitListUserSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListUserSessions403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListUserSessions403JSONResponse) VisitListUserSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type ListUserSessions404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListUserSessions404JSONResponse) VisitListUserSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ListUserSessions429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListUserSessions429JSONResponse) VisitListUserSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type ListUserSessions500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListUserSessions500JSONResponse) VisitListUserSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type ListTrustedDevicesRequestObject struct {
+}
+
+type ListTrustedDevicesResponseObject interface {
+	VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error
+}
+
+type ListTrustedDevices200JSONResponse []TrustedDevice
+
+func (response ListTrustedDevices200JSONResponse) VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListTrustedDevices400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ListTrustedDevices400JSONResponse) VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ListTrustedDevices401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ListTrustedDevices401JSONResponse) VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListTrustedDevices403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListTrustedDevices403JSONResponse) VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type ListTrustedDevices404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListTrustedDevices404JSONResponse) VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ListTrustedDevices429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListTrustedDevices429JSONResponse) VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type ListTrustedDevices500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ListTrustedDevices500JSONResponse) VisitListTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOkRequestObject struct {
+}
+
+type GetOkResponseObject interface {
+	VisitGetOkResponse(ctx *fiber.Ctx) error
+}
+
+type GetOk200JSONResponse struct {
+	// Ok Indicates if the API is working
+	Ok bool `json:"ok"`
+}
+
+func (response GetOk200JSONResponse) VisitGetOkResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOk400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOk400JSONResponse) VisitGetOkResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOk401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOk401JSONResponse) VisitGetOkResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetOk403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOk403JSONResponse) VisitGetOkResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetOk404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOk404JSONResponse) VisitGetOkResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetOk429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOk429JSONResponse) VisitGetOkResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetOk500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOk500JSONResponse) VisitGetOkResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationAcceptInvitationRequestObject struct {
+	Body *PostOrganizationAcceptInvitationJSONRequestBody
+}
+
+type PostOrganizationAcceptInvitationResponseObject interface {
+	VisitPostOrganizationAcceptInvitationResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationAcceptInvitation200JSONResponse struct {
+	Invitation *Invitation `json:"invitation,omitempty"`
+	Member     *Member     `json:"member,omitempty"`
+}
+
+func (response PostOrganizationAcceptInvitation200JSONResponse) VisitPostOrganizationAcceptInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationAcceptInvitation400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationAcceptInvitation400JSONResponse) VisitPostOrganizationAcceptInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationAcceptInvitation401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationAcceptInvitation401JSONResponse) VisitPostOrganizationAcceptInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationAcceptInvitation403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationAcceptInvitation403JSONResponse) VisitPostOrganizationAcceptInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationAcceptInvitation404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationAcceptInvitation404JSONResponse) VisitPostOrganizationAcceptInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationAcceptInvitation429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationAcceptInvitation429JSONResponse) VisitPostOrganizationAcceptInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationAcceptInvitation500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationAcceptInvitation500JSONResponse) VisitPostOrganizationAcceptInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationAddTeamMemberRequestObject struct {
+	Body *PostOrganizationAddTeamMemberJSONRequestBody
+}
+
+type PostOrganizationAddTeamMemberResponseObject interface {
+	VisitPostOrganizationAddTeamMemberResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationAddTeamMember200JSONResponse struct {
+	// CreatedAt Timestamp when the team member was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Unique identifier of the team member
+	Id string `json:"id"`
+
+	// TeamId The team ID of the team the team member is in
+	TeamId string `json:"teamId"`
+
+	// UserId The user ID of the team member
+	UserId string `json:"userId"`
+}
+
+func (response PostOrganizationAddTeamMember200JSONResponse) VisitPostOrganizationAddTeamMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationAddTeamMember400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationAddTeamMember400JSONResponse) VisitPostOrganizationAddTeamMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationAddTeamMember401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationAddTeamMember401JSONResponse) VisitPostOrganizationAddTeamMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationAddTeamMember403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationAddTeamMember403JSONResponse) VisitPostOrganizationAddTeamMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationAddTeamMember404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationAddTeamMember404JSONResponse) VisitPostOrganizationAddTeamMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationAddTeamMember429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationAddTeamMember429JSONResponse) VisitPostOrganizationAddTeamMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationAddTeamMember500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationAddTeamMember500JSONResponse) VisitPostOrganizationAddTeamMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCancelInvitationRequestObject struct {
+	Body *PostOrganizationCancelInvitationJSONRequestBody
+}
+
+type PostOrganizationCancelInvitationResponseObject interface {
+	VisitPostOrganizationCancelInvitationResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationCancelInvitation200JSONResponse Invitation
+
+func (response PostOrganizationCancelInvitation200JSONResponse) VisitPostOrganizationCancelInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCancelInvitation400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationCancelInvitation400JSONResponse) VisitPostOrganizationCancelInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCancelInvitation401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationCancelInvitation401JSONResponse) VisitPostOrganizationCancelInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCancelInvitation403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCancelInvitation403JSONResponse) VisitPostOrganizationCancelInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCancelInvitation404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCancelInvitation404JSONResponse) VisitPostOrganizationCancelInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCancelInvitation429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCancelInvitation429JSONResponse) VisitPostOrganizationCancelInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCancelInvitation500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCancelInvitation500JSONResponse) VisitPostOrganizationCancelInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCheckSlugRequestObject struct {
+	Body *PostOrganizationCheckSlugJSONRequestBody
+}
+
+type PostOrganizationCheckSlugResponseObject interface {
+	VisitPostOrganizationCheckSlugResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationCheckSlug200JSONResponse struct {
+	Status *bool `json:"status,omitempty"`
+}
+
+func (response PostOrganizationCheckSlug200JSONResponse) VisitPostOrganizationCheckSlugResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCheckSlug400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationCheckSlug400JSONResponse) VisitPostOrganizationCheckSlugResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCheckSlug401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationCheckSlug401JSONResponse) VisitPostOrganizationCheckSlugResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCheckSlug403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCheckSlug403JSONResponse) VisitPostOrganizationCheckSlugResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCheckSlug404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCheckSlug404JSONResponse) VisitPostOrganizationCheckSlugResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCheckSlug429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCheckSlug429JSONResponse) VisitPostOrganizationCheckSlugResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCheckSlug500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCheckSlug500JSONResponse) VisitPostOrganizationCheckSlugResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCreateRequestObject struct {
+	Body *PostOrganizationCreateJSONRequestBody
+}
+
+type PostOrganizationCreateResponseObject interface {
+	VisitPostOrganizationCreateResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationCreate200JSONResponse Organization
+
+func (response PostOrganizationCreate200JSONResponse) VisitPostOrganizationCreateResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCreate400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationCreate400JSONResponse) VisitPostOrganizationCreateResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCreate401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationCreate401JSONResponse) VisitPostOrganizationCreateResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCreate403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCreate403JSONResponse) VisitPostOrganizationCreateResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCreate404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCreate404JSONResponse) VisitPostOrganizationCreateResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCreate429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCreate429JSONResponse) VisitPostOrganizationCreateResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCreate500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCreate500JSONResponse) VisitPostOrganizationCreateResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCreateTeamRequestObject struct {
+	Body *PostOrganizationCreateTeamJSONRequestBody
+}
+
+type PostOrganizationCreateTeamResponseObject interface {
+	VisitPostOrganizationCreateTeamResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationCreateTeam200JSONResponse struct {
+	// CreatedAt Timestamp when the team was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Unique identifier of the created team
+	Id string `json:"id"`
+
+	// Name Name of the team
+	Name string `json:"name"`
+
+	// OrganizationId ID of the organization the team belongs to
+	OrganizationId string `json:"organizationId"`
+
+	// UpdatedAt Timestamp when the team was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func (response PostOrganizationCreateTeam200JSONResponse) VisitPostOrganizationCreateTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCreateTeam400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationCreateTeam400JSONResponse) VisitPostOrganizationCreateTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCreateTeam401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationCreateTeam401JSONResponse) VisitPostOrganizationCreateTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCreateTeam403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCreateTeam403JSONResponse) VisitPostOrganizationCreateTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCreateTeam404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCreateTeam404JSONResponse) VisitPostOrganizationCreateTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCreateTeam429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCreateTeam429JSONResponse) VisitPostOrganizationCreateTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationCreateTeam500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationCreateTeam500JSONResponse) VisitPostOrganizationCreateTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationDeleteRequestObject struct {
+	Body *PostOrganizationDeleteJSONRequestBody
+}
+
+type PostOrganizationDeleteResponseObject interface {
+	VisitPostOrganizationDeleteResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationDelete200JSONResponse string
+
+func (response PostOrganizationDelete200JSONResponse) VisitPostOrganizationDeleteResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationDelete400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationDelete400JSONResponse) VisitPostOrganizationDeleteResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationDelete401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationDelete401JSONResponse) VisitPostOrganizationDeleteResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationDelete403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationDelete403JSONResponse) VisitPostOrganizationDeleteResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationDelete404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationDelete404JSONResponse) VisitPostOrganizationDeleteResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationDelete429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationDelete429JSONResponse) VisitPostOrganizationDeleteResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationDelete500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationDelete500JSONResponse) VisitPostOrganizationDeleteResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetActiveMemberRequestObject struct {
+}
+
+type GetOrganizationGetActiveMemberResponseObject interface {
+	VisitGetOrganizationGetActiveMemberResponse(ctx *fiber.Ctx) error
+}
+
+type GetOrganizationGetActiveMember200JSONResponse struct {
+	Id             string `json:"id"`
+	OrganizationId string `json:"organizationId"`
+	Role           string `json:"role"`
+	UserId         string `json:"userId"`
+}
+
+func (response GetOrganizationGetActiveMember200JSONResponse) VisitGetOrganizationGetActiveMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetActiveMember400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationGetActiveMember400JSONResponse) VisitGetOrganizationGetActiveMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetActiveMember401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationGetActiveMember401JSONResponse) VisitGetOrganizationGetActiveMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetActiveMember403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationGetActiveMember403JSONResponse) VisitGetOrganizationGetActiveMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetActiveMember404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationGetActiveMember404JSONResponse) VisitGetOrganizationGetActiveMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetActiveMember429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationGetActiveMember429JSONResponse) VisitGetOrganizationGetActiveMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetActiveMember500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationGetActiveMember500JSONResponse) VisitGetOrganizationGetActiveMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetActiveMemberRoleRequestObject struct {
+}
+
+type GetOrganizationGetActiveMemberRoleResponseObject interface {
+	VisitGetOrganizationGetActiveMemberRoleResponse(ctx *fiber.Ctx) error
+}
+
+type GetOrganizationGetActiveMemberRole200JSONResponse struct {
+	Role string `json:"role"`
+}
+
+func (response GetOrganizationGetActiveMemberRole200JSONResponse) VisitGetOrganizationGetActiveMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetActiveMemberRole400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationGetActiveMemberRole400JSONResponse) VisitGetOrganizationGetActiveMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetActiveMemberRole401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationGetActiveMemberRole401JSONResponse) VisitGetOrganizationGetActiveMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetActiveMemberRole403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationGetActiveMemberRole403JSONResponse) VisitGetOrganizationGetActiveMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetActiveMemberRole404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationGetActiveMemberRole404JSONResponse) VisitGetOrganizationGetActiveMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetActiveMemberRole429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationGetActiveMemberRole429JSONResponse) VisitGetOrganizationGetActiveMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetActiveMemberRole500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationGetActiveMemberRole500JSONResponse) VisitGetOrganizationGetActiveMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationRequestObject struct {
+	Params GetOrganizationParams
+}
+
+type GetOrganizationResponseObject interface {
+	VisitGetOrganizationResponse(ctx *fiber.Ctx) error
+}
+
+type GetOrganization200JSONResponse Organization
+
+func (response GetOrganization200JSONResponse) VisitGetOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganization400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganization400JSONResponse) VisitGetOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganization401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganization401JSONResponse) VisitGetOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganization403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganization403JSONResponse) VisitGetOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganization404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganization404JSONResponse) VisitGetOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganization429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganization429JSONResponse) VisitGetOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganization500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganization500JSONResponse) VisitGetOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetInvitationRequestObject struct {
+	Params GetOrganizationGetInvitationParams
+}
+
+type GetOrganizationGetInvitationResponseObject interface {
+	VisitGetOrganizationGetInvitationResponse(ctx *fiber.Ctx) error
+}
+
+type GetOrganizationGetInvitation200JSONResponse struct {
+	Email            string `json:"email"`
+	ExpiresAt        string `json:"expiresAt"`
+	Id               string `json:"id"`
+	InviterEmail     string `json:"inviterEmail"`
+	InviterId        string `json:"inviterId"`
+	OrganizationId   string `json:"organizationId"`
+	OrganizationName string `json:"organizationName"`
+	OrganizationSlug string `json:"organizationSlug"`
+	Role             string `json:"role"`
+	Status           string `json:"status"`
+}
+
+func (response GetOrganizationGetInvitation200JSONResponse) VisitGetOrganizationGetInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetInvitation400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationGetInvitation400JSONResponse) VisitGetOrganizationGetInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetInvitation401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationGetInvitation401JSONResponse) VisitGetOrganizationGetInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetInvitation403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationGetInvitation403JSONResponse) VisitGetOrganizationGetInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetInvitation404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationGetInvitation404JSONResponse) VisitGetOrganizationGetInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetInvitation429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationGetInvitation429JSONResponse) VisitGetOrganizationGetInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationGetInvitation500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationGetInvitation500JSONResponse) VisitGetOrganizationGetInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationHasPermissionRequestObject struct {
+	Body *PostOrganizationHasPermissionJSONRequestBody
+}
+
+type PostOrganizationHasPermissionResponseObject interface {
+	VisitPostOrganizationHasPermissionResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationHasPermission200JSONResponse struct {
+	Error   *string `json:"error,omitempty"`
+	Success bool    `json:"success"`
+}
+
+func (response PostOrganizationHasPermission200JSONResponse) VisitPostOrganizationHasPermissionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationHasPermission400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationHasPermission400JSONResponse) VisitPostOrganizationHasPermissionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationHasPermission401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationHasPermission401JSONResponse) VisitPostOrganizationHasPermissionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationHasPermission403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationHasPermission403JSONResponse) VisitPostOrganizationHasPermissionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationHasPermission404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationHasPermission404JSONResponse) VisitPostOrganizationHasPermissionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationHasPermission429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationHasPermission429JSONResponse) VisitPostOrganizationHasPermissionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationHasPermission500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationHasPermission500JSONResponse) VisitPostOrganizationHasPermissionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type CreateOrganizationInvitationRequestObject struct {
+	Body *CreateOrganizationInvitationJSONRequestBody
+}
+
+type CreateOrganizationInvitationResponseObject interface {
+	VisitCreateOrganizationInvitationResponse(ctx *fiber.Ctx) error
+}
+
+type CreateOrganizationInvitation200JSONResponse struct {
+	CreatedAt      string `json:"createdAt"`
+	Email          string `json:"email"`
+	ExpiresAt      string `json:"expiresAt"`
+	Id             string `json:"id"`
+	InviterId      string `json:"inviterId"`
+	OrganizationId string `json:"organizationId"`
+	Role           string `json:"role"`
+	Status         string `json:"status"`
+}
+
+func (response CreateOrganizationInvitation200JSONResponse) VisitCreateOrganizationInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type CreateOrganizationInvitation400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response CreateOrganizationInvitation400JSONResponse) VisitCreateOrganizationInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateOrganizationInvitation401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response CreateOrganizationInvitation401JSONResponse) VisitCreateOrganizationInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreateOrganizationInvitation403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreateOrganizationInvitation403JSONResponse) VisitCreateOrganizationInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type CreateOrganizationInvitation404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreateOrganizationInvitation404JSONResponse) VisitCreateOrganizationInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type CreateOrganizationInvitation429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreateOrganizationInvitation429JSONResponse) VisitCreateOrganizationInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type CreateOrganizationInvitation500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreateOrganizationInvitation500JSONResponse) VisitCreateOrganizationInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationLeaveRequestObject struct {
+	Body *PostOrganizationLeaveJSONRequestBody
+}
+
+type PostOrganizationLeaveResponseObject interface {
+	VisitPostOrganizationLeaveResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationLeave200JSONResponse struct {
+	Member struct {
+		Id             string `json:"id"`
+		OrganizationId string `json:"organizationId"`
+		Role           string `json:"role"`
+		UserId         string `json:"userId"`
+	} `json:"member"`
+}
+
+func (response PostOrganizationLeave200JSONResponse) VisitPostOrganizationLeaveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationLeave400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationLeave400JSONResponse) VisitPostOrganizationLeaveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationLeave401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationLeave401JSONResponse) VisitPostOrganizationLeaveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationLeave403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationLeave403JSONResponse) VisitPostOrganizationLeaveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationLeave404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationLeave404JSONResponse) VisitPostOrganizationLeaveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationLeave429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationLeave429JSONResponse) VisitPostOrganizationLeaveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationLeave500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationLeave500JSONResponse) VisitPostOrganizationLeaveResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListRequestObject struct {
+}
+
+type GetOrganizationListResponseObject interface {
+	VisitGetOrganizationListResponse(ctx *fiber.Ctx) error
+}
+
+type GetOrganizationList200JSONResponse []Organization
+
+func (response GetOrganizationList200JSONResponse) VisitGetOrganizationListResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationList400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationList400JSONResponse) VisitGetOrganizationListResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationList401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationList401JSONResponse) VisitGetOrganizationListResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationList403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationList403JSONResponse) VisitGetOrganizationListResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationList404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationList404JSONResponse) VisitGetOrganizationListResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationList429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationList429JSONResponse) VisitGetOrganizationListResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationList500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationList500JSONResponse) VisitGetOrganizationListResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListInvitationsRequestObject struct {
+	Params GetOrganizationListInvitationsParams
+}
+
+type GetOrganizationListInvitationsResponseObject interface {
+	VisitGetOrganizationListInvitationsResponse(ctx *fiber.Ctx) error
+}
+
+type GetOrganizationListInvitations200JSONResponse []Invitation
+
+func (response GetOrganizationListInvitations200JSONResponse) VisitGetOrganizationListInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListInvitations400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationListInvitations400JSONResponse) VisitGetOrganizationListInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListInvitations401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationListInvitations401JSONResponse) VisitGetOrganizationListInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListInvitations403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListInvitations403JSONResponse) VisitGetOrganizationListInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListInvitations404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListInvitations404JSONResponse) VisitGetOrganizationListInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListInvitations429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListInvitations429JSONResponse) VisitGetOrganizationListInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListInvitations500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListInvitations500JSONResponse) VisitGetOrganizationListInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListMembersRequestObject struct {
+}
+
+type GetOrganizationListMembersResponseObject interface {
+	VisitGetOrganizationListMembersResponse(ctx *fiber.Ctx) error
+}
+
+type GetOrganizationListMembers400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationListMembers400JSONResponse) VisitGetOrganizationListMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListMembers401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationListMembers401JSONResponse) VisitGetOrganizationListMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListMembers403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListMembers403JSONResponse) VisitGetOrganizationListMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListMembers404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListMembers404JSONResponse) VisitGetOrganizationListMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListMembers429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListMembers429JSONResponse) VisitGetOrganizationListMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListMembers500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListMembers500JSONResponse) VisitGetOrganizationListMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListTeamMembersRequestObject struct {
+	Params GetOrganizationListTeamMembersParams
+}
+
+type GetOrganizationListTeamMembersResponseObject interface {
+	VisitGetOrganizationListTeamMembersResponse(ctx *fiber.Ctx) error
+}
+
+type GetOrganizationListTeamMembers200JSONResponse []struct {
+	// CreatedAt Timestamp when the team member was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Unique identifier of the team member
+	Id string `json:"id"`
+
+	// TeamId The team ID of the team the team member is in
+	TeamId string `json:"teamId"`
+
+	// UserId The user ID of the team member
+	UserId string `json:"userId"`
+}
+
+func (response GetOrganizationListTeamMembers200JSONResponse) VisitGetOrganizationListTeamMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListTeamMembers400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationListTeamMembers400JSONResponse) VisitGetOrganizationListTeamMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListTeamMembers401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationListTeamMembers401JSONResponse) VisitGetOrganizationListTeamMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListTeamMembers403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListTeamMembers403JSONResponse) VisitGetOrganizationListTeamMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListTeamMembers404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListTeamMembers404JSONResponse) VisitGetOrganizationListTeamMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListTeamMembers429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListTeamMembers429JSONResponse) VisitGetOrganizationListTeamMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListTeamMembers500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListTeamMembers500JSONResponse) VisitGetOrganizationListTeamMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListTeamsRequestObject struct {
+	Params GetOrganizationListTeamsParams
+}
+
+type GetOrganizationListTeamsResponseObject interface {
+	VisitGetOrganizationListTeamsResponse(ctx *fiber.Ctx) error
+}
+
+type GetOrganizationListTeams200JSONResponse []struct {
+	// CreatedAt Timestamp when the team was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Unique identifier of the team
+	Id string `json:"id"`
+
+	// Name Name of the team
+	Name string `json:"name"`
+
+	// OrganizationId ID of the organization the team belongs to
+	OrganizationId string `json:"organizationId"`
+
+	// UpdatedAt Timestamp when the team was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func (response GetOrganizationListTeams200JSONResponse) VisitGetOrganizationListTeamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListTeams400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationListTeams400JSONResponse) VisitGetOrganizationListTeamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListTeams401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationListTeams401JSONResponse) VisitGetOrganizationListTeamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListTeams403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListTeams403JSONResponse) VisitGetOrganizationListTeamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListTeams404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListTeams404JSONResponse) VisitGetOrganizationListTeamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListTeams429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListTeams429JSONResponse) VisitGetOrganizationListTeamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListTeams500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListTeams500JSONResponse) VisitGetOrganizationListTeamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListUserInvitationsRequestObject struct {
+}
+
+type GetOrganizationListUserInvitationsResponseObject interface {
+	VisitGetOrganizationListUserInvitationsResponse(ctx *fiber.Ctx) error
+}
+
+type GetOrganizationListUserInvitations200JSONResponse []struct {
+	CreatedAt string `json:"createdAt"`
+	Email     string `json:"email"`
+	ExpiresAt string `json:"expiresAt"`
+	Id        string `json:"id"`
+
+	// InviterId The ID of the user who created the invitation
+	InviterId        string `json:"inviterId"`
+	OrganizationId   string `json:"organizationId"`
+	OrganizationName string `json:"organizationName"`
+	Role             string `json:"role"`
+	Status           string `json:"status"`
+
+	// TeamId The ID of the team associated with the invitation
+	TeamId *string `json:"teamId"`
+}
+
+func (response GetOrganizationListUserInvitations200JSONResponse) VisitGetOrganizationListUserInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListUserInvitations400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationListUserInvitations400JSONResponse) VisitGetOrganizationListUserInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListUserInvitations401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationListUserInvitations401JSONResponse) VisitGetOrganizationListUserInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListUserInvitations403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListUserInvitations403JSONResponse) VisitGetOrganizationListUserInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListUserInvitations404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListUserInvitations404JSONResponse) VisitGetOrganizationListUserInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListUserInvitations429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListUserInvitations429JSONResponse) VisitGetOrganizationListUserInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListUserInvitations500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListUserInvitations500JSONResponse) VisitGetOrganizationListUserInvitationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListUserTeamsRequestObject struct {
+}
+
+type GetOrganizationListUserTeamsResponseObject interface {
+	VisitGetOrganizationListUserTeamsResponse(ctx *fiber.Ctx) error
+}
+
+type GetOrganizationListUserTeams200JSONResponse []Team
+
+func (response GetOrganizationListUserTeams200JSONResponse) VisitGetOrganizationListUserTeamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListUserTeams400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationListUserTeams400JSONResponse) VisitGetOrganizationListUserTeamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListUserTeams401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetOrganizationListUserTeams401JSONResponse) VisitGetOrganizationListUserTeamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListUserTeams403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListUserTeams403JSONResponse) VisitGetOrganizationListUserTeamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListUserTeams404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListUserTeams404JSONResponse) VisitGetOrganizationListUserTeamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListUserTeams429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListUserTeams429JSONResponse) VisitGetOrganizationListUserTeamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrganizationListUserTeams500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetOrganizationListUserTeams500JSONResponse) VisitGetOrganizationListUserTeamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRejectInvitationRequestObject struct {
+	Body *PostOrganizationRejectInvitationJSONRequestBody
+}
+
+type PostOrganizationRejectInvitationResponseObject interface {
+	VisitPostOrganizationRejectInvitationResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationRejectInvitation200JSONResponse struct {
+	Invitation *Invitation             `json:"invitation,omitempty"`
+	Member     *map[string]interface{} `json:"member"`
+}
+
+func (response PostOrganizationRejectInvitation200JSONResponse) VisitPostOrganizationRejectInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRejectInvitation400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationRejectInvitation400JSONResponse) VisitPostOrganizationRejectInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRejectInvitation401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationRejectInvitation401JSONResponse) VisitPostOrganizationRejectInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRejectInvitation403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRejectInvitation403JSONResponse) VisitPostOrganizationRejectInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRejectInvitation404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRejectInvitation404JSONResponse) VisitPostOrganizationRejectInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRejectInvitation429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRejectInvitation429JSONResponse) VisitPostOrganizationRejectInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRejectInvitation500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRejectInvitation500JSONResponse) VisitPostOrganizationRejectInvitationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveMemberRequestObject struct {
+	Body *PostOrganizationRemoveMemberJSONRequestBody
+}
+
+type PostOrganizationRemoveMemberResponseObject interface {
+	VisitPostOrganizationRemoveMemberResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationRemoveMember200JSONResponse struct {
+	Member struct {
+		Id             string `json:"id"`
+		OrganizationId string `json:"organizationId"`
+		Role           string `json:"role"`
+		UserId         string `json:"userId"`
+	} `json:"member"`
+}
+
+func (response PostOrganizationRemoveMember200JSONResponse) VisitPostOrganizationRemoveMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveMember400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationRemoveMember400JSONResponse) VisitPostOrganizationRemoveMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveMember401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationRemoveMember401JSONResponse) VisitPostOrganizationRemoveMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveMember403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRemoveMember403JSONResponse) VisitPostOrganizationRemoveMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveMember404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRemoveMember404JSONResponse) VisitPostOrganizationRemoveMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveMember429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRemoveMember429JSONResponse) VisitPostOrganizationRemoveMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveMember500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRemoveMember500JSONResponse) VisitPostOrganizationRemoveMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveTeamRequestObject struct {
+	Body *PostOrganizationRemoveTeamJSONRequestBody
+}
+
+type PostOrganizationRemoveTeamResponseObject interface {
+	VisitPostOrganizationRemoveTeamResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationRemoveTeam200JSONResponse struct {
+	// Message Confirmation message indicating successful removal
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationRemoveTeam200JSONResponse) VisitPostOrganizationRemoveTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveTeam400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationRemoveTeam400JSONResponse) VisitPostOrganizationRemoveTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveTeam401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationRemoveTeam401JSONResponse) VisitPostOrganizationRemoveTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveTeam403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRemoveTeam403JSONResponse) VisitPostOrganizationRemoveTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveTeam404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRemoveTeam404JSONResponse) VisitPostOrganizationRemoveTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveTeam429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRemoveTeam429JSONResponse) VisitPostOrganizationRemoveTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveTeam500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRemoveTeam500JSONResponse) VisitPostOrganizationRemoveTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveTeamMemberRequestObject struct {
+	Body *PostOrganizationRemoveTeamMemberJSONRequestBody
+}
+
+type PostOrganizationRemoveTeamMemberResponseObject interface {
+	VisitPostOrganizationRemoveTeamMemberResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationRemoveTeamMember200JSONResponse struct {
+	// Message Confirmation message indicating successful removal
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationRemoveTeamMember200JSONResponse) VisitPostOrganizationRemoveTeamMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveTeamMember400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationRemoveTeamMember400JSONResponse) VisitPostOrganizationRemoveTeamMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveTeamMember401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationRemoveTeamMember401JSONResponse) VisitPostOrganizationRemoveTeamMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveTeamMember403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRemoveTeamMember403JSONResponse) VisitPostOrganizationRemoveTeamMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveTeamMember404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRemoveTeamMember404JSONResponse) VisitPostOrganizationRemoveTeamMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveTeamMember429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRemoveTeamMember429JSONResponse) VisitPostOrganizationRemoveTeamMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationRemoveTeamMember500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationRemoveTeamMember500JSONResponse) VisitPostOrganizationRemoveTeamMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetActiveOrganizationRequestObject struct {
+	Body *SetActiveOrganizationJSONRequestBody
+}
+
+type SetActiveOrganizationResponseObject interface {
+	VisitSetActiveOrganizationResponse(ctx *fiber.Ctx) error
+}
+
+type SetActiveOrganization200JSONResponse Organization
+
+func (response SetActiveOrganization200JSONResponse) VisitSetActiveOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type SetActiveOrganization400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response SetActiveOrganization400JSONResponse) VisitSetActiveOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetActiveOrganization401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response SetActiveOrganization401JSONResponse) VisitSetActiveOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetActiveOrganization403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SetActiveOrganization403JSONResponse) VisitSetActiveOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type SetActiveOrganization404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SetActiveOrganization404JSONResponse) VisitSetActiveOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type SetActiveOrganization429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SetActiveOrganization429JSONResponse) VisitSetActiveOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type SetActiveOrganization500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SetActiveOrganization500JSONResponse) VisitSetActiveOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationSetActiveTeamRequestObject struct {
+	Body *PostOrganizationSetActiveTeamJSONRequestBody
+}
+
+type PostOrganizationSetActiveTeamResponseObject interface {
+	VisitPostOrganizationSetActiveTeamResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationSetActiveTeam200JSONResponse Team
+
+func (response PostOrganizationSetActiveTeam200JSONResponse) VisitPostOrganizationSetActiveTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationSetActiveTeam400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationSetActiveTeam400JSONResponse) VisitPostOrganizationSetActiveTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationSetActiveTeam401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationSetActiveTeam401JSONResponse) VisitPostOrganizationSetActiveTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationSetActiveTeam403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationSetActiveTeam403JSONResponse) VisitPostOrganizationSetActiveTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationSetActiveTeam404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationSetActiveTeam404JSONResponse) VisitPostOrganizationSetActiveTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationSetActiveTeam429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationSetActiveTeam429JSONResponse) VisitPostOrganizationSetActiveTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationSetActiveTeam500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationSetActiveTeam500JSONResponse) VisitPostOrganizationSetActiveTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationUpdateRequestObject struct {
+	Body *PostOrganizationUpdateJSONRequestBody
+}
+
+type PostOrganizationUpdateResponseObject interface {
+	VisitPostOrganizationUpdateResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationUpdate200JSONResponse Organization
+
+func (response PostOrganizationUpdate200JSONResponse) VisitPostOrganizationUpdateResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationUpdate400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationUpdate400JSONResponse) VisitPostOrganizationUpdateResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationUpdate401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationUpdate401JSONResponse) VisitPostOrganizationUpdateResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationUpdate403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationUpdate403JSONResponse) VisitPostOrganizationUpdateResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationUpdate404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationUpdate404JSONResponse) VisitPostOrganizationUpdateResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationUpdate429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationUpdate429JSONResponse) VisitPostOrganizationUpdateResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationUpdate500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationUpdate500JSONResponse) VisitPostOrganizationUpdateResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateOrganizationMemberRoleRequestObject struct {
+	Body *UpdateOrganizationMemberRoleJSONRequestBody
+}
+
+type UpdateOrganizationMemberRoleResponseObject interface {
+	VisitUpdateOrganizationMemberRoleResponse(ctx *fiber.Ctx) error
+}
+
+type UpdateOrganizationMemberRole200JSONResponse struct {
+	Member struct {
+		Id             string `json:"id"`
+		OrganizationId string `json:"organizationId"`
+		Role           string `json:"role"`
+		UserId         string `json:"userId"`
+	} `json:"member"`
+}
+
+func (response UpdateOrganizationMemberRole200JSONResponse) VisitUpdateOrganizationMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateOrganizationMemberRole400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response UpdateOrganizationMemberRole400JSONResponse) VisitUpdateOrganizationMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateOrganizationMemberRole401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response UpdateOrganizationMemberRole401JSONResponse) VisitUpdateOrganizationMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateOrganizationMemberRole403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response UpdateOrganizationMemberRole403JSONResponse) VisitUpdateOrganizationMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateOrganizationMemberRole404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response UpdateOrganizationMemberRole404JSONResponse) VisitUpdateOrganizationMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateOrganizationMemberRole429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response UpdateOrganizationMemberRole429JSONResponse) VisitUpdateOrganizationMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateOrganizationMemberRole500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response UpdateOrganizationMemberRole500JSONResponse) VisitUpdateOrganizationMemberRoleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationUpdateTeamRequestObject struct {
+	Body *PostOrganizationUpdateTeamJSONRequestBody
+}
+
+type PostOrganizationUpdateTeamResponseObject interface {
+	VisitPostOrganizationUpdateTeamResponse(ctx *fiber.Ctx) error
+}
+
+type PostOrganizationUpdateTeam200JSONResponse struct {
+	// CreatedAt Timestamp when the team was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Unique identifier of the updated team
+	Id string `json:"id"`
+
+	// Name Updated name of the team
+	Name string `json:"name"`
+
+	// OrganizationId ID of the organization the team belongs to
+	OrganizationId string `json:"organizationId"`
+
+	// UpdatedAt Timestamp when the team was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func (response PostOrganizationUpdateTeam200JSONResponse) VisitPostOrganizationUpdateTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationUpdateTeam400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationUpdateTeam400JSONResponse) VisitPostOrganizationUpdateTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationUpdateTeam401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostOrganizationUpdateTeam401JSONResponse) VisitPostOrganizationUpdateTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationUpdateTeam403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationUpdateTeam403JSONResponse) VisitPostOrganizationUpdateTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationUpdateTeam404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationUpdateTeam404JSONResponse) VisitPostOrganizationUpdateTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationUpdateTeam429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationUpdateTeam429JSONResponse) VisitPostOrganizationUpdateTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostOrganizationUpdateTeam500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostOrganizationUpdateTeam500JSONResponse) VisitPostOrganizationUpdateTeamResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostRefreshTokenRequestObject struct {
+	Body *PostRefreshTokenJSONRequestBody
+}
+
+type PostRefreshTokenResponseObject interface {
+	VisitPostRefreshTokenResponse(ctx *fiber.Ctx) error
+}
+
+type PostRefreshToken200JSONResponse struct {
+	AccessToken           *string    `json:"accessToken,omitempty"`
+	AccessTokenExpiresAt  *time.Time `json:"accessTokenExpiresAt,omitempty"`
+	IdToken               *string    `json:"idToken,omitempty"`
+	RefreshToken          *string    `json:"refreshToken,omitempty"`
+	RefreshTokenExpiresAt *time.Time `json:"refreshTokenExpiresAt,omitempty"`
+	TokenType             *string    `json:"tokenType,omitempty"`
+}
+
+func (response PostRefreshToken200JSONResponse) VisitPostRefreshTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostRefreshToken400Response struct {
+}
+
+func (response PostRefreshToken400Response) VisitPostRefreshTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Status(400)
+	return nil
+}
+
+type PostRefreshToken401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRefreshToken401JSONResponse) VisitPostRefreshTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostRefreshToken403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRefreshToken403JSONResponse) VisitPostRefreshTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostRefreshToken404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRefreshToken404JSONResponse) VisitPostRefreshTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostRefreshToken429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRefreshToken429JSONResponse) VisitPostRefreshTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostRefreshToken500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRefreshToken500JSONResponse) VisitPostRefreshTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type RequestPasswordResetRequestObject struct {
+	Body *RequestPasswordResetJSONRequestBody
+}
+
+type RequestPasswordResetResponseObject interface {
+	VisitRequestPasswordResetResponse(ctx *fiber.Ctx) error
+}
+
+type RequestPasswordReset200JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+	Status  *bool   `json:"status,omitempty"`
+}
+
+func (response RequestPasswordReset200JSONResponse) VisitRequestPasswordResetResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type RequestPasswordReset400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response RequestPasswordReset400JSONResponse) VisitRequestPasswordResetResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type RequestPasswordReset401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response RequestPasswordReset401JSONResponse) VisitRequestPasswordResetResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type RequestPasswordReset403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response RequestPasswordReset403JSONResponse) VisitRequestPasswordResetResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type RequestPasswordReset404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response RequestPasswordReset404JSONResponse) VisitRequestPasswordResetResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type RequestPasswordReset429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response RequestPasswordReset429JSONResponse) VisitRequestPasswordResetResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type RequestPasswordReset500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response RequestPasswordReset500JSONResponse) VisitRequestPasswordResetResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type ResetPasswordRequestObject struct {
+	Body *ResetPasswordJSONRequestBody
+}
+
+type ResetPasswordResponseObject interface {
+	VisitResetPasswordResponse(ctx *fiber.Ctx) error
+}
+
+type ResetPassword200JSONResponse struct {
+	Status *bool `json:"status,omitempty"`
+}
+
+func (response ResetPassword200JSONResponse) VisitResetPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ResetPassword400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ResetPassword400JSONResponse) VisitResetPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ResetPassword401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ResetPassword401JSONResponse) VisitResetPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ResetPassword403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ResetPassword403JSONResponse) VisitResetPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type ResetPassword404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ResetPassword404JSONResponse) VisitResetPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ResetPassword429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ResetPassword429JSONResponse) VisitResetPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type ResetPassword500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ResetPassword500JSONResponse) VisitResetPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type ResetPasswordCallbackRequestObject struct {
+	Token  string `json:"token"`
+	Params ResetPasswordCallbackParams
+}
+
+type ResetPasswordCallbackResponseObject interface {
+	VisitResetPasswordCallbackResponse(ctx *fiber.Ctx) error
+}
+
+type ResetPasswordCallback200JSONResponse struct {
+	Token *string `json:"token,omitempty"`
+}
+
+func (response ResetPasswordCallback200JSONResponse) VisitResetPasswordCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ResetPasswordCallback400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ResetPasswordCallback400JSONResponse) VisitResetPasswordCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ResetPasswordCallback401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response ResetPasswordCallback401JSONResponse) VisitResetPasswordCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ResetPasswordCallback403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ResetPasswordCallback403JSONResponse) VisitResetPasswordCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type ResetPasswordCallback404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ResetPasswordCallback404JSONResponse) VisitResetPasswordCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ResetPasswordCallback429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ResetPasswordCallback429JSONResponse) VisitResetPasswordCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type ResetPasswordCallback500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response ResetPasswordCallback500JSONResponse) VisitResetPasswordCallbackResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeOtherSessionsRequestObject struct {
+	Body *PostRevokeOtherSessionsJSONRequestBody
+}
+
+type PostRevokeOtherSessionsResponseObject interface {
+	VisitPostRevokeOtherSessionsResponse(ctx *fiber.Ctx) error
+}
+
+type PostRevokeOtherSessions200JSONResponse struct {
+	// Status Indicates if all other sessions were revoked successfully
+	Status bool `json:"status"`
+}
+
+func (response PostRevokeOtherSessions200JSONResponse) VisitPostRevokeOtherSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeOtherSessions400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokeOtherSessions400JSONResponse) VisitPostRevokeOtherSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeOtherSessions401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokeOtherSessions401JSONResponse) VisitPostRevokeOtherSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeOtherSessions403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeOtherSessions403JSONResponse) VisitPostRevokeOtherSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeOtherSessions404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeOtherSessions404JSONResponse) VisitPostRevokeOtherSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeOtherSessions429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeOtherSessions429JSONResponse) VisitPostRevokeOtherSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeOtherSessions500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeOtherSessions500JSONResponse) VisitPostRevokeOtherSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokePersonalAccessTokenRequestObject struct {
+	Body *PostRevokePersonalAccessTokenJSONRequestBody
+}
+
+type PostRevokePersonalAccessTokenResponseObject interface {
+	VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error
+}
+
+type PostRevokePersonalAccessToken200JSONResponse struct {
+	// Status Indicates if the personal access token was revoked successfully
+	Status bool `json:"status"`
+}
+
+func (response PostRevokePersonalAccessToken200JSONResponse) VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokePersonalAccessToken400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokePersonalAccessToken400JSONResponse) VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokePersonalAccessToken401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokePersonalAccessToken401JSONResponse) VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokePersonalAccessToken403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokePersonalAccessToken403JSONResponse) VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokePersonalAccessToken404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokePersonalAccessToken404JSONResponse) VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokePersonalAccessToken429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokePersonalAccessToken429JSONResponse) VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokePersonalAccessToken500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokePersonalAccessToken500JSONResponse) VisitPostRevokePersonalAccessTokenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessionRequestObject struct {
+	Body *PostRevokeSessionJSONRequestBody
+}
+
+type PostRevokeSessionResponseObject interface {
+	VisitPostRevokeSessionResponse(ctx *fiber.Ctx) error
+}
+
+type PostRevokeSession200JSONResponse struct {
+	// Status Indicates if the session was revoked successfully
+	Status bool `json:"status"`
+}
+
+func (response PostRevokeSession200JSONResponse) VisitPostRevokeSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSession400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokeSession400JSONResponse) VisitPostRevokeSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSession401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokeSession401JSONResponse) VisitPostRevokeSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSession403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeSession403JSONResponse) VisitPostRevokeSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSession404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeSession404JSONResponse) VisitPostRevokeSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSession429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeSession429JSONResponse) VisitPostRevokeSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSession500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeSession500JSONResponse) VisitPostRevokeSessionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessionsRequestObject struct {
+	Body *PostRevokeSessionsJSONRequestBody
+}
+
+type PostRevokeSessionsResponseObject interface {
+	VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error
+}
+
+type PostRevokeSessions200JSONResponse struct {
+	// Status Indicates if all sessions were revoked successfully
+	Status bool `json:"status"`
+}
+
+func (response PostRevokeSessions200JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessions400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokeSessions400JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessions401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokeSessions401JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessions403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeSessions403JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessions404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeSessions404JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessions429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeSessions429JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeSessions500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeSessions500JSONResponse) VisitPostRevokeSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDeviceRequestObject struct {
+	Body *PostRevokeTrustedDeviceJSONRequestBody
+}
+
+type PostRevokeTrustedDeviceResponseObject interface {
+	VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error
+}
+
+type PostRevokeTrustedDevice200JSONResponse struct {
+	// Status Indicates if the trusted device was revoked successfully
+	Status bool `json:"status"`
+}
+
+func (response PostRevokeTrustedDevice200JSONResponse) VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevice400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokeTrustedDevice400JSONResponse) VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevice401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokeTrustedDevice401JSONResponse) VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevice403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeTrustedDevice403JSONResponse) VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevice404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeTrustedDevice404JSONResponse) VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevice429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeTrustedDevice429JSONResponse) VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevice500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeTrustedDevice500JSONResponse) VisitPostRevokeTrustedDeviceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevicesRequestObject struct {
+	Body *PostRevokeTrustedDevicesJSONRequestBody
+}
+
+type PostRevokeTrustedDevicesResponseObject interface {
+	VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error
+}
+
+type PostRevokeTrustedDevices200JSONResponse struct {
+	// Status Indicates if all trusted devices were revoked successfully
+	Status bool `json:"status"`
+}
+
+func (response PostRevokeTrustedDevices200JSONResponse) VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevices400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokeTrustedDevices400JSONResponse) VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevices401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostRevokeTrustedDevices401JSONResponse) VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevices403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeTrustedDevices403JSONResponse) VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevices404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeTrustedDevices404JSONResponse) VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevices429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeTrustedDevices429JSONResponse) VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeTrustedDevices500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostRevokeTrustedDevices500JSONResponse) VisitPostRevokeTrustedDevicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SendVerificationEmailRequestObject struct {
+	Body *SendVerificationEmailJSONRequestBody
+}
+
+type SendVerificationEmailResponseObject interface {
+	VisitSendVerificationEmailResponse(ctx *fiber.Ctx) error
+}
+
+type SendVerificationEmail200JSONResponse struct {
+	// Status Indicates if the email was sent successfully
+	Status *bool `json:"status,omitempty"`
+}
+
+func (response SendVerificationEmail200JSONResponse) VisitSendVerificationEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type SendVerificationEmail400JSONResponse struct {
+	// Message Error message
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SendVerificationEmail400JSONResponse) VisitSendVerificationEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SendVerificationEmail401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response SendVerificationEmail401JSONResponse) VisitSendVerificationEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SendVerificationEmail403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SendVerificationEmail403JSONResponse) VisitSendVerificationEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type SendVerificationEmail404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SendVerificationEmail404JSONResponse) VisitSendVerificationEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type SendVerificationEmail429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SendVerificationEmail429JSONResponse) VisitSendVerificationEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type SendVerificationEmail500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SendVerificationEmail500JSONResponse) VisitSendVerificationEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SignInEmailRequestObject struct {
+	Body *SignInEmailJSONRequestBody
+}
+
+type SignInEmailResponseObject interface {
+	VisitSignInEmailResponse(ctx *fiber.Ctx) error
+}
+
+type SignInEmail200JSONResponse struct {
+	Redirect bool `json:"redirect"`
+
+	// Token Session token
+	Token string  `json:"token"`
+	Url   *string `json:"url"`
+	User  User    `json:"user"`
+}
+
+func (response SignInEmail200JSONResponse) VisitSignInEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type SignInEmail400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response SignInEmail400JSONResponse) VisitSignInEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SignInEmail401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response SignInEmail401JSONResponse) VisitSignInEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SignInEmail403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SignInEmail403JSONResponse) VisitSignInEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type SignInEmail404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SignInEmail404JSONResponse) VisitSignInEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type SignInEmail429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SignInEmail429JSONResponse) VisitSignInEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type SignInEmail500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SignInEmail500JSONResponse) VisitSignInEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SocialSignInRequestObject struct {
+	Body *SocialSignInJSONRequestBody
+}
+
+type SocialSignInResponseObject interface {
+	VisitSocialSignInResponse(ctx *fiber.Ctx) error
+}
+
+type SocialSignIn200JSONResponse struct {
+	Redirect bool   `json:"redirect"`
+	Token    string `json:"token"`
+
+	// TwoFactorPending The session is pending until the second factor is verified
+	TwoFactorPending *bool   `json:"twoFactorPending,omitempty"`
+	Url              *string `json:"url,omitempty"`
+	User             User    `json:"user"`
+}
+
+func (response SocialSignIn200JSONResponse) VisitSocialSignInResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type SocialSignIn400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response SocialSignIn400JSONResponse) VisitSocialSignInResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SocialSignIn401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response SocialSignIn401JSONResponse) VisitSocialSignInResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SocialSignIn403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SocialSignIn403JSONResponse) VisitSocialSignInResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type SocialSignIn404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SocialSignIn404JSONResponse) VisitSocialSignInResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type SocialSignIn429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SocialSignIn429JSONResponse) VisitSocialSignInResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type SocialSignIn500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SocialSignIn500JSONResponse) VisitSocialSignInResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type CreateSocialSignInNonceRequestObject struct {
+	Body *CreateSocialSignInNonceJSONRequestBody
+}
+
+type CreateSocialSignInNonceResponseObject interface {
+	VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error
+}
+
+type CreateSocialSignInNonce200JSONResponse struct {
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Nonce Nonce to request the ID token with
+	Nonce string `json:"nonce"`
+}
+
+func (response CreateSocialSignInNonce200JSONResponse) VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type CreateSocialSignInNonce400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response CreateSocialSignInNonce400JSONResponse) VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateSocialSignInNonce401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response CreateSocialSignInNonce401JSONResponse) VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreateSocialSignInNonce403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreateSocialSignInNonce403JSONResponse) VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type CreateSocialSignInNonce404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreateSocialSignInNonce404JSONResponse) VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type CreateSocialSignInNonce429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreateSocialSignInNonce429JSONResponse) VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type CreateSocialSignInNonce500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response CreateSocialSignInNonce500JSONResponse) VisitCreateSocialSignInNonceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SignOutRequestObject struct {
+	Body *SignOutJSONRequestBody
+}
+
+type SignOutResponseObject interface {
+	VisitSignOutResponse(ctx *fiber.Ctx) error
+}
+
+type SignOut200JSONResponse struct {
+	Success *bool `json:"success,omitempty"`
+}
+
+func (response SignOut200JSONResponse) VisitSignOutResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type SignOut400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response SignOut400JSONResponse) VisitSignOutResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SignOut401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response SignOut401JSONResponse) VisitSignOutResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SignOut403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SignOut403JSONResponse) VisitSignOutResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type SignOut404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SignOut404JSONResponse) VisitSignOutResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type SignOut429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SignOut429JSONResponse) VisitSignOutResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type SignOut500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SignOut500JSONResponse) VisitSignOutResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SignUpWithEmailAndPasswordRequestObject struct {
+	Body *SignUpWithEmailAndPasswordJSONRequestBody
+}
+
+type SignUpWithEmailAndPasswordResponseObject interface {
+	VisitSignUpWithEmailAndPasswordResponse(ctx *fiber.Ctx) error
+}
+
+type SignUpWithEmailAndPassword200JSONResponse struct {
+	// Token Authentication token for the session
+	Token *string `json:"token"`
+	User  struct {
+		// CreatedAt When the user was created
+		CreatedAt time.Time `json:"createdAt"`
+
+		// Email The email address of the user
+		Email openapi_types.Email `json:"email"`
+
+		// EmailVerified Whether the email has been verified
+		EmailVerified bool `json:"emailVerified"`
+
+		// Id The unique identifier of the user
+		Id string `json:"id"`
+
+		// Image The profile image URL of the user
+		Image *string `json:"image"`
+
+		// Name The name of the user
+		Name string `json:"name"`
+
+		// UpdatedAt When the user was last updated
+		UpdatedAt time.Time `json:"updatedAt"`
+	} `json:"user"`
+}
+
+func (response SignUpWithEmailAndPassword200JSONResponse) VisitSignUpWithEmailAndPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type SignUpWithEmailAndPassword400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response SignUpWithEmailAndPassword400JSONResponse) VisitSignUpWithEmailAndPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SignUpWithEmailAndPassword401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response SignUpWithEmailAndPassword401JSONResponse) VisitSignUpWithEmailAndPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SignUpWithEmailAndPassword403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SignUpWithEmailAndPassword403JSONResponse) VisitSignUpWithEmailAndPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type SignUpWithEmailAndPassword404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SignUpWithEmailAndPassword404JSONResponse) VisitSignUpWithEmailAndPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type SignUpWithEmailAndPassword422JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SignUpWithEmailAndPassword422JSONResponse) VisitSignUpWithEmailAndPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type SignUpWithEmailAndPassword429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SignUpWithEmailAndPassword429JSONResponse) VisitSignUpWithEmailAndPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type SignUpWithEmailAndPassword500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SignUpWithEmailAndPassword500JSONResponse) VisitSignUpWithEmailAndPasswordResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostUnlinkAccountRequestObject struct {
+	Body *PostUnlinkAccountJSONRequestBody
+}
+
+type PostUnlinkAccountResponseObject interface {
+	VisitPostUnlinkAccountResponse(ctx *fiber.Ctx) error
+}
+
+type PostUnlinkAccount200JSONResponse struct {
+	Status *bool `json:"status,omitempty"`
+}
+
+func (response PostUnlinkAccount200JSONResponse) VisitPostUnlinkAccountResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostUnlinkAccount400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostUnlinkAccount400JSONResponse) VisitPostUnlinkAccountResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostUnlinkAccount401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response PostUnlinkAccount401JSONResponse) VisitPostUnlinkAccountResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostUnlinkAccount403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostUnlinkAccount403JSONResponse) VisitPostUnlinkAccountResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type PostUnlinkAccount404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostUnlinkAccount404JSONResponse) VisitPostUnlinkAccountResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostUnlinkAccount429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostUnlinkAccount429JSONResponse) VisitPostUnlinkAccountResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type PostUnlinkAccount500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostUnlinkAccount500JSONResponse) VisitPostUnlinkAccountResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUserRequestObject struct {
+	Body *UpdateUserJSONRequestBody
+}
+
+type UpdateUserResponseObject interface {
+	VisitUpdateUserResponse(ctx *fiber.Ctx) error
+}
+
+type UpdateUser200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response UpdateUser200JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response UpdateUser400JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response UpdateUser401JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response UpdateUser403JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response UpdateUser404JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response UpdateUser429JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response UpdateUser500JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetVerifyEmailRequestObject struct {
+	Params GetVerifyEmailParams
+}
+
+type GetVerifyEmailResponseObject interface {
+	VisitGetVerifyEmailResponse(ctx *fiber.Ctx) error
+}
+
+type GetVerifyEmail200JSONResponse struct {
+	// Status Indicates if the email was verified successfully
+	Status bool `json:"status"`
+	User   User `json:"user"`
+}
+
+func (response GetVerifyEmail200JSONResponse) VisitGetVerifyEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetVerifyEmail400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetVerifyEmail400JSONResponse) VisitGetVerifyEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetVerifyEmail401JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response GetVerifyEmail401JSONResponse) VisitGetVerifyEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetVerifyEmail403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetVerifyEmail403JSONResponse) VisitGetVerifyEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetVerifyEmail404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetVerifyEmail404JSONResponse) VisitGetVerifyEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetVerifyEmail429JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetVerifyEmail429JSONResponse) VisitGetVerifyEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type GetVerifyEmail500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetVerifyEmail500JSONResponse) VisitGetVerifyEmailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /account-info)
+	GetAccountInfo(ctx context.Context, request GetAccountInfoRequestObject) (GetAccountInfoResponseObject, error)
+
+	// (POST /change-email)
+	ChangeEmail(ctx context.Context, request ChangeEmailRequestObject) (ChangeEmailResponseObject, error)
+
+	// (POST /change-password)
+	ChangePassword(ctx context.Context, request ChangePasswordRequestObject) (ChangePasswordResponseObject, error)
+
+	// (POST /create-personal-access-token)
+	CreatePersonalAccessToken(ctx context.Context, request CreatePersonalAccessTokenRequestObject) (CreatePersonalAccessTokenResponseObject, error)
+
+	// (POST /delete-user)
+	DeleteUser(ctx context.Context, request DeleteUserRequestObject) (DeleteUserResponseObject, error)
+
+	// (GET /delete-user/callback)
+	GetDeleteUserCallback(ctx context.Context, request GetDeleteUserCallbackRequestObject) (GetDeleteUserCallbackResponseObject, error)
+
+	// (GET /error)
+	GetError(ctx context.Context, request GetErrorRequestObject) (GetErrorResponseObject, error)
+
+	// (POST /get-access-token)
+	PostGetAccessToken(ctx context.Context, request PostGetAccessTokenRequestObject) (PostGetAccessTokenResponseObject, error)
+
+	// (GET /get-session)
+	GetSession(ctx context.Context, request GetSessionRequestObject) (GetSessionResponseObject, error)
+
+	// (POST /link-social)
+	LinkSocialAccount(ctx context.Context, request LinkSocialAccountRequestObject) (LinkSocialAccountResponseObject, error)
+
+	// (GET /list-accounts)
+	ListUserAccounts(ctx context.Context, request ListUserAccountsRequestObject) (ListUserAccountsResponseObject, error)
+
+	// (GET /list-personal-access-tokens)
+	ListPersonalAccessTokens(ctx context.Context, request ListPersonalAccessTokensRequestObject) (ListPersonalAccessTokensResponseObject, error)
+
+	// (GET /list-sessions)
+	ListUserSessions(ctx context.Context, request ListUserSessionsRequestObject) (ListUserSessionsResponseObject, error)
+
+	// (GET /list-trusted-devices)
+	ListTrustedDevices(ctx context.Context, request ListTrustedDevicesRequestObject) (ListTrustedDevicesResponseObject, error)
+
+	// (GET /ok)
+	GetOk(ctx context.Context, request GetOkRequestObject) (GetOkResponseObject, error)
+
+	// (POST /organization/accept-invitation)
+	PostOrganizationAcceptInvitation(ctx context.Context, request PostOrganizationAcceptInvitationRequestObject) (PostOrganizationAcceptInvitationResponseObject, error)
+
+	// (POST /organization/add-team-member)
+	PostOrganizationAddTeamMember(ctx context.Context, request PostOrganizationAddTeamMemberRequestObject) (PostOrganizationAddTeamMemberResponseObject, error)
+
+	// (POST /organization/cancel-invitation)
+	PostOrganizationCancelInvitation(ctx context.Context, request PostOrganizationCancelInvitationRequestObject) (PostOrganizationCancelInvitationResponseObject, error)
+
+	// (POST /organization/check-slug)
+	PostOrganizationCheckSlug(ctx context.Context, request PostOrganizationCheckSlugRequestObject) (PostOrganizationCheckSlugResponseObject, error)
+
+	// (POST /organization/create)
+	PostOrganizationCreate(ctx context.Context, request PostOrganizationCreateRequestObject) (PostOrganizationCreateResponseObject, error)
+
+	// (POST /organization/create-team)
+	PostOrganizationCreateTeam(ctx context.Context, request PostOrganizationCreateTeamRequestObject) (PostOrganizationCreateTeamResponseObject, error)
+
+	// (POST /organization/delete)
+	PostOrganizationDelete(ctx context.Context, request PostOrganizationDeleteRequestObject) (PostOrganizationDeleteResponseObject, error)
+
+	// (GET /organization/get-active-member)
+	GetOrganizationGetActiveMember(ctx context.Context, request GetOrganizationGetActiveMemberRequestObject) (GetOrganizationGetActiveMemberResponseObject, error)
+
+	// (GET /organization/get-active-member-role)
+	GetOrganizationGetActiveMemberRole(ctx context.Context, request GetOrganizationGetActiveMemberRoleRequestObject) (GetOrganizationGetActiveMemberRoleResponseObject, error)
+
+	// (GET /organization/get-full-organization)
+	GetOrganization(ctx context.Context, request GetOrganizationRequestObject) (GetOrganizationResponseObject, error)
+
+	// (GET /organization/get-invitation)
+	GetOrganizationGetInvitation(ctx context.Context, request GetOrganizationGetInvitationRequestObject) (GetOrganizationGetInvitationResponseObject, error)
+
+	// (POST /organization/has-permission)
+	PostOrganizationHasPermission(ctx context.Context, request PostOrganizationHasPermissionRequestObject) (PostOrganizationHasPermissionResponseObject, error)
+
+	// (POST /organization/invite-member)
+	CreateOrganizationInvitation(ctx context.Context, request CreateOrganizationInvitationRequestObject) (CreateOrganizationInvitationResponseObject, error)
+
+	// (POST /organization/leave)
+	PostOrganizationLeave(ctx context.Context, request PostOrganizationLeaveRequestObject) (PostOrganizationLeaveResponseObject, error)
+
+	// (GET /organization/list)
+	GetOrganizationList(ctx context.Context, request GetOrganizationListRequestObject) (GetOrganizationListResponseObject, error)
+
+	// (GET /organization/list-invitations)
+	GetOrganizationListInvitations(ctx context.Context, request GetOrganizationListInvitationsRequestObject) (GetOrganizationListInvitationsResponseObject, error)
+
+	// (GET /organization/list-members)
+	GetOrganizationListMembers(ctx context.Context, request GetOrganizationListMembersRequestObject) (GetOrganizationListMembersResponseObject, error)
+
+	// (GET /organization/list-team-members)
+	GetOrganizationListTeamMembers(ctx context.Context, request GetOrganizationListTeamMembersRequestObject) (GetOrganizationListTeamMembersResponseObject, error)
+
+	// (GET /organization/list-teams)
+	GetOrganizationListTeams(ctx context.Context, request GetOrganizationListTeamsRequestObject) (GetOrganizationListTeamsResponseObject, error)
+
+	// (GET /organization/list-user-invitations)
+	GetOrganizationListUserInvitations(ctx context.Context, request GetOrganizationListUserInvitationsRequestObject) (GetOrganizationListUserInvitationsResponseObject, error)
+
+	// (GET /organization/list-user-teams)
+	GetOrganizationListUserTeams(ctx context.Context, request GetOrganizationListUserTeamsRequestObject) (GetOrganizationListUserTeamsResponseObject, error)
+
+	// (POST /organization/reject-invitation)
+	PostOrganizationRejectInvitation(ctx context.Context, request PostOrganizationRejectInvitationRequestObject) (PostOrganizationRejectInvitationResponseObject, error)
+
+	// (POST /organization/remove-member)
+	PostOrganizationRemoveMember(ctx context.Context, request PostOrganizationRemoveMemberRequestObject) (PostOrganizationRemoveMemberResponseObject, error)
+
+	// (POST /organization/remove-team)
+	PostOrganizationRemoveTeam(ctx context.Context, request PostOrganizationRemoveTeamRequestObject) (PostOrganizationRemoveTeamResponseObject, error)
+
+	// (POST /organization/remove-team-member)
+	PostOrganizationRemoveTeamMember(ctx context.Context, request PostOrganizationRemoveTeamMemberRequestObject) (PostOrganizationRemoveTeamMemberResponseObject, error)
+
+	// (POST /organization/set-active)
+	SetActiveOrganization(ctx context.Context, request SetActiveOrganizationRequestObject) (SetActiveOrganizationResponseObject, error)
+
+	// (POST /organization/set-active-team)
+	PostOrganizationSetActiveTeam(ctx context.Context, request PostOrganizationSetActiveTeamRequestObject) (PostOrganizationSetActiveTeamResponseObject, error)
+
+	// (POST /organization/update)
+	PostOrganizationUpdate(ctx context.Context, request PostOrganizationUpdateRequestObject) (PostOrganizationUpdateResponseObject, error)
+
+	// (POST /organization/update-member-role)
+	UpdateOrganizationMemberRole(ctx context.Context, request UpdateOrganizationMemberRoleRequestObject) (UpdateOrganizationMemberRoleResponseObject, error)
+
+	// (POST /organization/update-team)
+	PostOrganizationUpdateTeam(ctx context.Context, request PostOrganizationUpdateTeamRequestObject) (PostOrganizationUpdateTeamResponseObject, error)
+
+	// (POST /refresh-token)
+	PostRefreshToken(ctx context.Context, request PostRefreshTokenRequestObject) (PostRefreshTokenResponseObject, error)
+
+	// (POST /request-password-reset)
+	RequestPasswordReset(ctx context.Context, request RequestPasswordResetRequestObject) (RequestPasswordResetResponseObject, error)
+
+	// (POST /reset-password)
+	ResetPassword(ctx context.Context, request ResetPasswordRequestObject) (ResetPasswordResponseObject, error)
+
+	// (GET /reset-password/{token})
+	ResetPasswordCallback(ctx context.Context, request ResetPasswordCallbackRequestObject) (ResetPasswordCallbackResponseObject, error)
+
+	// (POST /revoke-other-sessions)
+	PostRevokeOtherSessions(ctx context.Context, request PostRevokeOtherSessionsRequestObject) (PostRevokeOtherSessionsResponseObject, error)
+
+	// (POST /revoke-personal-access-token)
+	PostRevokePersonalAccessToken(ctx context.Context, request PostRevokePersonalAccessTokenRequestObject) (PostRevokePersonalAccessTokenResponseObject, error)
+
+	// (POST /revoke-session)
+	PostRevokeSession(ctx context.Context, request PostRevokeSessionRequestObject) (PostRevokeSessionResponseObject, error)
+
+	// (POST /revoke-sessions)
+	PostRevokeSessions(ctx context.Context, request PostRevokeSessionsRequestObject) (PostRevokeSessionsResponseObject, error)
+
+	// (POST /revoke-trusted-device)
+	PostRevokeTrustedDevice(ctx context.Context, request PostRevokeTrustedDeviceRequestObject) (PostRevokeTrustedDeviceResponseObject, error)
+
+	// (POST /revoke-trusted-devices)
+	PostRevokeTrustedDevices(ctx context.Context, request PostRevokeTrustedDevicesRequestObject) (PostRevokeTrustedDevicesResponseObject, error)
+
+	// (POST /send-verification-email)
+	SendVerificationEmail(ctx context.Context, request SendVerificationEmailRequestObject) (SendVerificationEmailResponseObject, error)
+
+	// (POST /sign-in/email)
+	SignInEmail(ctx context.Context, request SignInEmailRequestObject) (SignInEmailResponseObject, error)
+
+	// (POST /sign-in/social)
+	SocialSignIn(ctx context.Context, request SocialSignInRequestObject) (SocialSignInResponseObject, error)
+
+	// (POST /sign-in/social/nonce)
+	CreateSocialSignInNonce(ctx context.Context, request CreateSocialSignInNonceRequestObject) (CreateSocialSignInNonceResponseObject, error)
+
+	// (POST /sign-out)
+	SignOut(ctx context.Context, request SignOutRequestObject) (SignOutResponseObject, error)
+
+	// (POST /sign-up/email)
+	SignUpWithEmailAndPassword(ctx context.Context, request SignUpWithEmailAndPasswordRequestObject) (SignUpWithEmailAndPasswordResponseObject, error)
+
+	// (POST /unlink-account)
+	PostUnlinkAccount(ctx context.Context, request PostUnlinkAccountRequestObject) (PostUnlinkAccountResponseObject, error)
+
+	// (POST /update-user)
+	UpdateUser(ctx context.Context, request UpdateUserRequestObject) (UpdateUserResponseObject, error)
+
+	// (GET /verify-email)
+	GetVerifyEmail(ctx context.Context, request GetVerifyEmailRequestObject) (GetVerifyEmailResponseObject, error)
+}
+
+type StrictHandlerFunc func(ctx *fiber.Ctx, args interface{}) (interface{}, error)
+
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAccountInfo operation middleware
+func (sh *strictHandler) GetAccountInfo(ctx *fiber.Ctx) error {
+	var request GetAccountInfoRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAccountInfo(ctx.UserContext(), request.(GetAccountInfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAccountInfo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetAccountInfoResponseObject); ok {
+		if err := validResponse.VisitGetAccountInfoResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ChangeEmail operation middleware
+func (sh *strictHandler) ChangeEmail(ctx *fiber.Ctx) error {
+	var request ChangeEmailRequestObject
+
+	var body ChangeEmailJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangeEmail(ctx.UserContext(), request.(ChangeEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangeEmail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ChangeEmailResponseObject); ok {
+		if err := validResponse.VisitChangeEmailResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ChangePassword operation middleware
+func (sh *strictHandler) ChangePassword(ctx *fiber.Ctx) error {
+	var request ChangePasswordRequestObject
+
+	var body ChangePasswordJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangePassword(ctx.UserContext(), request.(ChangePasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangePassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ChangePasswordResponseObject); ok {
+		if err := validResponse.VisitChangePasswordResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreatePersonalAccessToken operation middleware
+func (sh *strictHandler) CreatePersonalAccessToken(ctx *fiber.Ctx) error {
+	var request CreatePersonalAccessTokenRequestObject
+
+	var body CreatePersonalAccessTokenJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePersonalAccessToken(ctx.UserContext(), request.(CreatePersonalAccessTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePersonalAccessToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreatePersonalAccessTokenResponseObject); ok {
+		if err := validResponse.VisitCreatePersonalAccessTokenResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteUser operation middleware
+func (sh *strictHandler) DeleteUser(ctx *fiber.Ctx) error {
+	var request DeleteUserRequestObject
+
+	var body DeleteUserJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUser(ctx.UserContext(), request.(DeleteUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(DeleteUserResponseObject); ok {
+		if err := validResponse.VisitDeleteUserResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetDeleteUserCallback operation middleware
+func (sh *strictHandler) GetDeleteUserCallback(ctx *fiber.Ctx, params GetDeleteUserCallbackParams) error {
+	var request GetDeleteUserCallbackRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDeleteUserCallback(ctx.UserContext(), request.(GetDeleteUserCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDeleteUserCallback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetDeleteUserCallbackResponseObject); ok {
+		if err := validResponse.VisitGetDeleteUserCallbackResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetError operation middleware
+func (sh *strictHandler) GetError(ctx *fiber.Ctx) error {
+	var request GetErrorRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetError(ctx.UserContext(), request.(GetErrorRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetError")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetErrorResponseObject); ok {
+		if err := validResponse.VisitGetErrorResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostGetAccessToken operation middleware
+func (sh *strictHandler) PostGetAccessToken(ctx *fiber.Ctx) error {
+	var request PostGetAccessTokenRequestObject
+
+	var body PostGetAccessTokenJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostGetAccessToken(ctx.UserContext(), request.(PostGetAccessTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostGetAccessToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostGetAccessTokenResponseObject); ok {
+		if err := validResponse.VisitPostGetAccessTokenResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetSession operation middleware
+func (sh *strictHandler) GetSession(ctx *fiber.Ctx) error {
+	var request GetSessionRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSession(ctx.UserContext(), request.(GetSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSession")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetSessionResponseObject); ok {
+		if err := validResponse.VisitGetSessionResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// LinkSocialAccount operation middleware
+func (sh *strictHandler) LinkSocialAccount(ctx *fiber.Ctx) error {
+	var request LinkSocialAccountRequestObject
+
+	var body LinkSocialAccountJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.LinkSocialAccount(ctx.UserContext(), request.(LinkSocialAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LinkSocialAccount")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(LinkSocialAccountResponseObject); ok {
+		if err := validResponse.VisitLinkSocialAccountResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListUserAccounts operation middleware
+func (sh *strictHandler) ListUserAccounts(ctx *fiber.Ctx) error {
+	var request ListUserAccountsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUserAccounts(ctx.UserContext(), request.(ListUserAccountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUserAccounts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListUserAccountsResponseObject); ok {
+		if err := validResponse.VisitListUserAccountsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListPersonalAccessTokens operation middleware
+func (sh *strictHandler) ListPersonalAccessTokens(ctx *fiber.Ctx) error {
+	var request ListPersonalAccessTokensRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPersonalAccessTokens(ctx.UserContext(), request.(ListPersonalAccessTokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPersonalAccessTokens")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListPersonalAccessTokensResponseObject); ok {
+		if err := validResponse.VisitListPersonalAccessTokensResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListUserSessions operation middleware
+func (sh *strictHandler) ListUserSessions(ctx *fiber.Ctx) error {
+	var request ListUserSessionsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUserSessions(ctx.UserContext(), request.(ListUserSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUserSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListUserSessionsResponseObject); ok {
+		if err := validResponse.VisitListUserSessionsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListTrustedDevices operation middleware
+func (sh *strictHandler) ListTrustedDevices(ctx *fiber.Ctx) error {
+	var request ListTrustedDevicesRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTrustedDevices(ctx.UserContext(), request.(ListTrustedDevicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTrustedDevices")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListTrustedDevicesResponseObject); ok {
+		if err := validResponse.VisitListTrustedDevicesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOk operation middleware
+func (sh *strictHandler) GetOk(ctx *fiber.Ctx) error {
+	var request GetOkRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOk(ctx.UserContext(), request.(GetOkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOk")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetOkResponseObject); ok {
+		if err := validResponse.VisitGetOkResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationAcceptInvitation operation middleware
+func (sh *strictHandler) PostOrganizationAcceptInvitation(ctx *fiber.Ctx) error {
+	var request PostOrganizationAcceptInvitationRequestObject
+
+	var body PostOrganizationAcceptInvitationJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationAcceptInvitation(ctx.UserContext(), request.(PostOrganizationAcceptInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationAcceptInvitation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationAcceptInvitationResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationAcceptInvitationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationAddTeamMember operation middleware
+func (sh *strictHandler) PostOrganizationAddTeamMember(ctx *fiber.Ctx) error {
+	var request PostOrganizationAddTeamMemberRequestObject
+
+	var body PostOrganizationAddTeamMemberJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationAddTeamMember(ctx.UserContext(), request.(PostOrganizationAddTeamMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationAddTeamMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationAddTeamMemberResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationAddTeamMemberResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationCancelInvitation operation middleware
+func (sh *strictHandler) PostOrganizationCancelInvitation(ctx *fiber.Ctx) error {
+	var request PostOrganizationCancelInvitationRequestObject
+
+	var body PostOrganizationCancelInvitationJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationCancelInvitation(ctx.UserContext(), request.(PostOrganizationCancelInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationCancelInvitation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationCancelInvitationResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationCancelInvitationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationCheckSlug operation middleware
+func (sh *strictHandler) PostOrganizationCheckSlug(ctx *fiber.Ctx) error {
+	var request PostOrganizationCheckSlugRequestObject
+
+	var body PostOrganizationCheckSlugJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationCheckSlug(ctx.UserContext(), request.(PostOrganizationCheckSlugRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationCheckSlug")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationCheckSlugResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationCheckSlugResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationCreate operation middleware
+func (sh *strictHandler) PostOrganizationCreate(ctx *fiber.Ctx) error {
+	var request PostOrganizationCreateRequestObject
+
+	var body PostOrganizationCreateJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationCreate(ctx.UserContext(), request.(PostOrganizationCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationCreateResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationCreateResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationCreateTeam operation middleware
+func (sh *strictHandler) PostOrganizationCreateTeam(ctx *fiber.Ctx) error {
+	var request PostOrganizationCreateTeamRequestObject
+
+	var body PostOrganizationCreateTeamJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationCreateTeam(ctx.UserContext(), request.(PostOrganizationCreateTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationCreateTeam")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationCreateTeamResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationCreateTeamResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationDelete operation middleware
+func (sh *strictHandler) PostOrganizationDelete(ctx *fiber.Ctx) error {
+	var request PostOrganizationDeleteRequestObject
+
+	var body PostOrganizationDeleteJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationDelete(ctx.UserContext(), request.(PostOrganizationDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationDeleteResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationDeleteResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrganizationGetActiveMember operation middleware
+func (sh *strictHandler) GetOrganizationGetActiveMember(ctx *fiber.Ctx) error {
+	var request GetOrganizationGetActiveMemberRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizationGetActiveMember(ctx.UserContext(), request.(GetOrganizationGetActiveMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizationGetActiveMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetOrganizationGetActiveMemberResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationGetActiveMemberResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrganizationGetActiveMemberRole operation middleware
+func (sh *strictHandler) GetOrganizationGetActiveMemberRole(ctx *fiber.Ctx) error {
+	var request GetOrganizationGetActiveMemberRoleRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizationGetActiveMemberRole(ctx.UserContext(), request.(GetOrganizationGetActiveMemberRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizationGetActiveMemberRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetOrganizationGetActiveMemberRoleResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationGetActiveMemberRoleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrganization operation middleware
+func (sh *strictHandler) GetOrganization(ctx *fiber.Ctx, params GetOrganizationParams) error {
+	var request GetOrganizationRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganization(ctx.UserContext(), request.(GetOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganization")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetOrganizationResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrganizationGetInvitation operation middleware
+func (sh *strictHandler) GetOrganizationGetInvitation(ctx *fiber.Ctx, params GetOrganizationGetInvitationParams) error {
+	var request GetOrganizationGetInvitationRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizationGetInvitation(ctx.UserContext(), request.(GetOrganizationGetInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizationGetInvitation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetOrganizationGetInvitationResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationGetInvitationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationHasPermission operation middleware
+func (sh *strictHandler) PostOrganizationHasPermission(ctx *fiber.Ctx) error {
+	var request PostOrganizationHasPermissionRequestObject
+
+	var body PostOrganizationHasPermissionJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationHasPermission(ctx.UserContext(), request.(PostOrganizationHasPermissionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationHasPermission")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationHasPermissionResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationHasPermissionResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateOrganizationInvitation operation middleware
+func (sh *strictHandler) CreateOrganizationInvitation(ctx *fiber.Ctx) error {
+	var request CreateOrganizationInvitationRequestObject
+
+	var body CreateOrganizationInvitationJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOrganizationInvitation(ctx.UserContext(), request.(CreateOrganizationInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOrganizationInvitation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreateOrganizationInvitationResponseObject); ok {
+		if err := validResponse.VisitCreateOrganizationInvitationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationLeave operation middleware
+func (sh *strictHandler) PostOrganizationLeave(ctx *fiber.Ctx) error {
+	var request PostOrganizationLeaveRequestObject
+
+	var body PostOrganizationLeaveJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationLeave(ctx.UserContext(), request.(PostOrganizationLeaveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationLeave")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationLeaveResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationLeaveResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrganizationList operation middleware
+func (sh *strictHandler) GetOrganizationList(ctx *fiber.Ctx) error {
+	var request GetOrganizationListRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizationList(ctx.UserContext(), request.(GetOrganizationListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizationList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetOrganizationListResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationListResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrganizationListInvitations operation middleware
+func (sh *strictHandler) GetOrganizationListInvitations(ctx *fiber.Ctx, params GetOrganizationListInvitationsParams) error {
+	var request GetOrganizationListInvitationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizationListInvitations(ctx.UserContext(), request.(GetOrganizationListInvitationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizationListInvitations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetOrganizationListInvitationsResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationListInvitationsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrganizationListMembers operation middleware
+func (sh *strictHandler) GetOrganizationListMembers(ctx *fiber.Ctx) error {
+	var request GetOrganizationListMembersRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizationListMembers(ctx.UserContext(), request.(GetOrganizationListMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizationListMembers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetOrganizationListMembersResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationListMembersResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrganizationListTeamMembers operation middleware
+func (sh *strictHandler) GetOrganizationListTeamMembers(ctx *fiber.Ctx, params GetOrganizationListTeamMembersParams) error {
+	var request GetOrganizationListTeamMembersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizationListTeamMembers(ctx.UserContext(), request.(GetOrganizationListTeamMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizationListTeamMembers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetOrganizationListTeamMembersResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationListTeamMembersResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrganizationListTeams operation middleware
+func (sh *strictHandler) GetOrganizationListTeams(ctx *fiber.Ctx, params GetOrganizationListTeamsParams) error {
+	var request GetOrganizationListTeamsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizationListTeams(ctx.UserContext(), request.(GetOrganizationListTeamsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizationListTeams")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetOrganizationListTeamsResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationListTeamsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrganizationListUserInvitations operation middleware
+func (sh *strictHandler) GetOrganizationListUserInvitations(ctx *fiber.Ctx) error {
+	var request GetOrganizationListUserInvitationsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizationListUserInvitations(ctx.UserContext(), request.(GetOrganizationListUserInvitationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizationListUserInvitations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetOrganizationListUserInvitationsResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationListUserInvitationsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrganizationListUserTeams operation middleware
+func (sh *strictHandler) GetOrganizationListUserTeams(ctx *fiber.Ctx) error {
+	var request GetOrganizationListUserTeamsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizationListUserTeams(ctx.UserContext(), request.(GetOrganizationListUserTeamsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizationListUserTeams")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetOrganizationListUserTeamsResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationListUserTeamsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationRejectInvitation operation middleware
+func (sh *strictHandler) PostOrganizationRejectInvitation(ctx *fiber.Ctx) error {
+	var request PostOrganizationRejectInvitationRequestObject
+
+	var body PostOrganizationRejectInvitationJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationRejectInvitation(ctx.UserContext(), request.(PostOrganizationRejectInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationRejectInvitation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationRejectInvitationResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationRejectInvitationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationRemoveMember operation middleware
+func (sh *strictHandler) PostOrganizationRemoveMember(ctx *fiber.Ctx) error {
+	var request PostOrganizationRemoveMemberRequestObject
+
+	var body PostOrganizationRemoveMemberJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationRemoveMember(ctx.UserContext(), request.(PostOrganizationRemoveMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationRemoveMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationRemoveMemberResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationRemoveMemberResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationRemoveTeam operation middleware
+func (sh *strictHandler) PostOrganizationRemoveTeam(ctx *fiber.Ctx) error {
+	var request PostOrganizationRemoveTeamRequestObject
+
+	var body PostOrganizationRemoveTeamJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationRemoveTeam(ctx.UserContext(), request.(PostOrganizationRemoveTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationRemoveTeam")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationRemoveTeamResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationRemoveTeamResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationRemoveTeamMember operation middleware
+func (sh *strictHandler) PostOrganizationRemoveTeamMember(ctx *fiber.Ctx) error {
+	var request PostOrganizationRemoveTeamMemberRequestObject
+
+	var body PostOrganizationRemoveTeamMemberJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationRemoveTeamMember(ctx.UserContext(), request.(PostOrganizationRemoveTeamMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationRemoveTeamMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationRemoveTeamMemberResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationRemoveTeamMemberResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetActiveOrganization operation middleware
+func (sh *strictHandler) SetActiveOrganization(ctx *fiber.Ctx) error {
+	var request SetActiveOrganizationRequestObject
+
+	var body SetActiveOrganizationJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SetActiveOrganization(ctx.UserContext(), request.(SetActiveOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetActiveOrganization")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(SetActiveOrganizationResponseObject); ok {
+		if err := validResponse.VisitSetActiveOrganizationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationSetActiveTeam operation middleware
+func (sh *strictHandler) PostOrganizationSetActiveTeam(ctx *fiber.Ctx) error {
+	var request PostOrganizationSetActiveTeamRequestObject
+
+	var body PostOrganizationSetActiveTeamJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationSetActiveTeam(ctx.UserContext(), request.(PostOrganizationSetActiveTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationSetActiveTeam")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationSetActiveTeamResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationSetActiveTeamResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationUpdate operation middleware
+func (sh *strictHandler) PostOrganizationUpdate(ctx *fiber.Ctx) error {
+	var request PostOrganizationUpdateRequestObject
+
+	var body PostOrganizationUpdateJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationUpdate(ctx.UserContext(), request.(PostOrganizationUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationUpdateResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationUpdateResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateOrganizationMemberRole operation middleware
+func (sh *strictHandler) UpdateOrganizationMemberRole(ctx *fiber.Ctx) error {
+	var request UpdateOrganizationMemberRoleRequestObject
+
+	var body UpdateOrganizationMemberRoleJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateOrganizationMemberRole(ctx.UserContext(), request.(UpdateOrganizationMemberRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateOrganizationMemberRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(UpdateOrganizationMemberRoleResponseObject); ok {
+		if err := validResponse.VisitUpdateOrganizationMemberRoleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrganizationUpdateTeam operation middleware
+func (sh *strictHandler) PostOrganizationUpdateTeam(ctx *fiber.Ctx) error {
+	var request PostOrganizationUpdateTeamRequestObject
+
+	var body PostOrganizationUpdateTeamJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationUpdateTeam(ctx.UserContext(), request.(PostOrganizationUpdateTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationUpdateTeam")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostOrganizationUpdateTeamResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationUpdateTeamResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostRefreshToken operation middleware
+func (sh *strictHandler) PostRefreshToken(ctx *fiber.Ctx) error {
+	var request PostRefreshTokenRequestObject
+
+	var body PostRefreshTokenJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRefreshToken(ctx.UserContext(), request.(PostRefreshTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRefreshToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostRefreshTokenResponseObject); ok {
+		if err := validResponse.VisitPostRefreshTokenResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RequestPasswordReset operation middleware
+func (sh *strictHandler) RequestPasswordReset(ctx *fiber.Ctx) error {
+	var request RequestPasswordResetRequestObject
+
+	var body RequestPasswordResetJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestPasswordReset(ctx.UserContext(), request.(RequestPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestPasswordReset")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(RequestPasswordResetResponseObject); ok {
+		if err := validResponse.VisitRequestPasswordResetResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ResetPassword operation middleware
+func (sh *strictHandler) ResetPassword(ctx *fiber.Ctx) error {
+	var request ResetPasswordRequestObject
+
+	var body ResetPasswordJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ResetPassword(ctx.UserContext(), request.(ResetPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResetPassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ResetPasswordResponseObject); ok {
+		if err := validResponse.VisitResetPasswordResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ResetPasswordCallback operation middleware
+func (sh *strictHandler) ResetPasswordCallback(ctx *fiber.Ctx, token string, params ResetPasswordCallbackParams) error {
+	var request ResetPasswordCallbackRequestObject
+
+	request.Token = token
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ResetPasswordCallback(ctx.UserContext(), request.(ResetPasswordCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResetPasswordCallback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ResetPasswordCallbackResponseObject); ok {
+		if err := validResponse.VisitResetPasswordCallbackResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostRevokeOtherSessions operation middleware
+func (sh *strictHandler) PostRevokeOtherSessions(ctx *fiber.Ctx) error {
+	var request PostRevokeOtherSessionsRequestObject
+
+	var body PostRevokeOtherSessionsJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRevokeOtherSessions(ctx.UserContext(), request.(PostRevokeOtherSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRevokeOtherSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostRevokeOtherSessionsResponseObject); ok {
+		if err := validResponse.VisitPostRevokeOtherSessionsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostRevokePersonalAccessToken operation middleware
+func (sh *strictHandler) PostRevokePersonalAccessToken(ctx *fiber.Ctx) error {
+	var request PostRevokePersonalAccessTokenRequestObject
+
+	var body PostRevokePersonalAccessTokenJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRevokePersonalAccessToken(ctx.UserContext(), request.(PostRevokePersonalAccessTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRevokePersonalAccessToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostRevokePersonalAccessTokenResponseObject); ok {
+		if err := validResponse.VisitPostRevokePersonalAccessTokenResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostRevokeSession operation middleware
+func (sh *strictHandler) PostRevokeSession(ctx *fiber.Ctx) error {
+	var request PostRevokeSessionRequestObject
+
+	var body PostRevokeSessionJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRevokeSession(ctx.UserContext(), request.(PostRevokeSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRevokeSession")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostRevokeSessionResponseObject); ok {
+		if err := validResponse.VisitPostRevokeSessionResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostRevokeSessions operation middleware
+func (sh *strictHandler) PostRevokeSessions(ctx *fiber.Ctx) error {
+	var request PostRevokeSessionsRequestObject
+
+	var body PostRevokeSessionsJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRevokeSessions(ctx.UserContext(), request.(PostRevokeSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRevokeSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostRevokeSessionsResponseObject); ok {
+		if err := validResponse.VisitPostRevokeSessionsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostRevokeTrustedDevice operation middleware
+func (sh *strictHandler) PostRevokeTrustedDevice(ctx *fiber.Ctx) error {
+	var request PostRevokeTrustedDeviceRequestObject
+
+	var body PostRevokeTrustedDeviceJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRevokeTrustedDevice(ctx.UserContext(), request.(PostRevokeTrustedDeviceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRevokeTrustedDevice")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostRevokeTrustedDeviceResponseObject); ok {
+		if err := validResponse.VisitPostRevokeTrustedDeviceResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostRevokeTrustedDevices operation middleware
+func (sh *strictHandler) PostRevokeTrustedDevices(ctx *fiber.Ctx) error {
+	var request PostRevokeTrustedDevicesRequestObject
+
+	var body PostRevokeTrustedDevicesJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRevokeTrustedDevices(ctx.UserContext(), request.(PostRevokeTrustedDevicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRevokeTrustedDevices")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostRevokeTrustedDevicesResponseObject); ok {
+		if err := validResponse.VisitPostRevokeTrustedDevicesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SendVerificationEmail operation middleware
+func (sh *strictHandler) SendVerificationEmail(ctx *fiber.Ctx) error {
+	var request SendVerificationEmailRequestObject
+
+	var body SendVerificationEmailJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SendVerificationEmail(ctx.UserContext(), request.(SendVerificationEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SendVerificationEmail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(SendVerificationEmailResponseObject); ok {
+		if err := validResponse.VisitSendVerificationEmailResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SignInEmail operation middleware
+func (sh *strictHandler) SignInEmail(ctx *fiber.Ctx) error {
+	var request SignInEmailRequestObject
+
+	var body SignInEmailJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SignInEmail(ctx.UserContext(), request.(SignInEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SignInEmail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(SignInEmailResponseObject); ok {
+		if err := validResponse.VisitSignInEmailResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SocialSignIn operation middleware
+func (sh *strictHandler) SocialSignIn(ctx *fiber.Ctx) error {
+	var request SocialSignInRequestObject
+
+	var body SocialSignInJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SocialSignIn(ctx.UserContext(), request.(SocialSignInRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SocialSignIn")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(SocialSignInResponseObject); ok {
+		if err := validResponse.VisitSocialSignInResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateSocialSignInNonce operation middleware
+func (sh *strictHandler) CreateSocialSignInNonce(ctx *fiber.Ctx) error {
+	var request CreateSocialSignInNonceRequestObject
+
+	var body CreateSocialSignInNonceJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateSocialSignInNonce(ctx.UserContext(), request.(CreateSocialSignInNonceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateSocialSignInNonce")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreateSocialSignInNonceResponseObject); ok {
+		if err := validResponse.VisitCreateSocialSignInNonceResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SignOut operation middleware
+func (sh *strictHandler) SignOut(ctx *fiber.Ctx) error {
+	var request SignOutRequestObject
+
+	var body SignOutJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SignOut(ctx.UserContext(), request.(SignOutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SignOut")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(SignOutResponseObject); ok {
+		if err := validResponse.VisitSignOutResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SignUpWithEmailAndPassword operation middleware
+func (sh *strictHandler) SignUpWithEmailAndPassword(ctx *fiber.Ctx) error {
+	var request SignUpWithEmailAndPasswordRequestObject
+
+	var body SignUpWithEmailAndPasswordJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SignUpWithEmailAndPassword(ctx.UserContext(), request.(SignUpWithEmailAndPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SignUpWithEmailAndPassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(SignUpWithEmailAndPasswordResponseObject); ok {
+		if err := validResponse.VisitSignUpWithEmailAndPasswordResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostUnlinkAccount operation middleware
+func (sh *strictHandler) PostUnlinkAccount(ctx *fiber.Ctx) error {
+	var request PostUnlinkAccountRequestObject
+
+	var body PostUnlinkAccountJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUnlinkAccount(ctx.UserContext(), request.(PostUnlinkAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUnlinkAccount")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostUnlinkAccountResponseObject); ok {
+		if err := validResponse.VisitPostUnlinkAccountResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateUser operation middleware
+func (sh *strictHandler) UpdateUser(ctx *fiber.Ctx) error {
+	var request UpdateUserRequestObject
+
+	var body UpdateUserJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateUser(ctx.UserContext(), request.(UpdateUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(UpdateUserResponseObject); ok {
+		if err := validResponse.VisitUpdateUserResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetVerifyEmail operation middleware
+func (sh *strictHandler) GetVerifyEmail(ctx *fiber.Ctx, params GetVerifyEmailParams) error {
+	var request GetVerifyEmailRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVerifyEmail(ctx.UserContext(), request.(GetVerifyEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVerifyEmail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetVerifyEmailResponseObject); ok {
+		if err := validResponse.VisitGetVerifyEmailResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/katallaxie/fiber-goth/v3/pkg/apis"
+	"github.com/katallaxie/pkg/utilx"
+)
+
+// Account is an account of a provider that is linked to the signed-in user.
+type Account struct {
+	AccountId  string    `json:"accountId"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Id         string    `json:"id"`
+	ProviderId string    `json:"providerId"`
+	Scopes     []string  `json:"scopes"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	UserId     string    `json:"userId"`
+}
+
+// AccountsService manages the linked accounts of the signed-in user.
+type AccountsService struct {
+	c *Client
+}
+
+// List returns the accounts of the signed-in user.
+func (s *AccountsService) List(ctx context.Context) ([]Account, error) {
+	res, err := s.c.api.ListUserAccountsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := result(res.StatusCode(), res.Body, res.JSON200)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]Account, len(body))
+	for i, a := range body {
+		accounts[i] = Account(a)
+	}
+
+	return accounts, nil
+}
+
+// Unlink unlinks the account of the provider from the signed-in user.
+// The account ID is optional and selects one of several accounts of the provider.
+func (s *AccountsService) Unlink(ctx context.Context, providerID, accountID string) error {
+	body := apis.PostUnlinkAccountJSONRequestBody{ProviderId: providerID}
+	if utilx.NotEmpty(accountID) {
+		body.AccountId = &accountID
+	}
+
+	res, err := s.c.api.PostUnlinkAccountWithResponse(ctx, body)
+	if err != nil {
+		return err
+	}
+
+	_, err = result(res.StatusCode(), res.Body, res.JSON200)
+
+	return err
+}
@@ -0,0 +1,356 @@
+// Package client provides a client of the auth REST API.
+//
+// The client keeps the session cookie and the CSRF token of the signed-in user
+// and retries requests once if the CSRF token has been rotated.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"sync"
+
+	"github.com/katallaxie/fiber-goth/v3/pkg/apis"
+	"github.com/katallaxie/pkg/cast"
+	"github.com/katallaxie/pkg/utilx"
+)
+
+const (
+	// DefaultCSRFHeader is the default header of the CSRF token.
+	DefaultCSRFHeader = "X-Csrf-Token"
+	// DefaultSessionCookie is the default name of the session cookie.
+	DefaultSessionCookie = "fiber_goth.session"
+)
+
+// ErrUnexpectedResponse is returned if the response of the API does not match the spec.
+var ErrUnexpectedResponse = errors.New("unexpected response")
+
+// Error is an error returned by the API.
+type Error struct {
+	Code    int
+	Message string
+}
+
+// Error makes it compatible with the `error` interface.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Client is a client of the auth REST API.
+type Client struct {
+	api           *apis.ClientWithResponses
+	baseURL       *url.URL
+	httpClient    *http.Client
+	csrfHeader    string
+	sessionCookie string
+	store         SessionStore
+
+	mu        sync.Mutex
+	csrfToken string
+	state     State
+
+	// Sessions manages the sessions of the signed-in user.
+	Sessions *SessionsService
+	// Accounts manages the linked accounts of the signed-in user.
+	Accounts *AccountsService
+	// Orgs manages the organizations of the signed-in user.
+	Orgs *OrganizationsService
+	// Teams manages the teams of organizations.
+	Teams *TeamsService
+}
+
+// Opt is a function that configures the client.
+type Opt func(*Client)
+
+// WithHTTPClient sets the HTTP client to send requests with.
+// A cookie jar is added to a copy of the client if it has none.
+func WithHTTPClient(c *http.Client) Opt {
+	return func(cl *Client) {
+		cl.httpClient = c
+	}
+}
+
+// WithCSRFHeader sets the header of the CSRF token.
+func WithCSRFHeader(name string) Opt {
+	return func(c *Client) {
+		c.csrfHeader = name
+	}
+}
+
+// WithSessionCookie sets the name of the session cookie.
+func WithSessionCookie(name string) Opt {
+	return func(c *Client) {
+		c.sessionCookie = name
+	}
+}
+
+// WithSessionStore sets the store the session is persisted in.
+// The session of the store is restored when the client is created.
+// Errors of saving the session are ignored, the session is saved again on the next change.
+func WithSessionStore(store SessionStore) Opt {
+	return func(c *Client) {
+		c.store = store
+	}
+}
+
+// New returns a new client of the API at the base URL, e.g. "http://localhost:3000/api/auth".
+func New(baseURL string, opts ...Opt) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		baseURL:       u,
+		httpClient:    http.DefaultClient,
+		csrfHeader:    DefaultCSRFHeader,
+		sessionCookie: DefaultSessionCookie,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	hc := *c.httpClient
+	if hc.Jar == nil {
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			return nil, err
+		}
+		hc.Jar = jar
+	}
+	c.httpClient = &hc
+
+	c.api, err = apis.NewClientWithResponses(baseURL, apis.WithHTTPClient(&doer{c: c}))
+	if err != nil {
+		return nil, err
+	}
+
+	if c.store != nil {
+		state, err := c.store.Load()
+		if err != nil {
+			return nil, err
+		}
+		c.restore(state)
+		c.state = state
+	}
+
+	c.Sessions = &SessionsService{c: c}
+	c.Accounts = &AccountsService{c: c}
+	c.Orgs = &OrganizationsService{c: c}
+	c.Teams = &TeamsService{c: c}
+
+	return c, nil
+}
+
+// API returns the generated client of the API.
+// Requests of the generated client share the session of the client.
+func (c *Client) API() *apis.ClientWithResponses {
+	return c.api
+}
+
+// SignIn signs in the user with email and password.
+func (c *Client) SignIn(ctx context.Context, email, password string) (apis.User, error) {
+	res, err := c.api.SignInEmailWithResponse(ctx, apis.SignInEmailJSONRequestBody{Email: email, Password: password})
+	if err != nil {
+		return apis.User{}, err
+	}
+
+	body, err := result(res.StatusCode(), res.Body, res.JSON200)
+	if err != nil {
+		return apis.User{}, err
+	}
+
+	if utilx.NotEmpty(body.Token) {
+		c.SetSessionToken(body.Token)
+	}
+
+	return body.User, nil
+}
+
+// SignOut signs out the user and forgets the session.
+func (c *Client) SignOut(ctx context.Context) error {
+	res, err := c.api.SignOutWithResponse(ctx, apis.SignOutJSONRequestBody{})
+	if err != nil {
+		return err
+	}
+
+	if _, err := result(res.StatusCode(), res.Body, res.JSON200); err != nil {
+		return err
+	}
+
+	c.restore(State{})
+	c.persist()
+
+	return nil
+}
+
+// SessionToken returns the token of the session.
+func (c *Client) SessionToken() string {
+	for _, cookie := range c.httpClient.Jar.Cookies(c.baseURL) {
+		if cookie.Name == c.sessionCookie {
+			return cookie.Value
+		}
+	}
+
+	return ""
+}
+
+// SetSessionToken sets the token of the session, e.g. of a session that was created in the browser.
+func (c *Client) SetSessionToken(token string) {
+	c.httpClient.Jar.SetCookies(c.baseURL, []*http.Cookie{{Name: c.sessionCookie, Value: token, Path: "/"}})
+	c.persist()
+}
+
+// restore sets the session and the CSRF token of the state.
+func (c *Client) restore(state State) {
+	cookie := &http.Cookie{Name: c.sessionCookie, Value: state.SessionToken, Path: "/"}
+	if utilx.Empty(state.SessionToken) {
+		cookie.MaxAge = -1
+	}
+	c.httpClient.Jar.SetCookies(c.baseURL, []*http.Cookie{cookie})
+
+	c.setToken(state.CSRFToken)
+}
+
+// persist saves the session to the store if it has changed.
+func (c *Client) persist() {
+	if c.store == nil {
+		return
+	}
+
+	state := State{SessionToken: c.SessionToken(), CSRFToken: c.token()}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if state == c.state {
+		return
+	}
+
+	if err := c.store.Save(state); err == nil {
+		c.state = state
+	}
+}
+
+// token returns the current CSRF token.
+func (c *Client) token() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.csrfToken
+}
+
+// setToken sets the current CSRF token.
+func (c *Client) setToken(token string) {
+	c.mu.Lock()
+	c.csrfToken = token
+	c.mu.Unlock()
+}
+
+// doer sends the requests of the generated client with the session of the client.
+type doer struct {
+	c *Client
+}
+
+// Do sends the request with the CSRF token.
+// Requests that are rejected are retried once if the CSRF token has been rotated.
+func (d *doer) Do(req *http.Request) (*http.Response, error) {
+	res, sent, err := d.send(req)
+	if err != nil || res.StatusCode != http.StatusForbidden || safeMethod(req.Method) {
+		return res, err
+	}
+
+	// a rejected request without a new token fetches the current token of the session
+	if d.c.token() == sent {
+		if r, err := d.c.api.GetSession(req.Context()); err == nil {
+			_ = r.Body.Close()
+		}
+	}
+
+	if d.c.token() == sent {
+		return res, nil
+	}
+
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+
+	res, _, err = d.send(req)
+
+	return res, err
+}
+
+// send sends a copy of the request and records the CSRF token of the response.
+// It returns the token that has been sent with the request.
+func (d *doer) send(req *http.Request) (*http.Response, string, error) {
+	r := req.Clone(req.Context())
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, "", err
+		}
+		r.Body = body
+	}
+
+	token := d.c.token()
+	if utilx.NotEmpty(token) && !safeMethod(r.Method) {
+		r.Header.Set(d.c.csrfHeader, token)
+	}
+
+	res, err := d.c.httpClient.Do(r)
+	if err != nil {
+		return nil, token, err
+	}
+
+	if t := res.Header.Get(d.c.csrfHeader); utilx.NotEmpty(t) {
+		d.c.setToken(t)
+	}
+	d.c.persist()
+
+	return res, token, nil
+}
+
+// safeMethod returns true if the method does not require a CSRF token.
+func safeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	default:
+		return false
+	}
+}
+
+// result returns the body of a successful response or the error of the API.
+func result[T any](code int, body []byte, v *T) (T, error) {
+	var zero T
+
+	if code < http.StatusOK || code >= http.StatusMultipleChoices {
+		return zero, newError(code, body)
+	}
+
+	if v == nil {
+		return zero, ErrUnexpectedResponse
+	}
+
+	return *v, nil
+}
+
+// newError returns the error of the API from the body of the response.
+func newError(code int, body []byte) *Error {
+	err := &Error{Code: code, Message: http.StatusText(code)}
+
+	var msg struct {
+		Message *string `json:"message"`
+	}
+
+	if json.Unmarshal(body, &msg) == nil && utilx.NotEmpty(cast.Value(msg.Message)) {
+		err.Message = cast.Value(msg.Message)
+	}
+
+	return err
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// State is the session of the client that is persisted between runs.
+type State struct {
+	// SessionToken is the token of the session.
+	SessionToken string `json:"session_token"`
+	// CSRFToken is the current CSRF token of the session.
+	CSRFToken string `json:"csrf_token"`
+}
+
+// SessionStore persists the session of the client.
+type SessionStore interface {
+	// Load returns the persisted session.
+	Load() (State, error)
+	// Save persists the session.
+	Save(state State) error
+}
+
+var _ SessionStore = (*FileStore)(nil)
+
+// FileStore persists the session in a file that is only readable by the user.
+type FileStore struct {
+	path string
+}
+
+// NewFileStore returns a new store that persists the session in the file.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Load returns the persisted session. A missing file returns an empty session.
+func (s *FileStore) Load() (State, error) {
+	var state State
+
+	b, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return state, nil
+	}
+
+	if err != nil {
+		return state, err
+	}
+
+	err = json.Unmarshal(b, &state)
+	if err != nil {
+		return State{}, err
+	}
+
+	return state, nil
+}
+
+// Save persists the session.
+func (s *FileStore) Save(state State) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.path, b, 0o600)
+}
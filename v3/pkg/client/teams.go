@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+
+	"github.com/katallaxie/fiber-goth/v3/pkg/apis"
+	"github.com/katallaxie/pkg/cast"
+	"github.com/katallaxie/pkg/utilx"
+)
+
+// TeamsService manages the teams of organizations.
+type TeamsService struct {
+	c *Client
+}
+
+// Create creates a new team in the organization.
+// An empty organization ID creates the team in the active organization.
+func (s *TeamsService) Create(ctx context.Context, orgID, name string) (apis.Team, error) {
+	body := apis.PostOrganizationCreateTeamJSONRequestBody{Name: name}
+	if utilx.NotEmpty(orgID) {
+		body.OrganizationId = &orgID
+	}
+
+	res, err := s.c.api.PostOrganizationCreateTeamWithResponse(ctx, body)
+	if err != nil {
+		return apis.Team{}, err
+	}
+
+	t, err := result(res.StatusCode(), res.Body, res.JSON200)
+	if err != nil {
+		return apis.Team{}, err
+	}
+
+	return apis.Team{
+		Id:             cast.Ptr(t.Id),
+		Name:           t.Name,
+		OrganizationId: t.OrganizationId,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      cast.Ptr(t.UpdatedAt),
+	}, nil
+}
+
+// List returns the teams of the organization.
+// An empty organization ID lists the teams of the active organization.
+func (s *TeamsService) List(ctx context.Context, orgID string) ([]apis.Team, error) {
+	params := &apis.GetOrganizationListTeamsParams{}
+	if utilx.NotEmpty(orgID) {
+		params.OrganizationId = &orgID
+	}
+
+	res, err := s.c.api.GetOrganizationListTeamsWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := result(res.StatusCode(), res.Body, res.JSON200)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make([]apis.Team, len(body))
+	for i, t := range body {
+		teams[i] = apis.Team{
+			Id:             cast.Ptr(t.Id),
+			Name:           t.Name,
+			OrganizationId: t.OrganizationId,
+			CreatedAt:      t.CreatedAt,
+			UpdatedAt:      cast.Ptr(t.UpdatedAt),
+		}
+	}
+
+	return teams, nil
+}
+
+// ListMine returns the teams the signed-in user is a member of.
+func (s *TeamsService) ListMine(ctx context.Context) ([]apis.Team, error) {
+	res, err := s.c.api.GetOrganizationListUserTeamsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result(res.StatusCode(), res.Body, res.JSON200)
+}
+
+// Remove removes the team.
+func (s *TeamsService) Remove(ctx context.Context, teamID string) error {
+	res, err := s.c.api.PostOrganizationRemoveTeamWithResponse(ctx, apis.PostOrganizationRemoveTeamJSONRequestBody{TeamId: teamID})
+	if err != nil {
+		return err
+	}
+
+	_, err = result(res.StatusCode(), res.Body, res.JSON200)
+
+	return err
+}
+
+// AddMember adds the user to the team. The user has to be a member of the organization.
+func (s *TeamsService) AddMember(ctx context.Context, teamID, userID string) error {
+	res, err := s.c.api.PostOrganizationAddTeamMemberWithResponse(ctx, apis.PostOrganizationAddTeamMemberJSONRequestBody{TeamId: teamID, UserId: userID})
+	if err != nil {
+		return err
+	}
+
+	_, err = result(res.StatusCode(), res.Body, res.JSON200)
+
+	return err
+}
+
+// RemoveMember removes the user from the team.
+func (s *TeamsService) RemoveMember(ctx context.Context, teamID, userID string) error {
+	res, err := s.c.api.PostOrganizationRemoveTeamMemberWithResponse(ctx, apis.PostOrganizationRemoveTeamMemberJSONRequestBody{TeamId: teamID, UserId: userID})
+	if err != nil {
+		return err
+	}
+
+	_, err = result(res.StatusCode(), res.Body, res.JSON200)
+
+	return err
+}
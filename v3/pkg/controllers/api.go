@@ -0,0 +1,2250 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/katallaxie/fiber-goth/v3/access"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+	"github.com/katallaxie/fiber-goth/v3/pkg/apis"
+	"github.com/katallaxie/fiber-goth/v3/providers"
+
+	"github.com/katallaxie/pkg/cast"
+	"github.com/katallaxie/pkg/slices"
+	"github.com/katallaxie/pkg/utilx"
+)
+
+var _ apis.StrictServerInterface = (*APIController)(nil)
+
+var (
+	errAccountMismatch   = errors.New("account does not match")
+	errTwoFactorRequired = errors.New("two-factor verification is required")
+)
+
+var (
+	errMissingOrganization = errors.New("missing organization")
+	errInvitationMismatch  = errors.New("invitation does not match")
+	errMissingTeam         = errors.New("missing team")
+	errInvalidPermissions  = errors.New("invalid permissions")
+)
+
+const (
+	msgUnauthorized    = "you need to be signed in to perform this action"
+	msgSessionNotFound = "session not found"
+	msgInternalError   = "internal server error"
+	msgAccountNotFound = "account not found"
+	msgForbidden       = "you are not allowed to perform this action"
+	msgRefreshFailed   = "failed to refresh the access token"
+	msgMissingProvider = "missing provider"
+	msgProviderUnknown = "provider not found"
+	msgIDTokenLink     = "linking an account with an id token is not supported"
+	msgIDTokenSignIn   = "provider does not support signing in with an id token"
+	msgTwoFactorSignIn = "users with a second factor cannot sign in with an id token"
+	msgAccountLinked   = "account is already linked to another user"
+	msgLastAccount     = "cannot unlink the last sign-in method"
+	msgSessionNotFresh = "session is not fresh, please sign in again"
+	msgPasswordDelete  = "deleting a user with a password is not supported"
+	msgBadToken        = "token is invalid or has expired"
+	msgUserDeleted     = "user deleted"
+	msgDeletionSent    = "verification email sent"
+	msgNoOrganizations = "organizations are not supported by the adapter"
+	msgMissingOrg      = "missing organization"
+	msgMissingOrgName  = "missing organization name or slug"
+	msgOrgNotFound     = "organization not found"
+	msgSlugTaken       = "organization slug is already taken"
+
+	msgMissingInvitation  = "missing invitation"
+	msgMissingInvitee     = "missing email or role"
+	msgInvalidRole        = "invalid role"
+	msgTeamNotFound       = "team not found"
+	msgAlreadyMember      = "user is already a member of the organization"
+	msgAlreadyInvited     = "user is already invited to the organization"
+	msgInvitationChanged  = "user is already invited with another role or team"
+	msgInvitationNotFound = "invitation not found"
+	msgInvitationInvalid  = "invitation is no longer valid"
+
+	msgInvalidPermissions = "invalid permissions"
+	msgMissingMember      = "missing member"
+	msgMemberNotFound     = "member not found"
+	msgLastOwner          = "cannot remove the last owner of the organization"
+
+	msgMissingTeam       = "missing team"
+	msgMissingTeamName   = "missing team name"
+	msgMissingTeamMember = "missing team or user"
+	msgTeamLimit         = "maximum number of teams reached"
+	msgTeamMemberLimit   = "maximum number of team members reached"
+	msgAlreadyTeamMember = "user is already a member of the team"
+	msgTeamRemoved       = "team removed"
+	msgTeamMemberRemoved = "team member removed"
+
+	msgNoTrustedDevices     = "trusted devices are not supported by the adapter"
+	msgMissingTrustedDevice = "missing trusted device"
+	msgTrustedDeviceUnknown = "trusted device not found"
+
+	msgNoPersonalAccessTokens      = "personal access tokens are not supported by the adapter"
+	msgMissingTokenName            = "missing token name or scopes"
+	msgInvalidScope                = "invalid scope"
+	msgInvalidExpiry               = "invalid expiry"
+	msgMissingPersonalAccessToken  = "missing personal access token"
+	msgPersonalAccessTokenNotFound = "personal access token not found"
+)
+
+const (
+	// DefaultBeginAuthURL is the default URL of the handler to begin authentication.
+	DefaultBeginAuthURL = "/login/:provider"
+	// DefaultBaseURL is the default URL the API is mounted at.
+	DefaultBaseURL = "/api/auth"
+	// DefaultFreshAge is the default age of a session that is considered fresh.
+	DefaultFreshAge = 24 * time.Hour
+	// DefaultDeleteTokenExpiry is the default expiry of a deletion verification token.
+	DefaultDeleteTokenExpiry = time.Hour
+	// DefaultInvitationExpiry is the default expiry of an invitation to an organization.
+	DefaultInvitationExpiry = 48 * time.Hour
+	// DefaultSessionExpiry is the default expiry of a session of a user that signs in with the API.
+	DefaultSessionExpiry = 7 * time.Hour
+)
+
+// SendDeleteUserVerification sends the verification URL to confirm the deletion of the user.
+type SendDeleteUserVerification func(ctx context.Context, user adapters.GothUser, url string) error
+
+// SendInvitation sends an invitation to an organization to the invited email.
+type SendInvitation func(ctx context.Context, invitation adapters.GothInvitation, org adapters.GothOrganization, inviter adapters.GothUser) error
+
+// CreateSession creates the session of a user that has signed in with the provider.
+type CreateSession func(ctx context.Context, user adapters.GothUser, provider string) (adapters.GothSession, error)
+
+// UserHook is a function that is called with a user.
+type UserHook func(ctx context.Context, user adapters.GothUser) error
+
+// APIController implements the strict server interface of the auth API.
+type APIController struct {
+	adapter                    adapters.Adapter
+	orgs                       adapters.OrganizationAdapter
+	devices                    adapters.TrustedDeviceAdapter
+	tokens                     adapters.PersonalAccessTokenAdapter
+	tokenScopes                []string
+	beginAuthURL               string
+	baseURL                    string
+	freshAge                   time.Duration
+	sendDeleteUserVerification SendDeleteUserVerification
+	beforeDeleteUser           UserHook
+	afterDeleteUser            UserHook
+	invitationSender           SendInvitation
+	invitationExpiry           time.Duration
+	createSession              CreateSession
+	policy                     access.Policy
+	maxTeams                   int
+	maxTeamMembers             int
+}
+
+// Opt is a function that configures the controller.
+type Opt func(*APIController)
+
+// WithBeginAuthURL sets the URL of the handler to begin authentication.
+// The URL has to contain the ":provider" parameter.
+func WithBeginAuthURL(url string) Opt {
+	return func(c *APIController) {
+		c.beginAuthURL = url
+	}
+}
+
+// WithBaseURL sets the URL the API is mounted at.
+func WithBaseURL(url string) Opt {
+	return func(c *APIController) {
+		c.baseURL = url
+	}
+}
+
+// WithFreshAge sets the age of a session that is considered fresh.
+// Users with a fresh session can be deleted without further verification.
+func WithFreshAge(age time.Duration) Opt {
+	return func(c *APIController) {
+		c.freshAge = age
+	}
+}
+
+// WithDeleteUserVerification sets the function to send the verification URL
+// to confirm the deletion of a user. If set, users are only deleted after verification.
+func WithDeleteUserVerification(fn SendDeleteUserVerification) Opt {
+	return func(c *APIController) {
+		c.sendDeleteUserVerification = fn
+	}
+}
+
+// WithBeforeDeleteUser sets the hook that is called before a user is deleted.
+// An error aborts the deletion.
+func WithBeforeDeleteUser(fn UserHook) Opt {
+	return func(c *APIController) {
+		c.beforeDeleteUser = fn
+	}
+}
+
+// WithAfterDeleteUser sets the hook that is called after a user is deleted.
+// This is the place to clean up the data of the application.
+func WithAfterDeleteUser(fn UserHook) Opt {
+	return func(c *APIController) {
+		c.afterDeleteUser = fn
+	}
+}
+
+// WithInvitationSender sets the function to send invitations to organizations.
+func WithInvitationSender(fn SendInvitation) Opt {
+	return func(c *APIController) {
+		c.invitationSender = fn
+	}
+}
+
+// WithInvitationExpiry sets the expiry of invitations to organizations.
+func WithInvitationExpiry(expiry time.Duration) Opt {
+	return func(c *APIController) {
+		c.invitationExpiry = expiry
+	}
+}
+
+// WithPolicy sets the policy that maps the roles of members to their permissions.
+func WithPolicy(policy access.Policy) Opt {
+	return func(c *APIController) {
+		c.policy = policy
+	}
+}
+
+// WithMaxTeams sets the maximum number of teams of an organization.
+// Zero means the number of teams is not limited.
+func WithMaxTeams(n int) Opt {
+	return func(c *APIController) {
+		c.maxTeams = n
+	}
+}
+
+// WithMaxTeamMembers sets the maximum number of members of a team.
+// Zero means the number of members is not limited.
+func WithMaxTeamMembers(n int) Opt {
+	return func(c *APIController) {
+		c.maxTeamMembers = n
+	}
+}
+
+// WithPersonalAccessTokenScopes sets the scopes that personal access tokens can be created with, e.g. "repo:read".
+// If no scopes are set, tokens can be created with any scope.
+func WithPersonalAccessTokenScopes(scopes ...string) Opt {
+	return func(c *APIController) {
+		c.tokenScopes = scopes
+	}
+}
+
+// WithCreateSession sets the function to create the sessions of users that sign in with the API,
+// e.g. with the ID token of a provider. By default sessions expire after DefaultSessionExpiry.
+func WithCreateSession(fn CreateSession) Opt {
+	return func(c *APIController) {
+		c.createSession = fn
+	}
+}
+
+// NewAPIController returns a new controller that uses the adapter to store data.
+// Organizations are supported if the adapter implements the organization adapter,
+// trusted devices if it implements the trusted device adapter,
+// and personal access tokens if it implements the personal access token adapter.
+func NewAPIController(adapter adapters.Adapter, opts ...Opt) *APIController {
+	orgs, _ := adapter.(adapters.OrganizationAdapter)
+	devices, _ := adapter.(adapters.TrustedDeviceAdapter)
+	tokens, _ := adapter.(adapters.PersonalAccessTokenAdapter)
+
+	c := &APIController{
+		orgs:             orgs,
+		devices:          devices,
+		tokens:           tokens,
+		adapter:          adapter,
+		beginAuthURL:     DefaultBeginAuthURL,
+		baseURL:          DefaultBaseURL,
+		freshAge:         DefaultFreshAge,
+		invitationExpiry: DefaultInvitationExpiry,
+		policy:           access.DefaultPolicy,
+	}
+
+	c.createSession = c.defaultCreateSession
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// (GET /account-info).
+func (c *APIController) GetAccountInfo(_ context.Context, _ apis.GetAccountInfoRequestObject) (apis.GetAccountInfoResponseObject, error) {
+	return nil, ErrNotImplemented
+}
+
+// (POST /change-email).
+func (c *APIController) ChangeEmail(_ context.Context, _ apis.ChangeEmailRequestObject) (apis.ChangeEmailResponseObject, error) {
+	return nil, ErrNotImplemented
+}
+
+// (POST /change-password).
+func (c *APIController) ChangePassword(_ context.Context, _ apis.ChangePasswordRequestObject) (apis.ChangePasswordResponseObject, error) {
+	return nil, ErrNotImplemented
+}
+
+// (POST /create-personal-access-token).
+func (c *APIController) CreatePersonalAccessToken(ctx context.Context, req apis.CreatePersonalAccessTokenRequestObject) (apis.CreatePersonalAccessTokenResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.CreatePersonalAccessToken401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.tokens == nil {
+		return apis.CreatePersonalAccessToken500JSONResponse{Message: cast.Ptr(msgNoPersonalAccessTokens)}, nil
+	}
+
+	if req.Body == nil || utilx.Empty(req.Body.Name) || len(req.Body.Scopes) == 0 {
+		return apis.CreatePersonalAccessToken400JSONResponse{Message: msgMissingTokenName}, nil
+	}
+
+	for _, scope := range req.Body.Scopes {
+		if !c.validScope(scope) {
+			return apis.CreatePersonalAccessToken400JSONResponse{Message: msgInvalidScope}, nil
+		}
+	}
+
+	value, token := adapters.NewPersonalAccessToken(session.UserID, req.Body.Name, req.Body.Scopes...)
+
+	if req.Body.ExpiresIn != nil {
+		if *req.Body.ExpiresIn <= 0 {
+			return apis.CreatePersonalAccessToken400JSONResponse{Message: msgInvalidExpiry}, nil
+		}
+
+		token.ExpiresAt = cast.Ptr(time.Now().Add(time.Duration(*req.Body.ExpiresIn) * time.Second))
+	}
+
+	// Tokens of an organization are limited to its members.
+	if !utilx.Empty(cast.Value(req.Body.OrganizationId)) {
+		if c.orgs == nil {
+			return apis.CreatePersonalAccessToken500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+		}
+
+		orgID, err := uuid.Parse(cast.Value(req.Body.OrganizationId))
+		if err != nil {
+			return apis.CreatePersonalAccessToken400JSONResponse{Message: msgMissingOrg}, nil
+		}
+
+		_, err = c.orgs.GetMember(ctx, orgID, session.UserID)
+		if err != nil {
+			return apis.CreatePersonalAccessToken403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+		}
+
+		token.OrganizationID = &orgID
+	}
+
+	token, err := c.tokens.CreatePersonalAccessToken(ctx, token)
+	if err != nil {
+		return apis.CreatePersonalAccessToken500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.CreatePersonalAccessToken200JSONResponse{Token: value, PersonalAccessToken: toPersonalAccessToken(token)}, nil
+}
+
+// (POST /delete-user).
+func (c *APIController) DeleteUser(ctx context.Context, req apis.DeleteUserRequestObject) (apis.DeleteUserResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.DeleteUser401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	body := cast.Value(req.Body)
+
+	if utilx.NotEmpty(cast.Value(body.Password)) {
+		return apis.DeleteUser400JSONResponse{Message: msgPasswordDelete}, nil
+	}
+
+	if utilx.NotEmpty(cast.Value(body.Token)) {
+		err := c.verifyDeletionToken(ctx, session.UserID, cast.Value(body.Token))
+		if err != nil {
+			return apis.DeleteUser400JSONResponse{Message: msgBadToken}, nil
+		}
+
+		if err := c.deleteUser(ctx, session.UserID); err != nil {
+			return apis.DeleteUser500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+		}
+
+		return apis.DeleteUser200JSONResponse{Success: true, Message: msgUserDeleted}, nil
+	}
+
+	if c.sendDeleteUserVerification != nil {
+		if err := c.sendDeletionVerification(ctx, session.UserID, cast.Value(body.CallbackURL)); err != nil {
+			return apis.DeleteUser500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+		}
+
+		return apis.DeleteUser200JSONResponse{Success: true, Message: msgDeletionSent}, nil
+	}
+
+	if !session.IsFresh(c.freshAge) {
+		return apis.DeleteUser403JSONResponse{Message: cast.Ptr(msgSessionNotFresh)}, nil
+	}
+
+	if err := c.deleteUser(ctx, session.UserID); err != nil {
+		return apis.DeleteUser500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.DeleteUser200JSONResponse{Success: true, Message: msgUserDeleted}, nil
+}
+
+// (GET /delete-user/callback).
+func (c *APIController) GetDeleteUserCallback(ctx context.Context, req apis.GetDeleteUserCallbackRequestObject) (apis.GetDeleteUserCallbackResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.GetDeleteUserCallback401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	err := c.verifyDeletionToken(ctx, session.UserID, cast.Value(req.Params.Token))
+	if err != nil {
+		return apis.GetDeleteUserCallback400JSONResponse{Message: msgBadToken}, nil
+	}
+
+	if err := c.deleteUser(ctx, session.UserID); err != nil {
+		return apis.GetDeleteUserCallback500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.GetDeleteUserCallback200JSONResponse{Success: true, Message: msgUserDeleted}, nil
+}
+
+// (GET /error).
+func (c *APIController) GetError(_ context.Context, _ apis.GetErrorRequestObject) (apis.GetErrorResponseObject, error) {
+	return apis.GetError200TexthtmlResponse{}, nil
+}
+
+// (POST /get-access-token).
+func (c *APIController) PostGetAccessToken(ctx context.Context, req apis.PostGetAccessTokenRequestObject) (apis.PostGetAccessTokenResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostGetAccessToken401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if req.Body == nil {
+		return apis.PostGetAccessToken404JSONResponse{Message: cast.Ptr(msgAccountNotFound)}, nil
+	}
+
+	if req.Body.UserId != nil && cast.Value(req.Body.UserId) != session.UserID.String() {
+		return apis.PostGetAccessToken403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	account, err := c.accountFor(ctx, session, req.Body.ProviderId, req.Body.AccountId)
+	if err != nil {
+		return apis.PostGetAccessToken404JSONResponse{Message: cast.Ptr(msgAccountNotFound)}, nil
+	}
+
+	account, err = providers.ValidAccessToken(ctx, c.adapter, account)
+	if errors.Is(err, providers.ErrRefreshUnsupported) || errors.Is(err, providers.ErrMissingRefreshToken) {
+		return apis.PostGetAccessToken403JSONResponse{Message: cast.Ptr(msgRefreshFailed)}, nil
+	}
+
+	if err != nil {
+		return apis.PostGetAccessToken500JSONResponse{Message: cast.Ptr(msgRefreshFailed)}, nil
+	}
+
+	return toTokens(account), nil
+}
+
+// (GET /get-session).
+func (c *APIController) GetSession(ctx context.Context, _ apis.GetSessionRequestObject) (apis.GetSessionResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.GetSession401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	user, err := c.adapter.GetUser(ctx, session.UserID)
+	if err != nil {
+		return apis.GetSession500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.GetSession200JSONResponse{Session: toSession(session), User: toUser(user)}, nil
+}
+
+// (POST /link-social).
+func (c *APIController) LinkSocialAccount(ctx context.Context, req apis.LinkSocialAccountRequestObject) (apis.LinkSocialAccountResponseObject, error) {
+	if _, ok := SessionFromContext(ctx); !ok {
+		return apis.LinkSocialAccount401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if req.Body == nil || req.Body.Provider == "" {
+		return apis.LinkSocialAccount400JSONResponse{Message: msgMissingProvider}, nil
+	}
+
+	if req.Body.IdToken != nil {
+		return apis.LinkSocialAccount400JSONResponse{Message: msgIDTokenLink}, nil
+	}
+
+	// The handler to begin authentication verifies the session and records the link intent in the state.
+	u, err := url.Parse(strings.ReplaceAll(c.beginAuthURL, ":provider", url.PathEscape(req.Body.Provider)))
+	if err != nil {
+		return apis.LinkSocialAccount500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	q := u.Query()
+	q.Set("intent", "link")
+
+	if utilx.NotEmpty(cast.Value(req.Body.CallbackURL)) {
+		q.Set("redirect_uri", cast.Value(req.Body.CallbackURL))
+	}
+	u.RawQuery = q.Encode()
+
+	return apis.LinkSocialAccount200JSONResponse{
+		Redirect: !cast.Value(req.Body.DisableRedirect),
+		Url:      cast.Ptr(u.String()),
+	}, nil
+}
+
+// (GET /list-accounts).
+func (c *APIController) ListUserAccounts(ctx context.Context, _ apis.ListUserAccountsRequestObject) (apis.ListUserAccountsResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.ListUserAccounts401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	accounts, err := c.adapter.ListAccounts(ctx, session.UserID)
+	if err != nil {
+		return apis.ListUserAccounts500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return toAccounts(accounts...), nil
+}
+
+// (GET /list-sessions).
+func (c *APIController) ListUserSessions(ctx context.Context, _ apis.ListUserSessionsRequestObject) (apis.ListUserSessionsResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.ListUserSessions401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	sessions, err := c.adapter.ListSessions(ctx, session.UserID)
+	if err != nil {
+		return apis.ListUserSessions500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.ListUserSessions200JSONResponse(slices.Map(toSession, sessions...)), nil
+}
+
+// (GET /list-personal-access-tokens).
+func (c *APIController) ListPersonalAccessTokens(ctx context.Context, _ apis.ListPersonalAccessTokensRequestObject) (apis.ListPersonalAccessTokensResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.ListPersonalAccessTokens401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.tokens == nil {
+		return apis.ListPersonalAccessTokens500JSONResponse{Message: cast.Ptr(msgNoPersonalAccessTokens)}, nil
+	}
+
+	tokens, err := c.tokens.ListPersonalAccessTokens(ctx, session.UserID)
+	if err != nil {
+		return apis.ListPersonalAccessTokens500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.ListPersonalAccessTokens200JSONResponse(slices.Map(toPersonalAccessToken, tokens...)), nil
+}
+
+// (GET /list-trusted-devices).
+func (c *APIController) ListTrustedDevices(ctx context.Context, _ apis.ListTrustedDevicesRequestObject) (apis.ListTrustedDevicesResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.ListTrustedDevices401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.devices == nil {
+		return apis.ListTrustedDevices500JSONResponse{Message: cast.Ptr(msgNoTrustedDevices)}, nil
+	}
+
+	devices, err := c.devices.ListTrustedDevices(ctx, session.UserID)
+	if err != nil {
+		return apis.ListTrustedDevices500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.ListTrustedDevices200JSONResponse(slices.Map(toTrustedDevice, devices...)), nil
+}
+
+// (GET /ok).
+func (c *APIController) GetOk(_ context.Context, _ apis.GetOkRequestObject) (apis.GetOkResponseObject, error) {
+	return apis.GetOk200JSONResponse{Ok: true}, nil
+}
+
+// (POST /organization/accept-invitation).
+func (c *APIController) PostOrganizationAcceptInvitation(ctx context.Context, req apis.PostOrganizationAcceptInvitationRequestObject) (apis.PostOrganizationAcceptInvitationResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationAcceptInvitation401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationAcceptInvitation500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil {
+		return apis.PostOrganizationAcceptInvitation400JSONResponse{Message: msgMissingInvitation}, nil
+	}
+
+	invitation, err := c.invitationFor(ctx, session, req.Body.InvitationId)
+	if errors.Is(err, errInvitationMismatch) {
+		return apis.PostOrganizationAcceptInvitation403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	if err != nil {
+		return apis.PostOrganizationAcceptInvitation404JSONResponse{Message: cast.Ptr(msgInvitationNotFound)}, nil
+	}
+
+	if !invitation.IsPending() {
+		return apis.PostOrganizationAcceptInvitation400JSONResponse{Message: msgInvitationInvalid}, nil
+	}
+
+	invitation, member, err := c.orgs.AcceptInvitation(ctx, invitation.ID, session.UserID)
+	if errors.Is(err, adapters.ErrAlreadyMember) {
+		return apis.PostOrganizationAcceptInvitation400JSONResponse{Message: msgAlreadyMember}, nil
+	}
+
+	if err != nil {
+		return apis.PostOrganizationAcceptInvitation500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostOrganizationAcceptInvitation200JSONResponse{
+		Invitation: cast.Ptr(toInvitation(invitation)),
+		Member:     cast.Ptr(toMember(member)),
+	}, nil
+}
+
+// (POST /organization/add-team-member).
+func (c *APIController) PostOrganizationAddTeamMember(ctx context.Context, req apis.PostOrganizationAddTeamMemberRequestObject) (apis.PostOrganizationAddTeamMemberResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationAddTeamMember401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationAddTeamMember500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil || utilx.Empty(req.Body.TeamId) || utilx.Empty(req.Body.UserId) {
+		return apis.PostOrganizationAddTeamMember400JSONResponse{Message: msgMissingTeamMember}, nil
+	}
+
+	team, org, actor, err := c.teamFor(ctx, session, req.Body.TeamId)
+	if err != nil {
+		return apis.PostOrganizationAddTeamMember404JSONResponse{Message: cast.Ptr(msgTeamNotFound)}, nil
+	}
+
+	if !c.policy.Can(actor.Role, access.ResourceTeam, access.ActionUpdate) {
+		return apis.PostOrganizationAddTeamMember403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	userID, err := uuid.Parse(req.Body.UserId)
+	if err != nil {
+		return apis.PostOrganizationAddTeamMember404JSONResponse{Message: cast.Ptr(msgMemberNotFound)}, nil
+	}
+
+	// only members of the organization can be members of its teams
+	if _, err := c.orgs.GetMember(ctx, org.ID, userID); err != nil {
+		return apis.PostOrganizationAddTeamMember404JSONResponse{Message: cast.Ptr(msgMemberNotFound)}, nil
+	}
+
+	if c.maxTeamMembers > 0 && len(team.Members) >= c.maxTeamMembers {
+		return apis.PostOrganizationAddTeamMember400JSONResponse{Message: msgTeamMemberLimit}, nil
+	}
+
+	member, err := c.orgs.AddTeamMember(ctx, team.ID, userID)
+	if errors.Is(err, adapters.ErrAlreadyTeamMember) {
+		return apis.PostOrganizationAddTeamMember400JSONResponse{Message: msgAlreadyTeamMember}, nil
+	}
+
+	if err != nil {
+		return apis.PostOrganizationAddTeamMember500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostOrganizationAddTeamMember200JSONResponse{
+		Id:        member.ID.String(),
+		TeamId:    member.TeamID.String(),
+		UserId:    member.UserID.String(),
+		CreatedAt: member.CreatedAt,
+	}, nil
+}
+
+// (POST /organization/cancel-invitation).
+func (c *APIController) PostOrganizationCancelInvitation(ctx context.Context, req apis.PostOrganizationCancelInvitationRequestObject) (apis.PostOrganizationCancelInvitationResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationCancelInvitation401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationCancelInvitation500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil {
+		return apis.PostOrganizationCancelInvitation400JSONResponse{Message: msgMissingInvitation}, nil
+	}
+
+	id, err := uuid.Parse(req.Body.InvitationId)
+	if err != nil {
+		return apis.PostOrganizationCancelInvitation400JSONResponse{Message: msgMissingInvitation}, nil
+	}
+
+	invitation, err := c.orgs.GetInvitation(ctx, id)
+	if err != nil {
+		return apis.PostOrganizationCancelInvitation404JSONResponse{Message: cast.Ptr(msgInvitationNotFound)}, nil
+	}
+
+	member, err := c.orgs.GetMember(ctx, invitation.OrganizationID, session.UserID)
+	if err != nil {
+		return apis.PostOrganizationCancelInvitation404JSONResponse{Message: cast.Ptr(msgInvitationNotFound)}, nil
+	}
+
+	if !c.policy.Can(member.Role, access.ResourceInvitation, access.ActionCancel) && invitation.InviterID != session.UserID {
+		return apis.PostOrganizationCancelInvitation403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	if invitation.Status != adapters.InvitationStatusPending {
+		return apis.PostOrganizationCancelInvitation400JSONResponse{Message: msgInvitationInvalid}, nil
+	}
+
+	invitation.Status = adapters.InvitationStatusCanceled
+
+	invitation, err = c.orgs.UpdateInvitation(ctx, invitation)
+	if err != nil {
+		return apis.PostOrganizationCancelInvitation500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostOrganizationCancelInvitation200JSONResponse(toInvitation(invitation)), nil
+}
+
+// (POST /organization/check-slug).
+func (c *APIController) PostOrganizationCheckSlug(ctx context.Context, req apis.PostOrganizationCheckSlugRequestObject) (apis.PostOrganizationCheckSlugResponseObject, error) {
+	if _, ok := SessionFromContext(ctx); !ok {
+		return apis.PostOrganizationCheckSlug401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationCheckSlug500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil || utilx.Empty(req.Body.Slug) {
+		return apis.PostOrganizationCheckSlug400JSONResponse{Message: msgMissingOrgName}, nil
+	}
+
+	if _, err := c.orgs.GetOrganizationBySlug(ctx, req.Body.Slug); err == nil {
+		return apis.PostOrganizationCheckSlug400JSONResponse{Message: msgSlugTaken}, nil
+	}
+
+	return apis.PostOrganizationCheckSlug200JSONResponse{Status: cast.Ptr(true)}, nil
+}
+
+// (POST /organization/create).
+func (c *APIController) PostOrganizationCreate(ctx context.Context, req apis.PostOrganizationCreateRequestObject) (apis.PostOrganizationCreateResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationCreate401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationCreate500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil || utilx.Empty(req.Body.Name) || utilx.Empty(req.Body.Slug) {
+		return apis.PostOrganizationCreate400JSONResponse{Message: msgMissingOrgName}, nil
+	}
+
+	// creating organizations on behalf of other users is reserved for the server
+	if req.Body.UserId != nil && cast.Value(req.Body.UserId) != session.UserID.String() {
+		return apis.PostOrganizationCreate403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	org := adapters.GothOrganization{
+		Name:     req.Body.Name,
+		Slug:     req.Body.Slug,
+		Logo:     req.Body.Logo,
+		Metadata: req.Body.Metadata,
+	}
+
+	org, err := c.orgs.CreateOrganization(ctx, org, session.UserID)
+	if errors.Is(err, adapters.ErrSlugTaken) {
+		return apis.PostOrganizationCreate400JSONResponse{Message: msgSlugTaken}, nil
+	}
+
+	if err != nil {
+		return apis.PostOrganizationCreate500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	if !cast.Value(req.Body.KeepCurrentActiveOrganization) {
+		_, err = c.orgs.SetActiveOrganization(ctx, session.SessionToken, cast.Ptr(org.ID), nil)
+		if err != nil {
+			return apis.PostOrganizationCreate500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+		}
+	}
+
+	return apis.PostOrganizationCreate200JSONResponse(toOrganization(org)), nil
+}
+
+// (POST /organization/create-team).
+func (c *APIController) PostOrganizationCreateTeam(ctx context.Context, req apis.PostOrganizationCreateTeamRequestObject) (apis.PostOrganizationCreateTeamResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationCreateTeam401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationCreateTeam500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil || utilx.Empty(req.Body.Name) {
+		return apis.PostOrganizationCreateTeam400JSONResponse{Message: msgMissingTeamName}, nil
+	}
+
+	org, member, err := c.organizationFor(ctx, session, req.Body.OrganizationId, nil)
+	if errors.Is(err, errMissingOrganization) {
+		return apis.PostOrganizationCreateTeam400JSONResponse{Message: msgMissingOrg}, nil
+	}
+
+	if err != nil {
+		return apis.PostOrganizationCreateTeam404JSONResponse{Message: cast.Ptr(msgOrgNotFound)}, nil
+	}
+
+	if !c.policy.Can(member.Role, access.ResourceTeam, access.ActionCreate) {
+		return apis.PostOrganizationCreateTeam403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	if c.maxTeams > 0 && len(org.Teams) >= c.maxTeams {
+		return apis.PostOrganizationCreateTeam400JSONResponse{Message: msgTeamLimit}, nil
+	}
+
+	team, err := c.orgs.CreateTeam(ctx, adapters.GothTeam{Name: req.Body.Name, OrganizationID: org.ID})
+	if err != nil {
+		return apis.PostOrganizationCreateTeam500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostOrganizationCreateTeam200JSONResponse{
+		Id:             team.ID.String(),
+		Name:           team.Name,
+		OrganizationId: team.OrganizationID.String(),
+		CreatedAt:      team.CreatedAt,
+		UpdatedAt:      team.UpdatedAt,
+	}, nil
+}
+
+// (POST /organization/delete).
+func (c *APIController) PostOrganizationDelete(ctx context.Context, req apis.PostOrganizationDeleteRequestObject) (apis.PostOrganizationDeleteResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationDelete401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationDelete500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil || utilx.Empty(req.Body.OrganizationId) {
+		return apis.PostOrganizationDelete400JSONResponse{Message: msgMissingOrg}, nil
+	}
+
+	org, member, err := c.organizationFor(ctx, session, &req.Body.OrganizationId, nil)
+	if err != nil {
+		return apis.PostOrganizationDelete404JSONResponse{Message: cast.Ptr(msgOrgNotFound)}, nil
+	}
+
+	if !c.policy.Can(member.Role, access.ResourceOrganization, access.ActionDelete) {
+		return apis.PostOrganizationDelete403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	if err := c.orgs.DeleteOrganization(ctx, org.ID); err != nil {
+		return apis.PostOrganizationDelete500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostOrganizationDelete200JSONResponse(org.ID.String()), nil
+}
+
+// (GET /organization/get-active-member).
+func (c *APIController) GetOrganizationGetActiveMember(_ context.Context, _ apis.GetOrganizationGetActiveMemberRequestObject) (apis.GetOrganizationGetActiveMemberResponseObject, error) {
+	return nil, ErrNotImplemented
+}
+
+// (GET /organization/get-active-member-role).
+func (c *APIController) GetOrganizationGetActiveMemberRole(ctx context.Context, _ apis.GetOrganizationGetActiveMemberRoleRequestObject) (apis.GetOrganizationGetActiveMemberRoleResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.GetOrganizationGetActiveMemberRole401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.GetOrganizationGetActiveMemberRole500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	_, member, err := c.organizationFor(ctx, session, nil, nil)
+	if errors.Is(err, errMissingOrganization) {
+		return apis.GetOrganizationGetActiveMemberRole400JSONResponse{Message: msgMissingOrg}, nil
+	}
+
+	if err != nil {
+		return apis.GetOrganizationGetActiveMemberRole404JSONResponse{Message: cast.Ptr(msgOrgNotFound)}, nil
+	}
+
+	return apis.GetOrganizationGetActiveMemberRole200JSONResponse{Role: string(member.Role)}, nil
+}
+
+// (GET /organization/get-full-organization).
+func (c *APIController) GetOrganization(ctx context.Context, req apis.GetOrganizationRequestObject) (apis.GetOrganizationResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.GetOrganization401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.GetOrganization500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	org, _, err := c.organizationFor(ctx, session, req.Params.OrganizationId, req.Params.OrganizationSlug)
+	if errors.Is(err, errMissingOrganization) {
+		return apis.GetOrganization400JSONResponse{Message: msgMissingOrg}, nil
+	}
+
+	if err != nil {
+		return apis.GetOrganization404JSONResponse{Message: cast.Ptr(msgOrgNotFound)}, nil
+	}
+
+	return apis.GetOrganization200JSONResponse(toOrganization(org)), nil
+}
+
+// (GET /organization/get-invitation).
+func (c *APIController) GetOrganizationGetInvitation(ctx context.Context, req apis.GetOrganizationGetInvitationRequestObject) (apis.GetOrganizationGetInvitationResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.GetOrganizationGetInvitation401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.GetOrganizationGetInvitation500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	invitation, err := c.invitationFor(ctx, session, cast.Value(req.Params.Id))
+	if errors.Is(err, errInvitationMismatch) {
+		return apis.GetOrganizationGetInvitation403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	if err != nil {
+		return apis.GetOrganizationGetInvitation404JSONResponse{Message: cast.Ptr(msgInvitationNotFound)}, nil
+	}
+
+	if !invitation.IsPending() {
+		return apis.GetOrganizationGetInvitation400JSONResponse{Message: msgInvitationInvalid}, nil
+	}
+
+	inviter, err := c.adapter.GetUser(ctx, invitation.InviterID)
+	if err != nil {
+		return apis.GetOrganizationGetInvitation500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.GetOrganizationGetInvitation200JSONResponse{
+		Id:               invitation.ID.String(),
+		Email:            invitation.Email,
+		Role:             string(invitation.Role),
+		Status:           string(invitation.Status),
+		ExpiresAt:        invitation.ExpiresAt.Format(time.RFC3339),
+		InviterId:        invitation.InviterID.String(),
+		InviterEmail:     inviter.Email,
+		OrganizationId:   invitation.OrganizationID.String(),
+		OrganizationName: invitation.Organization.Name,
+		OrganizationSlug: invitation.Organization.Slug,
+	}, nil
+}
+
+// (POST /organization/has-permission).
+func (c *APIController) PostOrganizationHasPermission(ctx context.Context, req apis.PostOrganizationHasPermissionRequestObject) (apis.PostOrganizationHasPermissionResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationHasPermission401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationHasPermission500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil {
+		return apis.PostOrganizationHasPermission400JSONResponse{Message: msgInvalidPermissions}, nil
+	}
+
+	permissions := req.Body.Permissions
+	if len(permissions) == 0 && req.Body.Permission != nil {
+		permissions = *req.Body.Permission
+	}
+
+	statements, err := toStatements(permissions)
+	if err != nil {
+		return apis.PostOrganizationHasPermission400JSONResponse{Message: msgInvalidPermissions}, nil
+	}
+
+	_, member, err := c.organizationFor(ctx, session, nil, nil)
+	if errors.Is(err, errMissingOrganization) {
+		return apis.PostOrganizationHasPermission400JSONResponse{Message: msgMissingOrg}, nil
+	}
+
+	if err != nil {
+		return apis.PostOrganizationHasPermission404JSONResponse{Message: cast.Ptr(msgOrgNotFound)}, nil
+	}
+
+	return apis.PostOrganizationHasPermission200JSONResponse{Success: c.policy.HasPermission(member.Role, statements)}, nil
+}
+
+// (POST /organization/invite-member).
+func (c *APIController) CreateOrganizationInvitation(ctx context.Context, req apis.CreateOrganizationInvitationRequestObject) (apis.CreateOrganizationInvitationResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.CreateOrganizationInvitation401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.CreateOrganizationInvitation500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil || utilx.Empty(req.Body.Email) || utilx.Empty(req.Body.Role) {
+		return apis.CreateOrganizationInvitation400JSONResponse{Message: msgMissingInvitee}, nil
+	}
+
+	role := adapters.Role(req.Body.Role)
+	if !c.policy.HasRole(role) {
+		return apis.CreateOrganizationInvitation400JSONResponse{Message: msgInvalidRole}, nil
+	}
+
+	org, member, err := c.organizationFor(ctx, session, req.Body.OrganizationId, nil)
+	if errors.Is(err, errMissingOrganization) {
+		return apis.CreateOrganizationInvitation400JSONResponse{Message: msgMissingOrg}, nil
+	}
+
+	if err != nil {
+		return apis.CreateOrganizationInvitation404JSONResponse{Message: cast.Ptr(msgOrgNotFound)}, nil
+	}
+
+	// only owners are allowed to invite other owners
+	if !c.policy.Can(member.Role, access.ResourceInvitation, access.ActionCreate) || (role == adapters.RoleOwner && member.Role != adapters.RoleOwner) {
+		return apis.CreateOrganizationInvitation403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	teamID, err := teamOf(org, cast.Value(req.Body.TeamId))
+	if err != nil {
+		return apis.CreateOrganizationInvitation400JSONResponse{Message: msgTeamNotFound}, nil
+	}
+
+	if user, err := c.adapter.GetUserByEmail(ctx, req.Body.Email); err == nil {
+		if _, err := c.orgs.GetMember(ctx, org.ID, user.ID); err == nil {
+			return apis.CreateOrganizationInvitation400JSONResponse{Message: msgAlreadyMember}, nil
+		}
+	}
+
+	invitations, err := c.orgs.ListInvitations(ctx, org.ID)
+	if err != nil {
+		return apis.CreateOrganizationInvitation500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	var invitation adapters.GothInvitation
+	for _, i := range invitations {
+		if i.IsPending() && strings.EqualFold(i.Email, req.Body.Email) {
+			invitation = i
+			break
+		}
+	}
+
+	switch {
+	case invitation.ID != uuid.Nil && !cast.Value(req.Body.Resend):
+		return apis.CreateOrganizationInvitation400JSONResponse{Message: msgAlreadyInvited}, nil
+	case invitation.ID != uuid.Nil:
+		// only owners are allowed to resend invitations of owners
+		if invitation.Role == adapters.RoleOwner && member.Role != adapters.RoleOwner {
+			return apis.CreateOrganizationInvitation403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+		}
+
+		if invitation.Role != role || cast.Value(invitation.TeamID) != cast.Value(teamID) {
+			return apis.CreateOrganizationInvitation400JSONResponse{Message: msgInvitationChanged}, nil
+		}
+
+		invitation.ExpiresAt = time.Now().Add(c.invitationExpiry)
+		invitation, err = c.orgs.UpdateInvitation(ctx, invitation)
+	default:
+		invitation, err = c.orgs.CreateInvitation(ctx, adapters.GothInvitation{
+			OrganizationID: org.ID,
+			Email:          req.Body.Email,
+			Role:           role,
+			Status:         adapters.InvitationStatusPending,
+			TeamID:         teamID,
+			InviterID:      session.UserID,
+			ExpiresAt:      time.Now().Add(c.invitationExpiry),
+		})
+	}
+
+	if err != nil {
+		return apis.CreateOrganizationInvitation500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	if err := c.sendInvitation(ctx, invitation, org, session.UserID); err != nil {
+		return apis.CreateOrganizationInvitation500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.CreateOrganizationInvitation200JSONResponse{
+		Id:             invitation.ID.String(),
+		Email:          invitation.Email,
+		Role:           string(invitation.Role),
+		Status:         string(invitation.Status),
+		OrganizationId: invitation.OrganizationID.String(),
+		InviterId:      invitation.InviterID.String(),
+		ExpiresAt:      invitation.ExpiresAt.Format(time.RFC3339),
+		CreatedAt:      invitation.CreatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+// (POST /organization/leave).
+func (c *APIController) PostOrganizationLeave(ctx context.Context, req apis.PostOrganizationLeaveRequestObject) (apis.PostOrganizationLeaveResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationLeave401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationLeave500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil || utilx.Empty(req.Body.OrganizationId) {
+		return apis.PostOrganizationLeave400JSONResponse{Message: msgMissingOrg}, nil
+	}
+
+	org, member, err := c.organizationFor(ctx, session, &req.Body.OrganizationId, nil)
+	if err != nil {
+		return apis.PostOrganizationLeave404JSONResponse{Message: cast.Ptr(msgOrgNotFound)}, nil
+	}
+
+	last, err := c.lastOwner(ctx, org.ID, member)
+	if err != nil {
+		return apis.PostOrganizationLeave500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	if last {
+		return apis.PostOrganizationLeave400JSONResponse{Message: msgLastOwner}, nil
+	}
+
+	if err := c.orgs.RemoveMember(ctx, org.ID, member.UserID); err != nil {
+		return apis.PostOrganizationLeave500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	res := apis.PostOrganizationLeave200JSONResponse{}
+	res.Member.Id = member.ID.String()
+	res.Member.OrganizationId = member.OrganizationID.String()
+	res.Member.UserId = member.UserID.String()
+	res.Member.Role = string(member.Role)
+
+	return res, nil
+}
+
+// (GET /organization/list).
+func (c *APIController) GetOrganizationList(ctx context.Context, _ apis.GetOrganizationListRequestObject) (apis.GetOrganizationListResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.GetOrganizationList401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.GetOrganizationList500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	orgs, err := c.orgs.ListOrganizations(ctx, session.UserID)
+	if err != nil {
+		return apis.GetOrganizationList500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.GetOrganizationList200JSONResponse(slices.Map(toOrganization, orgs...)), nil
+}
+
+// (GET /organization/list-invitations).
+func (c *APIController) GetOrganizationListInvitations(ctx context.Context, req apis.GetOrganizationListInvitationsRequestObject) (apis.GetOrganizationListInvitationsResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.GetOrganizationListInvitations401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.GetOrganizationListInvitations500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	org, _, err := c.organizationFor(ctx, session, req.Params.OrganizationId, nil)
+	if errors.Is(err, errMissingOrganization) {
+		return apis.GetOrganizationListInvitations400JSONResponse{Message: msgMissingOrg}, nil
+	}
+
+	if err != nil {
+		return apis.GetOrganizationListInvitations404JSONResponse{Message: cast.Ptr(msgOrgNotFound)}, nil
+	}
+
+	invitations, err := c.orgs.ListInvitations(ctx, org.ID)
+	if err != nil {
+		return apis.GetOrganizationListInvitations500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.GetOrganizationListInvitations200JSONResponse(slices.Map(toInvitation, invitations...)), nil
+}
+
+// (GET /organization/list-members).
+func (c *APIController) GetOrganizationListMembers(_ context.Context, _ apis.GetOrganizationListMembersRequestObject) (apis.GetOrganizationListMembersResponseObject, error) {
+	return nil, ErrNotImplemented
+}
+
+// (GET /organization/list-team-members).
+func (c *APIController) GetOrganizationListTeamMembers(ctx context.Context, req apis.GetOrganizationListTeamMembersRequestObject) (apis.GetOrganizationListTeamMembersResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.GetOrganizationListTeamMembers401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.GetOrganizationListTeamMembers500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	teamID := cast.Value(req.Params.TeamId)
+	if utilx.Empty(teamID) && session.ActiveTeamID != nil {
+		teamID = session.ActiveTeamID.String()
+	}
+
+	if utilx.Empty(teamID) {
+		return apis.GetOrganizationListTeamMembers400JSONResponse{Message: msgMissingTeam}, nil
+	}
+
+	team, _, _, err := c.teamFor(ctx, session, teamID)
+	if err != nil {
+		return apis.GetOrganizationListTeamMembers404JSONResponse{Message: cast.Ptr(msgTeamNotFound)}, nil
+	}
+
+	members, err := c.orgs.ListTeamMembers(ctx, team.ID)
+	if err != nil {
+		return apis.GetOrganizationListTeamMembers500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return toTeamMembers(members...), nil
+}
+
+// (GET /organization/list-teams).
+func (c *APIController) GetOrganizationListTeams(ctx context.Context, req apis.GetOrganizationListTeamsRequestObject) (apis.GetOrganizationListTeamsResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.GetOrganizationListTeams401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.GetOrganizationListTeams500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	org, _, err := c.organizationFor(ctx, session, req.Params.OrganizationId, nil)
+	if errors.Is(err, errMissingOrganization) {
+		return apis.GetOrganizationListTeams400JSONResponse{Message: msgMissingOrg}, nil
+	}
+
+	if err != nil {
+		return apis.GetOrganizationListTeams404JSONResponse{Message: cast.Ptr(msgOrgNotFound)}, nil
+	}
+
+	teams, err := c.orgs.ListTeams(ctx, org.ID)
+	if err != nil {
+		return apis.GetOrganizationListTeams500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	res := make(apis.GetOrganizationListTeams200JSONResponse, len(teams))
+	for i, t := range teams {
+		res[i].Id = t.ID.String()
+		res[i].Name = t.Name
+		res[i].OrganizationId = t.OrganizationID.String()
+		res[i].CreatedAt = t.CreatedAt
+		res[i].UpdatedAt = t.UpdatedAt
+	}
+
+	return res, nil
+}
+
+// (GET /organization/list-user-invitations).
+func (c *APIController) GetOrganizationListUserInvitations(ctx context.Context, _ apis.GetOrganizationListUserInvitationsRequestObject) (apis.GetOrganizationListUserInvitationsResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.GetOrganizationListUserInvitations401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.GetOrganizationListUserInvitations500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	user, err := c.adapter.GetUser(ctx, session.UserID)
+	if err != nil {
+		return apis.GetOrganizationListUserInvitations500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	invitations, err := c.orgs.ListUserInvitations(ctx, user.Email)
+	if err != nil {
+		return apis.GetOrganizationListUserInvitations500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return toUserInvitations(invitations...), nil
+}
+
+// (GET /organization/list-user-teams).
+func (c *APIController) GetOrganizationListUserTeams(ctx context.Context, _ apis.GetOrganizationListUserTeamsRequestObject) (apis.GetOrganizationListUserTeamsResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.GetOrganizationListUserTeams401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.GetOrganizationListUserTeams500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	teams, err := c.orgs.ListUserTeams(ctx, session.UserID)
+	if err != nil {
+		return apis.GetOrganizationListUserTeams500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	res := make(apis.GetOrganizationListUserTeams200JSONResponse, len(teams))
+	for i, t := range teams {
+		res[i] = toTeam(t)
+	}
+
+	return res, nil
+}
+
+// (POST /organization/reject-invitation).
+func (c *APIController) PostOrganizationRejectInvitation(ctx context.Context, req apis.PostOrganizationRejectInvitationRequestObject) (apis.PostOrganizationRejectInvitationResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationRejectInvitation401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationRejectInvitation500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil {
+		return apis.PostOrganizationRejectInvitation400JSONResponse{Message: msgMissingInvitation}, nil
+	}
+
+	invitation, err := c.invitationFor(ctx, session, req.Body.InvitationId)
+	if errors.Is(err, errInvitationMismatch) {
+		return apis.PostOrganizationRejectInvitation403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	if err != nil {
+		return apis.PostOrganizationRejectInvitation404JSONResponse{Message: cast.Ptr(msgInvitationNotFound)}, nil
+	}
+
+	if !invitation.IsPending() {
+		return apis.PostOrganizationRejectInvitation400JSONResponse{Message: msgInvitationInvalid}, nil
+	}
+
+	invitation.Status = adapters.InvitationStatusRejected
+
+	invitation, err = c.orgs.UpdateInvitation(ctx, invitation)
+	if err != nil {
+		return apis.PostOrganizationRejectInvitation500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostOrganizationRejectInvitation200JSONResponse{Invitation: cast.Ptr(toInvitation(invitation))}, nil
+}
+
+// (POST /organization/remove-member).
+func (c *APIController) PostOrganizationRemoveMember(ctx context.Context, req apis.PostOrganizationRemoveMemberRequestObject) (apis.PostOrganizationRemoveMemberResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationRemoveMember401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationRemoveMember500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil || utilx.Empty(req.Body.MemberIdOrEmail) {
+		return apis.PostOrganizationRemoveMember400JSONResponse{Message: msgMissingMember}, nil
+	}
+
+	org, actor, err := c.organizationFor(ctx, session, req.Body.OrganizationId, nil)
+	if errors.Is(err, errMissingOrganization) {
+		return apis.PostOrganizationRemoveMember400JSONResponse{Message: msgMissingOrg}, nil
+	}
+
+	if err != nil {
+		return apis.PostOrganizationRemoveMember404JSONResponse{Message: cast.Ptr(msgOrgNotFound)}, nil
+	}
+
+	members, err := c.orgs.ListMembers(ctx, org.ID)
+	if err != nil {
+		return apis.PostOrganizationRemoveMember500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	member, ok := slices.Find(func(m adapters.GothMember) bool {
+		return m.ID.String() == req.Body.MemberIdOrEmail || strings.EqualFold(m.User.Email, req.Body.MemberIdOrEmail)
+	}, members...)
+	if !ok {
+		return apis.PostOrganizationRemoveMember404JSONResponse{Message: cast.Ptr(msgMemberNotFound)}, nil
+	}
+
+	// members are always allowed to leave, only owners are allowed to remove owners
+	if member.UserID != session.UserID &&
+		(!c.policy.Can(actor.Role, access.ResourceMember, access.ActionDelete) || (member.Role == adapters.RoleOwner && actor.Role != adapters.RoleOwner)) {
+		return apis.PostOrganizationRemoveMember403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	last, err := c.lastOwner(ctx, org.ID, member)
+	if err != nil {
+		return apis.PostOrganizationRemoveMember500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	if last {
+		return apis.PostOrganizationRemoveMember400JSONResponse{Message: msgLastOwner}, nil
+	}
+
+	if err := c.orgs.RemoveMember(ctx, org.ID, member.UserID); err != nil {
+		return apis.PostOrganizationRemoveMember500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	res := apis.PostOrganizationRemoveMember200JSONResponse{}
+	res.Member.Id = member.ID.String()
+	res.Member.OrganizationId = member.OrganizationID.String()
+	res.Member.UserId = member.UserID.String()
+	res.Member.Role = string(member.Role)
+
+	return res, nil
+}
+
+// (POST /organization/remove-team).
+func (c *APIController) PostOrganizationRemoveTeam(ctx context.Context, req apis.PostOrganizationRemoveTeamRequestObject) (apis.PostOrganizationRemoveTeamResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationRemoveTeam401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationRemoveTeam500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil || utilx.Empty(req.Body.TeamId) {
+		return apis.PostOrganizationRemoveTeam400JSONResponse{Message: msgMissingTeam}, nil
+	}
+
+	team, org, member, err := c.teamFor(ctx, session, req.Body.TeamId)
+	if err != nil {
+		return apis.PostOrganizationRemoveTeam404JSONResponse{Message: cast.Ptr(msgTeamNotFound)}, nil
+	}
+
+	if utilx.NotEmpty(cast.Value(req.Body.OrganizationId)) && cast.Value(req.Body.OrganizationId) != org.ID.String() {
+		return apis.PostOrganizationRemoveTeam404JSONResponse{Message: cast.Ptr(msgTeamNotFound)}, nil
+	}
+
+	if !c.policy.Can(member.Role, access.ResourceTeam, access.ActionDelete) {
+		return apis.PostOrganizationRemoveTeam403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	if err := c.orgs.DeleteTeam(ctx, team.ID); err != nil {
+		return apis.PostOrganizationRemoveTeam500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostOrganizationRemoveTeam200JSONResponse{Message: msgTeamRemoved}, nil
+}
+
+// (POST /organization/remove-team-member).
+func (c *APIController) PostOrganizationRemoveTeamMember(ctx context.Context, req apis.PostOrganizationRemoveTeamMemberRequestObject) (apis.PostOrganizationRemoveTeamMemberResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationRemoveTeamMember401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationRemoveTeamMember500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil || utilx.Empty(req.Body.TeamId) || utilx.Empty(req.Body.UserId) {
+		return apis.PostOrganizationRemoveTeamMember400JSONResponse{Message: msgMissingTeamMember}, nil
+	}
+
+	team, _, actor, err := c.teamFor(ctx, session, req.Body.TeamId)
+	if err != nil {
+		return apis.PostOrganizationRemoveTeamMember404JSONResponse{Message: cast.Ptr(msgTeamNotFound)}, nil
+	}
+
+	userID, err := uuid.Parse(req.Body.UserId)
+	if err != nil {
+		return apis.PostOrganizationRemoveTeamMember404JSONResponse{Message: cast.Ptr(msgMemberNotFound)}, nil
+	}
+
+	// members are always allowed to leave a team
+	if userID != session.UserID && !c.policy.Can(actor.Role, access.ResourceTeam, access.ActionUpdate) {
+		return apis.PostOrganizationRemoveTeamMember403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	if !slices.Any(func(m adapters.GothTeamMember) bool { return m.UserID == userID }, team.Members...) {
+		return apis.PostOrganizationRemoveTeamMember404JSONResponse{Message: cast.Ptr(msgMemberNotFound)}, nil
+	}
+
+	if err := c.orgs.RemoveTeamMember(ctx, team.ID, userID); err != nil {
+		return apis.PostOrganizationRemoveTeamMember500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostOrganizationRemoveTeamMember200JSONResponse{Message: msgTeamMemberRemoved}, nil
+}
+
+// (POST /organization/set-active).
+// An empty organization ID and slug unsets the active organization.
+func (c *APIController) SetActiveOrganization(ctx context.Context, req apis.SetActiveOrganizationRequestObject) (apis.SetActiveOrganizationResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.SetActiveOrganization401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.SetActiveOrganization500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	body := cast.Value(req.Body)
+
+	if utilx.Empty(cast.Value(body.OrganizationId)) && utilx.Empty(cast.Value(body.OrganizationSlug)) {
+		if _, err := c.orgs.SetActiveOrganization(ctx, session.SessionToken, nil, nil); err != nil {
+			return apis.SetActiveOrganization500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+		}
+
+		return apis.SetActiveOrganization200JSONResponse{}, nil
+	}
+
+	org, _, err := c.organizationFor(ctx, session, body.OrganizationId, body.OrganizationSlug)
+	if err != nil {
+		return apis.SetActiveOrganization404JSONResponse{Message: cast.Ptr(msgOrgNotFound)}, nil
+	}
+
+	if _, err := c.orgs.SetActiveOrganization(ctx, session.SessionToken, cast.Ptr(org.ID), nil); err != nil {
+		return apis.SetActiveOrganization500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.SetActiveOrganization200JSONResponse(toOrganization(org)), nil
+}
+
+// (POST /organization/set-active-team).
+// An empty team ID unsets the active team.
+func (c *APIController) PostOrganizationSetActiveTeam(ctx context.Context, req apis.PostOrganizationSetActiveTeamRequestObject) (apis.PostOrganizationSetActiveTeamResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationSetActiveTeam401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationSetActiveTeam500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if session.ActiveOrganizationID == nil {
+		return apis.PostOrganizationSetActiveTeam400JSONResponse{Message: msgMissingOrg}, nil
+	}
+
+	org, _, err := c.organizationFor(ctx, session, nil, nil)
+	if err != nil {
+		return apis.PostOrganizationSetActiveTeam404JSONResponse{Message: cast.Ptr(msgOrgNotFound)}, nil
+	}
+
+	teamID, err := teamOf(org, cast.Value(cast.Value(req.Body).TeamId))
+	if err != nil {
+		return apis.PostOrganizationSetActiveTeam404JSONResponse{Message: cast.Ptr(msgTeamNotFound)}, nil
+	}
+
+	if teamID != nil {
+		team, err := c.orgs.GetTeam(ctx, *teamID)
+		if err != nil {
+			return apis.PostOrganizationSetActiveTeam404JSONResponse{Message: cast.Ptr(msgTeamNotFound)}, nil
+		}
+
+		// only members of a team can set it as their active team
+		if !slices.Any(func(m adapters.GothTeamMember) bool { return m.UserID == session.UserID }, team.Members...) {
+			return apis.PostOrganizationSetActiveTeam403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+		}
+	}
+
+	if _, err := c.orgs.SetActiveOrganization(ctx, session.SessionToken, cast.Ptr(org.ID), teamID); err != nil {
+		return apis.PostOrganizationSetActiveTeam500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	for _, t := range org.Teams {
+		if teamID != nil && t.ID == *teamID {
+			return apis.PostOrganizationSetActiveTeam200JSONResponse(toTeam(t)), nil
+		}
+	}
+
+	return apis.PostOrganizationSetActiveTeam200JSONResponse{}, nil
+}
+
+// (POST /organization/update).
+func (c *APIController) PostOrganizationUpdate(ctx context.Context, req apis.PostOrganizationUpdateRequestObject) (apis.PostOrganizationUpdateResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationUpdate401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationUpdate500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil {
+		return apis.PostOrganizationUpdate400JSONResponse{Message: msgMissingOrg}, nil
+	}
+
+	org, member, err := c.organizationFor(ctx, session, req.Body.OrganizationId, nil)
+	if errors.Is(err, errMissingOrganization) {
+		return apis.PostOrganizationUpdate400JSONResponse{Message: msgMissingOrg}, nil
+	}
+
+	if err != nil {
+		return apis.PostOrganizationUpdate404JSONResponse{Message: cast.Ptr(msgOrgNotFound)}, nil
+	}
+
+	if !c.policy.Can(member.Role, access.ResourceOrganization, access.ActionUpdate) {
+		return apis.PostOrganizationUpdate403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	data := req.Body.Data
+	org.Name = utilx.IfElse(utilx.NotEmpty(cast.Value(data.Name)), cast.Value(data.Name), org.Name)
+	org.Slug = utilx.IfElse(utilx.NotEmpty(cast.Value(data.Slug)), cast.Value(data.Slug), org.Slug)
+	org.Logo = utilx.IfElse(data.Logo != nil, data.Logo, org.Logo)
+	org.Metadata = utilx.IfElse(data.Metadata != nil, data.Metadata, org.Metadata)
+
+	org, err = c.orgs.UpdateOrganization(ctx, org)
+	if errors.Is(err, adapters.ErrSlugTaken) {
+		return apis.PostOrganizationUpdate400JSONResponse{Message: msgSlugTaken}, nil
+	}
+
+	if err != nil {
+		return apis.PostOrganizationUpdate500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostOrganizationUpdate200JSONResponse(toOrganization(org)), nil
+}
+
+// (POST /organization/update-member-role).
+func (c *APIController) UpdateOrganizationMemberRole(ctx context.Context, req apis.UpdateOrganizationMemberRoleRequestObject) (apis.UpdateOrganizationMemberRoleResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.UpdateOrganizationMemberRole401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.UpdateOrganizationMemberRole500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil || utilx.Empty(req.Body.MemberId) {
+		return apis.UpdateOrganizationMemberRole400JSONResponse{Message: msgMissingMember}, nil
+	}
+
+	role := adapters.Role(req.Body.Role)
+	if !c.policy.HasRole(role) {
+		return apis.UpdateOrganizationMemberRole400JSONResponse{Message: msgInvalidRole}, nil
+	}
+
+	org, actor, err := c.organizationFor(ctx, session, req.Body.OrganizationId, nil)
+	if errors.Is(err, errMissingOrganization) {
+		return apis.UpdateOrganizationMemberRole400JSONResponse{Message: msgMissingOrg}, nil
+	}
+
+	if err != nil {
+		return apis.UpdateOrganizationMemberRole404JSONResponse{Message: cast.Ptr(msgOrgNotFound)}, nil
+	}
+
+	if !c.policy.Can(actor.Role, access.ResourceMember, access.ActionUpdate) {
+		return apis.UpdateOrganizationMemberRole403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	memberID, err := uuid.Parse(req.Body.MemberId)
+	if err != nil {
+		return apis.UpdateOrganizationMemberRole404JSONResponse{Message: cast.Ptr(msgMemberNotFound)}, nil
+	}
+
+	member, err := c.orgs.GetMemberByID(ctx, memberID)
+	if err != nil || member.OrganizationID != org.ID {
+		return apis.UpdateOrganizationMemberRole404JSONResponse{Message: cast.Ptr(msgMemberNotFound)}, nil
+	}
+
+	// only owners are allowed to grant or revoke the owner role
+	if (role == adapters.RoleOwner || member.Role == adapters.RoleOwner) && actor.Role != adapters.RoleOwner {
+		return apis.UpdateOrganizationMemberRole403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	if role != adapters.RoleOwner {
+		last, err := c.lastOwner(ctx, org.ID, member)
+		if err != nil {
+			return apis.UpdateOrganizationMemberRole500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+		}
+
+		if last {
+			return apis.UpdateOrganizationMemberRole400JSONResponse{Message: msgLastOwner}, nil
+		}
+	}
+
+	member.Role = role
+
+	member, err = c.orgs.UpdateMember(ctx, member)
+	if err != nil {
+		return apis.UpdateOrganizationMemberRole500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	res := apis.UpdateOrganizationMemberRole200JSONResponse{}
+	res.Member.Id = member.ID.String()
+	res.Member.OrganizationId = member.OrganizationID.String()
+	res.Member.UserId = member.UserID.String()
+	res.Member.Role = string(member.Role)
+
+	return res, nil
+}
+
+// (POST /organization/update-team).
+func (c *APIController) PostOrganizationUpdateTeam(ctx context.Context, req apis.PostOrganizationUpdateTeamRequestObject) (apis.PostOrganizationUpdateTeamResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostOrganizationUpdateTeam401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.orgs == nil {
+		return apis.PostOrganizationUpdateTeam500JSONResponse{Message: cast.Ptr(msgNoOrganizations)}, nil
+	}
+
+	if req.Body == nil || utilx.Empty(req.Body.TeamId) {
+		return apis.PostOrganizationUpdateTeam400JSONResponse{Message: msgMissingTeam}, nil
+	}
+
+	team, _, member, err := c.teamFor(ctx, session, req.Body.TeamId)
+	if err != nil {
+		return apis.PostOrganizationUpdateTeam404JSONResponse{Message: cast.Ptr(msgTeamNotFound)}, nil
+	}
+
+	if !c.policy.Can(member.Role, access.ResourceTeam, access.ActionUpdate) {
+		return apis.PostOrganizationUpdateTeam403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	team.Name = utilx.IfElse(utilx.NotEmpty(cast.Value(req.Body.Data.Name)), cast.Value(req.Body.Data.Name), team.Name)
+
+	team, err = c.orgs.UpdateTeam(ctx, team)
+	if err != nil {
+		return apis.PostOrganizationUpdateTeam500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostOrganizationUpdateTeam200JSONResponse{
+		Id:             team.ID.String(),
+		Name:           team.Name,
+		OrganizationId: team.OrganizationID.String(),
+		CreatedAt:      team.CreatedAt,
+		UpdatedAt:      team.UpdatedAt,
+	}, nil
+}
+
+// (POST /refresh-token).
+func (c *APIController) PostRefreshToken(ctx context.Context, req apis.PostRefreshTokenRequestObject) (apis.PostRefreshTokenResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostRefreshToken401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if req.Body == nil {
+		return apis.PostRefreshToken404JSONResponse{Message: cast.Ptr(msgAccountNotFound)}, nil
+	}
+
+	if req.Body.UserId != nil && cast.Value(req.Body.UserId) != session.UserID.String() {
+		return apis.PostRefreshToken403JSONResponse{Message: cast.Ptr(msgForbidden)}, nil
+	}
+
+	account, err := c.accountFor(ctx, session, req.Body.ProviderId, req.Body.AccountId)
+	if err != nil {
+		return apis.PostRefreshToken404JSONResponse{Message: cast.Ptr(msgAccountNotFound)}, nil
+	}
+
+	account, err = providers.RefreshAccessToken(ctx, c.adapter, account)
+	if errors.Is(err, providers.ErrRefreshUnsupported) || errors.Is(err, providers.ErrMissingRefreshToken) {
+		return apis.PostRefreshToken403JSONResponse{Message: cast.Ptr(msgRefreshFailed)}, nil
+	}
+
+	if err != nil {
+		return apis.PostRefreshToken500JSONResponse{Message: cast.Ptr(msgRefreshFailed)}, nil
+	}
+
+	return apis.PostRefreshToken200JSONResponse(toTokens(account)), nil
+}
+
+// (POST /request-password-reset).
+func (c *APIController) RequestPasswordReset(_ context.Context, _ apis.RequestPasswordResetRequestObject) (apis.RequestPasswordResetResponseObject, error) {
+	return nil, ErrNotImplemented
+}
+
+// (POST /reset-password).
+func (c *APIController) ResetPassword(_ context.Context, _ apis.ResetPasswordRequestObject) (apis.ResetPasswordResponseObject, error) {
+	return nil, ErrNotImplemented
+}
+
+// (GET /reset-password/{token}).
+func (c *APIController) ResetPasswordCallback(_ context.Context, _ apis.ResetPasswordCallbackRequestObject) (apis.ResetPasswordCallbackResponseObject, error) {
+	return nil, ErrNotImplemented
+}
+
+// (POST /revoke-other-sessions).
+func (c *APIController) PostRevokeOtherSessions(ctx context.Context, _ apis.PostRevokeOtherSessionsRequestObject) (apis.PostRevokeOtherSessionsResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostRevokeOtherSessions401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	err := c.adapter.DeleteSessionsByUser(ctx, session.UserID, session.SessionToken)
+	if err != nil {
+		return apis.PostRevokeOtherSessions500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostRevokeOtherSessions200JSONResponse{Status: true}, nil
+}
+
+// (POST /revoke-personal-access-token).
+func (c *APIController) PostRevokePersonalAccessToken(ctx context.Context, req apis.PostRevokePersonalAccessTokenRequestObject) (apis.PostRevokePersonalAccessTokenResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostRevokePersonalAccessToken401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.tokens == nil {
+		return apis.PostRevokePersonalAccessToken500JSONResponse{Message: cast.Ptr(msgNoPersonalAccessTokens)}, nil
+	}
+
+	if req.Body == nil || req.Body.Id == "" {
+		return apis.PostRevokePersonalAccessToken400JSONResponse{Message: msgMissingPersonalAccessToken}, nil
+	}
+
+	id, err := uuid.Parse(req.Body.Id)
+	if err != nil {
+		return apis.PostRevokePersonalAccessToken400JSONResponse{Message: msgMissingPersonalAccessToken}, nil
+	}
+
+	// Only personal access tokens of the signed-in user can be revoked.
+	err = c.tokens.DeletePersonalAccessToken(ctx, id, session.UserID)
+	if err != nil {
+		return apis.PostRevokePersonalAccessToken404JSONResponse{Message: cast.Ptr(msgPersonalAccessTokenNotFound)}, nil
+	}
+
+	return apis.PostRevokePersonalAccessToken200JSONResponse{Status: true}, nil
+}
+
+// (POST /revoke-session).
+func (c *APIController) PostRevokeSession(ctx context.Context, req apis.PostRevokeSessionRequestObject) (apis.PostRevokeSessionResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostRevokeSession401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if req.Body == nil {
+		return apis.PostRevokeSession400JSONResponse{Message: msgSessionNotFound}, nil
+	}
+
+	id, err := uuid.Parse(req.Body.Id)
+	if err != nil {
+		return apis.PostRevokeSession400JSONResponse{Message: msgSessionNotFound}, nil
+	}
+
+	// Only sessions of the signed-in user can be revoked.
+	sessions, err := c.adapter.ListSessions(ctx, session.UserID)
+	if err != nil {
+		return apis.PostRevokeSession500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	revoke, ok := slices.Find(func(s adapters.GothSession) bool { return s.ID == id }, sessions...)
+	if !ok {
+		return apis.PostRevokeSession404JSONResponse{Message: cast.Ptr(msgSessionNotFound)}, nil
+	}
+
+	err = c.adapter.DeleteSession(ctx, revoke.SessionToken)
+	if err != nil {
+		return apis.PostRevokeSession500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostRevokeSession200JSONResponse{Status: true}, nil
+}
+
+// (POST /revoke-sessions).
+func (c *APIController) PostRevokeSessions(ctx context.Context, _ apis.PostRevokeSessionsRequestObject) (apis.PostRevokeSessionsResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostRevokeSessions401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	err := c.adapter.DeleteSessionsByUser(ctx, session.UserID)
+	if err != nil {
+		return apis.PostRevokeSessions500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostRevokeSessions200JSONResponse{Status: true}, nil
+}
+
+// (POST /revoke-trusted-device).
+func (c *APIController) PostRevokeTrustedDevice(ctx context.Context, req apis.PostRevokeTrustedDeviceRequestObject) (apis.PostRevokeTrustedDeviceResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostRevokeTrustedDevice401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.devices == nil {
+		return apis.PostRevokeTrustedDevice500JSONResponse{Message: cast.Ptr(msgNoTrustedDevices)}, nil
+	}
+
+	if req.Body == nil || req.Body.Id == "" {
+		return apis.PostRevokeTrustedDevice400JSONResponse{Message: msgMissingTrustedDevice}, nil
+	}
+
+	id, err := uuid.Parse(req.Body.Id)
+	if err != nil {
+		return apis.PostRevokeTrustedDevice400JSONResponse{Message: msgMissingTrustedDevice}, nil
+	}
+
+	// Only trusted devices of the signed-in user can be revoked.
+	err = c.devices.DeleteTrustedDevice(ctx, id, session.UserID)
+	if err != nil {
+		return apis.PostRevokeTrustedDevice404JSONResponse{Message: cast.Ptr(msgTrustedDeviceUnknown)}, nil
+	}
+
+	return apis.PostRevokeTrustedDevice200JSONResponse{Status: true}, nil
+}
+
+// (POST /revoke-trusted-devices).
+func (c *APIController) PostRevokeTrustedDevices(ctx context.Context, _ apis.PostRevokeTrustedDevicesRequestObject) (apis.PostRevokeTrustedDevicesResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostRevokeTrustedDevices401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if c.devices == nil {
+		return apis.PostRevokeTrustedDevices500JSONResponse{Message: cast.Ptr(msgNoTrustedDevices)}, nil
+	}
+
+	err := c.devices.DeleteTrustedDevices(ctx, session.UserID)
+	if err != nil {
+		return apis.PostRevokeTrustedDevices500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostRevokeTrustedDevices200JSONResponse{Status: true}, nil
+}
+
+// (POST /send-verification-email).
+func (c *APIController) SendVerificationEmail(_ context.Context, _ apis.SendVerificationEmailRequestObject) (apis.SendVerificationEmailResponseObject, error) {
+	return nil, ErrNotImplemented
+}
+
+// (POST /sign-in/email).
+func (c *APIController) SignInEmail(_ context.Context, _ apis.SignInEmailRequestObject) (apis.SignInEmailResponseObject, error) {
+	return nil, ErrNotImplemented
+}
+
+// (POST /sign-in/social/nonce).
+func (c *APIController) CreateSocialSignInNonce(ctx context.Context, req apis.CreateSocialSignInNonceRequestObject) (apis.CreateSocialSignInNonceResponseObject, error) {
+	if req.Body == nil || req.Body.Provider == "" {
+		return apis.CreateSocialSignInNonce400JSONResponse{Message: msgMissingProvider}, nil
+	}
+
+	provider, err := providers.GetProvider(req.Body.Provider)
+	if err != nil {
+		return apis.CreateSocialSignInNonce404JSONResponse{Message: cast.Ptr(msgProviderUnknown)}, nil
+	}
+
+	authenticator, ok := provider.(providers.IDTokenAuthenticator)
+	if !ok {
+		return apis.CreateSocialSignInNonce400JSONResponse{Message: msgIDTokenSignIn}, nil
+	}
+
+	nonce, err := authenticator.BeginIDTokenAuth(ctx, c.adapter)
+	if err != nil {
+		return apis.CreateSocialSignInNonce500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.CreateSocialSignInNonce200JSONResponse{
+		Nonce:     nonce.Nonce,
+		ExpiresAt: nonce.ExpiresAt,
+	}, nil
+}
+
+// (POST /sign-in/social).
+func (c *APIController) SocialSignIn(ctx context.Context, req apis.SocialSignInRequestObject) (apis.SocialSignInResponseObject, error) {
+	if req.Body == nil || req.Body.Provider == "" {
+		return apis.SocialSignIn400JSONResponse{Message: msgMissingProvider}, nil
+	}
+
+	// Without an ID token the user signs in with the handler to begin authentication.
+	if req.Body.IdToken == nil {
+		u, err := url.Parse(strings.ReplaceAll(c.beginAuthURL, ":provider", url.PathEscape(req.Body.Provider)))
+		if err != nil {
+			return apis.SocialSignIn500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+		}
+
+		if utilx.NotEmpty(cast.Value(req.Body.CallbackURL)) {
+			q := u.Query()
+			q.Set("redirect_uri", cast.Value(req.Body.CallbackURL))
+			u.RawQuery = q.Encode()
+		}
+
+		return apis.SocialSignIn200JSONResponse{
+			Redirect: !cast.Value(req.Body.DisableRedirect),
+			Url:      cast.Ptr(u.String()),
+		}, nil
+	}
+
+	provider, err := providers.GetProvider(req.Body.Provider)
+	if err != nil {
+		return apis.SocialSignIn404JSONResponse{Message: cast.Ptr(msgProviderUnknown)}, nil
+	}
+
+	authenticator, ok := provider.(providers.IDTokenAuthenticator)
+	if !ok {
+		return apis.SocialSignIn400JSONResponse{Message: msgIDTokenSignIn}, nil
+	}
+
+	token := providers.IDToken{
+		Token:        req.Body.IdToken.Token,
+		Nonce:        cast.Value(req.Body.IdToken.Nonce),
+		AccessToken:  cast.Value(req.Body.IdToken.AccessToken),
+		RefreshToken: cast.Value(req.Body.IdToken.RefreshToken),
+	}
+
+	if req.Body.IdToken.ExpiresAt != nil {
+		token.ExpiresAt = time.Unix(int64(*req.Body.IdToken.ExpiresAt), 0)
+	}
+
+	user, err := authenticator.CompleteIDTokenAuth(ctx, c.adapter, token)
+	if errors.Is(err, adapters.ErrAccountAlreadyLinked) {
+		return apis.SocialSignIn403JSONResponse{Message: cast.Ptr(msgAccountLinked)}, nil
+	}
+
+	if err != nil {
+		return apis.SocialSignIn401JSONResponse{Message: msgBadToken}, nil
+	}
+
+	session, err := c.createSession(ctx, user, provider.ID())
+	if errors.Is(err, errTwoFactorRequired) {
+		return apis.SocialSignIn403JSONResponse{Message: cast.Ptr(msgTwoFactorSignIn)}, nil
+	}
+
+	if err != nil {
+		return apis.SocialSignIn500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.SocialSignIn200JSONResponse{
+		Redirect:         false,
+		Token:            session.SessionToken,
+		TwoFactorPending: cast.Ptr(session.TwoFactorPending),
+		User:             toUser(user),
+	}, nil
+}
+
+// (POST /sign-out).
+func (c *APIController) SignOut(ctx context.Context, _ apis.SignOutRequestObject) (apis.SignOutResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.SignOut401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	err := c.adapter.DeleteSession(ctx, session.SessionToken)
+	if err != nil {
+		return apis.SignOut500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.SignOut200JSONResponse{Success: cast.Ptr(true)}, nil
+}
+
+// (POST /sign-up/email).
+func (c *APIController) SignUpWithEmailAndPassword(_ context.Context, _ apis.SignUpWithEmailAndPasswordRequestObject) (apis.SignUpWithEmailAndPasswordResponseObject, error) {
+	return nil, ErrNotImplemented
+}
+
+// (POST /unlink-account).
+func (c *APIController) PostUnlinkAccount(ctx context.Context, req apis.PostUnlinkAccountRequestObject) (apis.PostUnlinkAccountResponseObject, error) {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return apis.PostUnlinkAccount401JSONResponse{Message: msgUnauthorized}, nil
+	}
+
+	if req.Body == nil || req.Body.ProviderId == "" {
+		return apis.PostUnlinkAccount400JSONResponse{Message: msgMissingProvider}, nil
+	}
+
+	account, err := c.accountFor(ctx, session, req.Body.ProviderId, req.Body.AccountId)
+	if err != nil {
+		return apis.PostUnlinkAccount404JSONResponse{Message: cast.Ptr(msgAccountNotFound)}, nil
+	}
+
+	err = c.adapter.UnlinkAccount(ctx, account.ID, session.UserID)
+	if errors.Is(err, adapters.ErrLastAccount) {
+		return apis.PostUnlinkAccount400JSONResponse{Message: msgLastAccount}, nil
+	}
+
+	if err != nil {
+		return apis.PostUnlinkAccount500JSONResponse{Message: cast.Ptr(msgInternalError)}, nil
+	}
+
+	return apis.PostUnlinkAccount200JSONResponse{Status: cast.Ptr(true)}, nil
+}
+
+// (POST /update-user).
+func (c *APIController) UpdateUser(_ context.Context, _ apis.UpdateUserRequestObject) (apis.UpdateUserResponseObject, error) {
+	return nil, ErrNotImplemented
+}
+
+// (GET /verify-email).
+func (c *APIController) GetVerifyEmail(_ context.Context, _ apis.GetVerifyEmailRequestObject) (apis.GetVerifyEmailResponseObject, error) {
+	return nil, ErrNotImplemented
+}
+
+// accountFor returns the account of the signed-in user for the provider.
+// If an account ID is given, it has to match the account.
+func (c *APIController) accountFor(ctx context.Context, session adapters.GothSession, provider string, accountID *string) (adapters.GothAccount, error) {
+	account, err := c.adapter.GetAccount(ctx, session.UserID, provider)
+	if err != nil {
+		return adapters.GothAccount{}, err
+	}
+
+	if accountID != nil && cast.Value(accountID) != account.ID.String() {
+		return adapters.GothAccount{}, errAccountMismatch
+	}
+
+	return account, nil
+}
+
+// organizationFor returns the organization by ID or slug and the membership of the signed-in user.
+// It defaults to the active organization of the session.
+// Organizations the user is not a member of are not found.
+func (c *APIController) organizationFor(ctx context.Context, session adapters.GothSession, orgID, slug *string) (adapters.GothOrganization, adapters.GothMember, error) {
+	var org adapters.GothOrganization
+	var err error
+
+	switch {
+	case utilx.NotEmpty(cast.Value(orgID)):
+		id, perr := uuid.Parse(cast.Value(orgID))
+		if perr != nil {
+			return adapters.GothOrganization{}, adapters.GothMember{}, perr
+		}
+		org, err = c.orgs.GetOrganization(ctx, id)
+	case utilx.NotEmpty(cast.Value(slug)):
+		org, err = c.orgs.GetOrganizationBySlug(ctx, cast.Value(slug))
+	case session.ActiveOrganizationID != nil:
+		org, err = c.orgs.GetOrganization(ctx, *session.ActiveOrganizationID)
+	default:
+		return adapters.GothOrganization{}, adapters.GothMember{}, errMissingOrganization
+	}
+
+	if err != nil {
+		return adapters.GothOrganization{}, adapters.GothMember{}, err
+	}
+
+	member, err := c.orgs.GetMember(ctx, org.ID, session.UserID)
+	if err != nil {
+		return adapters.GothOrganization{}, adapters.GothMember{}, err
+	}
+
+	return org, member, nil
+}
+
+// lastOwner returns true if the member is the only owner of the organization.
+func (c *APIController) lastOwner(ctx context.Context, orgID uuid.UUID, member adapters.GothMember) (bool, error) {
+	if member.Role != adapters.RoleOwner {
+		return false, nil
+	}
+
+	members, err := c.orgs.ListMembers(ctx, orgID)
+	if err != nil {
+		return false, err
+	}
+
+	return len(slices.Filter(func(m adapters.GothMember) bool { return m.Role == adapters.RoleOwner }, members...)) <= 1, nil
+}
+
+// teamFor returns the team by ID, its organization and the membership of the signed-in user.
+// Teams of organizations the user is not a member of are not found.
+func (c *APIController) teamFor(ctx context.Context, session adapters.GothSession, teamID string) (adapters.GothTeam, adapters.GothOrganization, adapters.GothMember, error) {
+	id, err := uuid.Parse(teamID)
+	if err != nil {
+		return adapters.GothTeam{}, adapters.GothOrganization{}, adapters.GothMember{}, err
+	}
+
+	team, err := c.orgs.GetTeam(ctx, id)
+	if err != nil {
+		return adapters.GothTeam{}, adapters.GothOrganization{}, adapters.GothMember{}, err
+	}
+
+	org, member, err := c.organizationFor(ctx, session, cast.Ptr(team.OrganizationID.String()), nil)
+	if err != nil {
+		return adapters.GothTeam{}, adapters.GothOrganization{}, adapters.GothMember{}, err
+	}
+
+	return team, org, member, nil
+}
+
+// invitationFor returns the invitation by ID if it is addressed to the signed-in user.
+func (c *APIController) invitationFor(ctx context.Context, session adapters.GothSession, invitationID string) (adapters.GothInvitation, error) {
+	id, err := uuid.Parse(invitationID)
+	if err != nil {
+		return adapters.GothInvitation{}, err
+	}
+
+	invitation, err := c.orgs.GetInvitation(ctx, id)
+	if err != nil {
+		return adapters.GothInvitation{}, err
+	}
+
+	user, err := c.adapter.GetUser(ctx, session.UserID)
+	if err != nil {
+		return adapters.GothInvitation{}, err
+	}
+
+	if !strings.EqualFold(user.Email, invitation.Email) {
+		return adapters.GothInvitation{}, errInvitationMismatch
+	}
+
+	return invitation, nil
+}
+
+// sendInvitation sends the invitation with the configured sender.
+func (c *APIController) sendInvitation(ctx context.Context, invitation adapters.GothInvitation, org adapters.GothOrganization, inviterID uuid.UUID) error {
+	if c.invitationSender == nil {
+		return nil
+	}
+
+	inviter, err := c.adapter.GetUser(ctx, inviterID)
+	if err != nil {
+		return err
+	}
+
+	return c.invitationSender(ctx, invitation, org, inviter)
+}
+
+// teamOf returns the ID of the team if it belongs to the organization.
+// An empty team ID returns no team.
+func teamOf(org adapters.GothOrganization, teamID string) (*uuid.UUID, error) {
+	if utilx.Empty(teamID) {
+		return nil, nil
+	}
+
+	for _, t := range org.Teams {
+		if t.ID.String() == teamID {
+			return cast.Ptr(t.ID), nil
+		}
+	}
+
+	return nil, errMissingTeam
+}
+
+// validScope returns true if the scope can be granted to a personal access token.
+// Scopes are stored comma-separated, and have to be one of the configured scopes, if any.
+func (c *APIController) validScope(scope string) bool {
+	if utilx.Empty(scope) || strings.ContainsAny(scope, ", ") {
+		return false
+	}
+
+	return len(c.tokenScopes) == 0 || slices.In(scope, c.tokenScopes...)
+}
+
+// defaultCreateSession creates a session of the user that expires after DefaultSessionExpiry.
+// Users with a second factor are refused, as the second factor cannot be verified without the middleware.
+func (c *APIController) defaultCreateSession(ctx context.Context, user adapters.GothUser, _ string) (adapters.GothSession, error) {
+	if store, ok := c.adapter.(adapters.TwoFactorAdapter); ok {
+		tf, err := store.GetTwoFactor(ctx, user.ID)
+		if err == nil && tf.Enabled {
+			return adapters.GothSession{}, errTwoFactorRequired
+		}
+	}
+
+	return c.adapter.CreateSession(ctx, user.ID, time.Now().Add(DefaultSessionExpiry))
+}
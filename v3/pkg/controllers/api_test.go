@@ -0,0 +1,264 @@
+package controllers_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+	"github.com/katallaxie/fiber-goth/v3/pkg/apis"
+	"github.com/katallaxie/fiber-goth/v3/pkg/controllers"
+
+	"github.com/google/uuid"
+)
+
+func TestRemoveMember(t *testing.T) {
+	adapter, org := setup(t)
+
+	owner := adapter.addMember(org.ID, adapters.RoleOwner)
+	admin := adapter.addMember(org.ID, adapters.RoleAdmin)
+	member := adapter.addMember(org.ID, adapters.RoleMember)
+	other := adapter.addMember(org.ID, adapters.RoleMember)
+	stranger := uuid.New()
+
+	tests := []struct {
+		name   string
+		actor  uuid.UUID
+		active *uuid.UUID
+		orgID  *string
+		member adapters.GothMember
+		want   apis.PostOrganizationRemoveMemberResponseObject
+	}{
+		{"missing organization", admin.UserID, nil, nil, other, apis.PostOrganizationRemoveMember400JSONResponse{}},
+		{"not a member", stranger, nil, orgID(org), other, apis.PostOrganizationRemoveMember404JSONResponse{}},
+		{"member removes member", member.UserID, nil, orgID(org), other, apis.PostOrganizationRemoveMember403JSONResponse{}},
+		{"admin removes owner", admin.UserID, nil, orgID(org), owner, apis.PostOrganizationRemoveMember403JSONResponse{}},
+		{"last owner leaves", owner.UserID, nil, orgID(org), owner, apis.PostOrganizationRemoveMember400JSONResponse{}},
+		{"member leaves", other.UserID, &org.ID, nil, other, apis.PostOrganizationRemoveMember200JSONResponse{}},
+		{"admin removes member", admin.UserID, &org.ID, nil, member, apis.PostOrganizationRemoveMember200JSONResponse{}},
+	}
+
+	c := controllers.NewAPIController(adapter)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := signedIn(tt.actor, tt.active)
+
+			res, err := c.PostOrganizationRemoveMember(ctx, apis.PostOrganizationRemoveMemberRequestObject{
+				Body: &apis.PostOrganizationRemoveMemberJSONRequestBody{MemberIdOrEmail: tt.member.ID.String(), OrganizationId: tt.orgID},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if reflect.TypeOf(res) != reflect.TypeOf(tt.want) {
+				t.Errorf("response = %T, want %T", res, tt.want)
+			}
+		})
+	}
+
+	if _, err := adapter.GetMember(context.Background(), org.ID, member.UserID); err == nil {
+		t.Error("the removed member is still a member of the organization")
+	}
+}
+
+func TestLeaveLastOwner(t *testing.T) {
+	adapter, org := setup(t)
+
+	owner := adapter.addMember(org.ID, adapters.RoleOwner)
+	c := controllers.NewAPIController(adapter)
+	ctx := signedIn(owner.UserID, nil)
+	req := apis.PostOrganizationLeaveRequestObject{Body: &apis.PostOrganizationLeaveJSONRequestBody{OrganizationId: org.ID.String()}}
+
+	res, err := c.PostOrganizationLeave(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := res.(apis.PostOrganizationLeave400JSONResponse); !ok {
+		t.Errorf("response = %T, want the last owner to stay", res)
+	}
+
+	adapter.addMember(org.ID, adapters.RoleOwner)
+
+	res, err = c.PostOrganizationLeave(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := res.(apis.PostOrganizationLeave200JSONResponse); !ok {
+		t.Errorf("response = %T, want the owner to leave", res)
+	}
+}
+
+func TestUpdateMemberRole(t *testing.T) {
+	adapter, org := setup(t)
+
+	owner := adapter.addMember(org.ID, adapters.RoleOwner)
+	admin := adapter.addMember(org.ID, adapters.RoleAdmin)
+	member := adapter.addMember(org.ID, adapters.RoleMember)
+
+	tests := []struct {
+		name   string
+		actor  uuid.UUID
+		member adapters.GothMember
+		role   adapters.Role
+		want   apis.UpdateOrganizationMemberRoleResponseObject
+	}{
+		{"member promotes member", member.UserID, member, adapters.RoleAdmin, apis.UpdateOrganizationMemberRole403JSONResponse{}},
+		{"admin grants owner", admin.UserID, member, adapters.RoleOwner, apis.UpdateOrganizationMemberRole403JSONResponse{}},
+		{"admin demotes owner", admin.UserID, owner, adapters.RoleMember, apis.UpdateOrganizationMemberRole403JSONResponse{}},
+		{"last owner demotes itself", owner.UserID, owner, adapters.RoleAdmin, apis.UpdateOrganizationMemberRole400JSONResponse{}},
+		{"unknown role", owner.UserID, member, adapters.Role("guest"), apis.UpdateOrganizationMemberRole400JSONResponse{}},
+		{"admin promotes member", admin.UserID, member, adapters.RoleAdmin, apis.UpdateOrganizationMemberRole200JSONResponse{}},
+		{"owner grants owner", owner.UserID, admin, adapters.RoleOwner, apis.UpdateOrganizationMemberRole200JSONResponse{}},
+		{"owner demotes itself", owner.UserID, owner, adapters.RoleAdmin, apis.UpdateOrganizationMemberRole200JSONResponse{}},
+	}
+
+	c := controllers.NewAPIController(adapter)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := signedIn(tt.actor, nil)
+
+			res, err := c.UpdateOrganizationMemberRole(ctx, apis.UpdateOrganizationMemberRoleRequestObject{
+				Body: &apis.UpdateOrganizationMemberRoleJSONRequestBody{MemberId: tt.member.ID.String(), OrganizationId: orgID(org), Role: string(tt.role)},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if reflect.TypeOf(res) != reflect.TypeOf(tt.want) {
+				t.Errorf("response = %T, want %T", res, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetOrganizationNotMember(t *testing.T) {
+	adapter, org := setup(t)
+
+	adapter.addMember(org.ID, adapters.RoleOwner)
+	c := controllers.NewAPIController(adapter)
+	ctx := signedIn(uuid.New(), nil)
+
+	res, err := c.GetOrganization(ctx, apis.GetOrganizationRequestObject{Params: apis.GetOrganizationParams{OrganizationSlug: &org.Slug}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := res.(apis.GetOrganization404JSONResponse); !ok {
+		t.Errorf("response = %T, want the organization to be hidden from non-members", res)
+	}
+}
+
+func setup(t *testing.T) (*memAdapter, adapters.GothOrganization) {
+	t.Helper()
+
+	adapter := &memAdapter{
+		orgs:    map[uuid.UUID]adapters.GothOrganization{},
+		members: map[uuid.UUID]adapters.GothMember{},
+	}
+
+	org := adapters.GothOrganization{ID: uuid.New(), Name: "Acme", Slug: "acme"}
+	adapter.orgs[org.ID] = org
+
+	return adapter, org
+}
+
+// signedIn returns the context of a request of the signed-in user with the active organization.
+func signedIn(userID uuid.UUID, active *uuid.UUID) context.Context {
+	return controllers.ContextWithSession(context.Background(), adapters.GothSession{
+		UserID:               userID,
+		ActiveOrganizationID: active,
+		ExpiresAt:            time.Now().Add(time.Hour),
+	})
+}
+
+func orgID(org adapters.GothOrganization) *string {
+	id := org.ID.String()
+	return &id
+}
+
+type memAdapter struct {
+	orgs    map[uuid.UUID]adapters.GothOrganization
+	members map[uuid.UUID]adapters.GothMember
+
+	adapters.UnimplementedAdapter
+	adapters.UnimplementedOrganizationAdapter
+}
+
+func (a *memAdapter) addMember(orgID uuid.UUID, role adapters.Role) adapters.GothMember {
+	member := adapters.GothMember{ID: uuid.New(), OrganizationID: orgID, UserID: uuid.New(), Role: role}
+	a.members[member.ID] = member
+
+	return member
+}
+
+func (a *memAdapter) GetOrganization(_ context.Context, id uuid.UUID) (adapters.GothOrganization, error) {
+	org, ok := a.orgs[id]
+	if !ok {
+		return adapters.GothOrganization{}, errors.New("missing organization")
+	}
+
+	return org, nil
+}
+
+func (a *memAdapter) GetOrganizationBySlug(_ context.Context, slug string) (adapters.GothOrganization, error) {
+	for _, org := range a.orgs {
+		if org.Slug == slug {
+			return org, nil
+		}
+	}
+
+	return adapters.GothOrganization{}, errors.New("missing organization")
+}
+
+func (a *memAdapter) GetMember(_ context.Context, orgID, userID uuid.UUID) (adapters.GothMember, error) {
+	for _, m := range a.members {
+		if m.OrganizationID == orgID && m.UserID == userID {
+			return m, nil
+		}
+	}
+
+	return adapters.GothMember{}, errors.New("missing member")
+}
+
+func (a *memAdapter) GetMemberByID(_ context.Context, id uuid.UUID) (adapters.GothMember, error) {
+	member, ok := a.members[id]
+	if !ok {
+		return adapters.GothMember{}, errors.New("missing member")
+	}
+
+	return member, nil
+}
+
+func (a *memAdapter) UpdateMember(_ context.Context, member adapters.GothMember) (adapters.GothMember, error) {
+	a.members[member.ID] = member
+	return member, nil
+}
+
+func (a *memAdapter) ListMembers(_ context.Context, orgID uuid.UUID) ([]adapters.GothMember, error) {
+	var members []adapters.GothMember
+
+	for _, m := range a.members {
+		if m.OrganizationID == orgID {
+			members = append(members, m)
+		}
+	}
+
+	return members, nil
+}
+
+func (a *memAdapter) RemoveMember(_ context.Context, orgID, userID uuid.UUID) error {
+	for id, m := range a.members {
+		if m.OrganizationID == orgID && m.UserID == userID {
+			delete(a.members, id)
+			return nil
+		}
+	}
+
+	return errors.New("missing member")
+}
@@ -0,0 +1,31 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+)
+
+// The contextKey type is unexported to prevent collisions with context keys defined in
+// other packages.
+type contextKey int
+
+// The keys for the values in context.
+const (
+	sessionKey contextKey = iota
+)
+
+// ContextWithSession returns a new context containing the session of the signed-in user.
+func ContextWithSession(ctx context.Context, session adapters.GothSession) context.Context {
+	return context.WithValue(ctx, sessionKey, session)
+}
+
+// SessionFromContext returns the session of the signed-in user from the context.
+func SessionFromContext(ctx context.Context) (adapters.GothSession, bool) {
+	session, ok := ctx.Value(sessionKey).(adapters.GothSession)
+	if !ok || !session.IsValid() {
+		return adapters.GothSession{}, false
+	}
+
+	return session, true
+}
@@ -0,0 +1,227 @@
+package controllers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/katallaxie/fiber-goth/v3/access"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+	"github.com/katallaxie/fiber-goth/v3/pkg/apis"
+
+	"github.com/katallaxie/pkg/cast"
+	"github.com/katallaxie/pkg/utilx"
+)
+
+// toUser converts a user of the adapter to the API model.
+func toUser(u adapters.GothUser) apis.User {
+	return apis.User{
+		Id:            cast.Ptr(u.ID.String()),
+		Name:          u.Name,
+		Email:         u.Email,
+		EmailVerified: u.EmailVerified,
+		Image:         u.Image,
+		CreatedAt:     u.CreatedAt,
+		UpdatedAt:     u.UpdatedAt,
+	}
+}
+
+// toSession converts a session of the adapter to the API model.
+func toSession(s adapters.GothSession) apis.Session {
+	return apis.Session{
+		Id:                   s.ID.String(),
+		UserId:               s.UserID.String(),
+		ExpiresAt:            s.ExpiresAt,
+		IpAddress:            optional(s.IPAddress),
+		UserAgent:            optional(s.UserAgent),
+		ActiveOrganizationId: optionalID(s.ActiveOrganizationID),
+		ActiveTeamId:         optionalID(s.ActiveTeamID),
+		CreatedAt:            s.CreatedAt,
+		UpdatedAt:            s.UpdatedAt,
+	}
+}
+
+// toTrustedDevice converts a trusted device of the adapter to the API model.
+func toTrustedDevice(d adapters.GothTrustedDevice) apis.TrustedDevice {
+	return apis.TrustedDevice{
+		Id:         d.ID.String(),
+		UserId:     d.UserID.String(),
+		IpAddress:  optional(d.IPAddress),
+		UserAgent:  optional(d.UserAgent),
+		ExpiresAt:  d.ExpiresAt,
+		LastUsedAt: d.LastUsedAt,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+// toPersonalAccessToken converts a personal access token of the adapter to the API model.
+func toPersonalAccessToken(t adapters.GothPersonalAccessToken) apis.PersonalAccessToken {
+	return apis.PersonalAccessToken{
+		Id:             t.ID.String(),
+		Name:           t.Name,
+		Prefix:         t.Prefix,
+		UserId:         t.UserID.String(),
+		OrganizationId: optionalID(t.OrganizationID),
+		Scopes:         t.Scopes(),
+		ExpiresAt:      t.ExpiresAt,
+		LastUsedAt:     t.LastUsedAt,
+		CreatedAt:      t.CreatedAt,
+	}
+}
+
+// toAccounts converts the accounts of the adapter to the API model.
+func toAccounts(accounts ...adapters.GothAccount) apis.ListUserAccounts200JSONResponse {
+	res := make(apis.ListUserAccounts200JSONResponse, len(accounts))
+
+	for i, a := range accounts {
+		res[i].Id = a.ID.String()
+		res[i].AccountId = cast.Value(a.ProviderAccountID)
+		res[i].ProviderId = a.Provider
+		res[i].UserId = cast.Value(a.UserID).String()
+		res[i].Scopes = strings.FieldsFunc(cast.Value(a.Scope), func(r rune) bool { return r == ' ' || r == ',' })
+		res[i].CreatedAt = a.CreatedAt
+		res[i].UpdatedAt = a.UpdatedAt
+	}
+
+	return res
+}
+
+// toTokens converts the tokens of an account to the API model.
+// The refresh token is never handed out to the client.
+func toTokens(a adapters.GothAccount) apis.PostGetAccessToken200JSONResponse {
+	return apis.PostGetAccessToken200JSONResponse{
+		AccessToken:          a.AccessToken,
+		AccessTokenExpiresAt: a.ExpiresAt,
+		IdToken:              a.IDToken,
+		TokenType:            a.TokenType,
+	}
+}
+
+// toOrganization converts an organization of the adapter to the API model.
+func toOrganization(o adapters.GothOrganization) apis.Organization {
+	return apis.Organization{
+		Id:        cast.Ptr(o.ID.String()),
+		Name:      o.Name,
+		Slug:      o.Slug,
+		Logo:      o.Logo,
+		Metadata:  o.Metadata,
+		CreatedAt: o.CreatedAt,
+	}
+}
+
+// toTeam converts a team of the adapter to the API model.
+func toTeam(t adapters.GothTeam) apis.Team {
+	return apis.Team{
+		Id:             cast.Ptr(t.ID.String()),
+		Name:           t.Name,
+		OrganizationId: t.OrganizationID.String(),
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      cast.Ptr(t.UpdatedAt),
+	}
+}
+
+// toTeamMembers converts the members of a team to the API model.
+func toTeamMembers(members ...adapters.GothTeamMember) apis.GetOrganizationListTeamMembers200JSONResponse {
+	res := make(apis.GetOrganizationListTeamMembers200JSONResponse, len(members))
+
+	for i, m := range members {
+		res[i].Id = m.ID.String()
+		res[i].TeamId = m.TeamID.String()
+		res[i].UserId = m.UserID.String()
+		res[i].CreatedAt = m.CreatedAt
+	}
+
+	return res
+}
+
+// toMember converts a member of the adapter to the API model.
+func toMember(m adapters.GothMember) apis.Member {
+	return apis.Member{
+		Id:             cast.Ptr(m.ID.String()),
+		OrganizationId: m.OrganizationID.String(),
+		UserId:         m.UserID.String(),
+		Role:           string(m.Role),
+		CreatedAt:      m.CreatedAt,
+	}
+}
+
+// toInvitation converts an invitation of the adapter to the API model.
+func toInvitation(i adapters.GothInvitation) apis.Invitation {
+	return apis.Invitation{
+		Id:             cast.Ptr(i.ID.String()),
+		OrganizationId: i.OrganizationID.String(),
+		Email:          i.Email,
+		Role:           cast.Ptr(string(i.Role)),
+		Status:         string(i.Status),
+		TeamId:         optionalID(i.TeamID),
+		InviterId:      i.InviterID.String(),
+		ExpiresAt:      i.ExpiresAt,
+		CreatedAt:      i.CreatedAt,
+	}
+}
+
+// toUserInvitations converts the invitations of a user to the API model.
+func toUserInvitations(invitations ...adapters.GothInvitation) apis.GetOrganizationListUserInvitations200JSONResponse {
+	res := make(apis.GetOrganizationListUserInvitations200JSONResponse, len(invitations))
+
+	for i, inv := range invitations {
+		res[i].Id = inv.ID.String()
+		res[i].Email = inv.Email
+		res[i].Role = string(inv.Role)
+		res[i].Status = string(inv.Status)
+		res[i].OrganizationId = inv.OrganizationID.String()
+		res[i].OrganizationName = inv.Organization.Name
+		res[i].InviterId = inv.InviterID.String()
+		res[i].TeamId = optionalID(inv.TeamID)
+		res[i].ExpiresAt = inv.ExpiresAt.Format(time.RFC3339)
+		res[i].CreatedAt = inv.CreatedAt.Format(time.RFC3339)
+	}
+
+	return res
+}
+
+// toStatements converts the permissions of a request to statements.
+// The permissions map resources to lists of actions.
+func toStatements(permissions map[string]interface{}) (access.Statements, error) {
+	if len(permissions) == 0 {
+		return nil, errInvalidPermissions
+	}
+
+	statements := make(access.Statements, len(permissions))
+
+	for resource, v := range permissions {
+		actions, ok := v.([]interface{})
+		if !ok {
+			return nil, errInvalidPermissions
+		}
+
+		for _, a := range actions {
+			action, ok := a.(string)
+			if !ok {
+				return nil, errInvalidPermissions
+			}
+
+			statements[resource] = append(statements[resource], action)
+		}
+	}
+
+	return statements, nil
+}
+
+// optionalID returns the string of an optional ID.
+func optionalID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+
+	return cast.Ptr(id.String())
+}
+
+// optional returns a pointer to the value, or nil if it is empty.
+func optional[T comparable](v T) *T {
+	if utilx.Empty(v) {
+		return nil
+	}
+
+	return cast.Ptr(v)
+}
@@ -0,0 +1,72 @@
+// Package spec provides the OpenAPI document of the REST API and a page to explore it.
+// The page is Swagger UI, which is bundled with the pinned version of github.com/swaggo/files.
+package spec
+
+import (
+	"bytes"
+	_ "embed"
+	"html/template"
+	"io/fs"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/katallaxie/fiber-goth/v3/pkg/apis"
+	"github.com/oasdiff/yaml"
+	swaggerFiles "github.com/swaggo/files/v2"
+)
+
+//go:embed explorer.html
+var explorer string
+
+var explorerTemplate = template.Must(template.New("explorer").Parse(explorer))
+
+// Document returns the OpenAPI document of the REST API.
+// The server of the document is set to the base URL the API is mounted at.
+func Document(baseURL string) (*openapi3.T, error) {
+	doc, err := apis.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Servers = openapi3.Servers{{URL: baseURL}}
+
+	return doc, nil
+}
+
+// JSON returns the OpenAPI document of the REST API as JSON.
+func JSON(baseURL string) ([]byte, error) {
+	doc, err := Document(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return doc.MarshalJSON()
+}
+
+// YAML returns the OpenAPI document of the REST API as YAML.
+func YAML(baseURL string) ([]byte, error) {
+	b, err := JSON(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.JSONToYAML(b)
+}
+
+// Explorer returns the page to explore the API with Swagger UI.
+// The page loads the OpenAPI document from the spec URL and the files of Swagger UI from the assets URL,
+// which serves Assets. Requests are sent with the cookies of the browser.
+func Explorer(specURL, assetsURL string) ([]byte, error) {
+	var b bytes.Buffer
+
+	err := explorerTemplate.Execute(&b, struct{ SpecURL, AssetsURL string }{SpecURL: specURL, AssetsURL: assetsURL})
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// Assets returns the files of Swagger UI that the page to explore the API loads.
+func Assets() fs.FS {
+	return swaggerFiles.FS
+}
@@ -0,0 +1,128 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+
+	"golang.org/x/oauth2"
+)
+
+// ParamDeviceCode is the parameter of the device code to complete the device authorization grant.
+const ParamDeviceCode = "device_code"
+
+// The error codes of the token end-point while the device is not authorized, see RFC 8628 section 3.5.
+const (
+	// DeviceAuthorizationPending is returned until the user has authorized the device.
+	DeviceAuthorizationPending = "authorization_pending"
+	// DeviceSlowDown is returned if the device polls too often, the interval has to be increased by 5 seconds.
+	DeviceSlowDown = "slow_down"
+	// DeviceAccessDenied is returned if the user has denied the authorization of the device.
+	DeviceAccessDenied = "access_denied"
+	// DeviceExpiredToken is returned if the device code has expired.
+	DeviceExpiredToken = "expired_token"
+)
+
+const (
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+	maxTokenResponse    = 1 << 20
+)
+
+var (
+	// ErrMissingDeviceCode is returned when the device code is missing.
+	ErrMissingDeviceCode = errors.New("missing device code")
+	// ErrMissingAccessToken is returned when the token end-point responds without an access token.
+	ErrMissingAccessToken = errors.New("missing access token")
+)
+
+// DeviceAuthorizer is implemented by providers that support the device authorization grant (RFC 8628),
+// e.g. to sign in on a CLI with the browser of another device.
+type DeviceAuthorizer interface {
+	// DeviceAuth requests a device code and a user code to enter at the verification URI of the provider.
+	DeviceAuth(ctx context.Context) (*oauth2.DeviceAuthResponse, error)
+	// CompleteDeviceAuth polls the token end-point of the provider once with the "device_code" parameter
+	// and completes the authentication once the user has authorized the device.
+	// Until then an *oauth2.RetrieveError with the error code of the end-point is returned.
+	CompleteDeviceAuth(ctx context.Context, adapter adapters.Adapter, params AuthParams) (adapters.GothUser, error)
+}
+
+// DeviceAccessToken polls the token end-point of the config once for the access token of the device code.
+// Unlike the polling of the oauth2 package it does not wait, the device polls with the interval of the device code.
+func DeviceAccessToken(ctx context.Context, client *http.Client, config *oauth2.Config, deviceCode string) (*oauth2.Token, error) {
+	if deviceCode == "" {
+		return nil, ErrMissingDeviceCode
+	}
+
+	v := url.Values{
+		"client_id":   {config.ClientID},
+		"grant_type":  {deviceCodeGrantType},
+		"device_code": {deviceCode},
+	}
+
+	if config.ClientSecret != "" {
+		v.Set("client_secret", config.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, config.Endpoint.TokenURL, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxTokenResponse))
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		AccessToken      string `json:"access_token"`
+		TokenType        string `json:"token_type"`
+		RefreshToken     string `json:"refresh_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, &oauth2.RetrieveError{Response: resp, Body: body}
+	}
+
+	// GitHub responds to pending devices with an error and status OK.
+	if res.Error != "" || resp.StatusCode != http.StatusOK {
+		return nil, &oauth2.RetrieveError{Response: resp, Body: body, ErrorCode: res.Error, ErrorDescription: res.ErrorDescription}
+	}
+
+	if res.AccessToken == "" {
+		return nil, ErrMissingAccessToken
+	}
+
+	var extra map[string]any
+	if err := json.Unmarshal(body, &extra); err != nil {
+		return nil, err
+	}
+
+	token := &oauth2.Token{
+		AccessToken:  res.AccessToken,
+		TokenType:    res.TokenType,
+		RefreshToken: res.RefreshToken,
+	}
+
+	if res.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(res.ExpiresIn) * time.Second)
+	}
+
+	return token.WithExtra(extra), nil
+}
@@ -27,7 +27,12 @@ var (
 
 const NoopEmail = ""
 
-var _ providers.Provider = (*dexProvider)(nil)
+var (
+	_ providers.Provider         = (*dexProvider)(nil)
+	_ providers.TokenRefresher   = (*dexProvider)(nil)
+	_ providers.DeviceAuthorizer = (*dexProvider)(nil)
+	_ providers.Reauthenticator  = (*dexProvider)(nil)
+)
 
 // DefaultScopes holds the default scopes used for GitHub.
 var DefaultScopes = []string{"openid", "profile", "email", "groups"}
@@ -96,6 +101,11 @@ func (d *dexProvider) Type() providers.ProviderType {
 	return d.providerType
 }
 
+// Reauthenticates returns true, since the provider forwards "prompt=login" and "max_age" to the IdP.
+func (g *dexProvider) Reauthenticates() bool {
+	return true
+}
+
 type authIntent struct {
 	authURL      string
 	codeVerifier string
@@ -116,12 +126,13 @@ func (a *authIntent) GetAuthURL() (string, error) {
 }
 
 // BeginAuth starts the authentication process.
-func (g *dexProvider) BeginAuth(_ context.Context, _ adapters.Adapter, state string, _ providers.AuthParams) (providers.AuthIntent, error) {
+// The prompt, max_age and acr_values parameters are forwarded to request a new authentication.
+func (g *dexProvider) BeginAuth(_ context.Context, _ adapters.Adapter, state string, params providers.AuthParams) (providers.AuthIntent, error) {
 	verifier := oauth2.GenerateVerifier()
 
 	uri := g.config.AuthCodeURL(
 		state,
-		oauth2.S256ChallengeOption(verifier),
+		append(providers.AuthCodeOptions(params), oauth2.S256ChallengeOption(verifier))...,
 	)
 
 	return &authIntent{
@@ -131,8 +142,6 @@ func (g *dexProvider) BeginAuth(_ context.Context, _ adapters.Adapter, state str
 }
 
 // CompleteAuth completes the authentication process.
-//
-//nolint:gocyclo
 func (g *dexProvider) CompleteAuth(ctx context.Context, adapter adapters.Adapter, params providers.AuthParams) (adapters.GothUser, error) {
 	code := params.Get("code")
 	if code == "" {
@@ -144,6 +153,30 @@ func (g *dexProvider) CompleteAuth(ctx context.Context, adapter adapters.Adapter
 		return adapters.GothUser{}, err
 	}
 
+	return g.completeAuth(ctx, adapter, token, params)
+}
+
+// DeviceAuth requests a device code and a user code to enter at the device page of Dex.
+func (g *dexProvider) DeviceAuth(ctx context.Context) (*oauth2.DeviceAuthResponse, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, g.client)
+
+	return g.config.DeviceAuth(ctx)
+}
+
+// CompleteDeviceAuth completes the authentication of a device once the user has authorized it.
+func (g *dexProvider) CompleteDeviceAuth(ctx context.Context, adapter adapters.Adapter, params providers.AuthParams) (adapters.GothUser, error) {
+	token, err := providers.DeviceAccessToken(ctx, g.client, g.config, params.Get(providers.ParamDeviceCode))
+	if err != nil {
+		return adapters.GothUser{}, err
+	}
+
+	return g.completeAuth(ctx, adapter, token, params)
+}
+
+// completeAuth verifies the ID token of the token and creates or updates its user.
+//
+//nolint:gocyclo
+func (g *dexProvider) completeAuth(ctx context.Context, adapter adapters.Adapter, token *oauth2.Token, params providers.AuthParams) (adapters.GothUser, error) {
 	rawIDToken, ok := token.Extra("id_token").(string)
 	if !ok {
 		return adapters.GothUser{}, ErrMissingIDToken
@@ -170,17 +203,23 @@ func (g *dexProvider) CompleteAuth(ctx context.Context, adapter adapters.Adapter
 		return adapters.GothUser{}, err
 	}
 
+	auth, err := providers.AuthContextFromIDToken(idToken)
+	if err != nil {
+		return adapters.GothUser{}, err
+	}
+
 	user := adapters.GothUser{
 		Name:  claims.Name,
 		Email: claims.Email,
 		Accounts: []adapters.GothAccount{
 			{
-				Type:         adapters.AccountTypeOAuth2,
-				Provider:     g.ID(),
-				AccessToken:  cast.Ptr(token.AccessToken),
-				RefreshToken: cast.Ptr(token.RefreshToken),
-				ExpiresAt:    cast.Ptr(token.Expiry),
-				IDToken:      cast.Ptr(rawIDToken),
+				Type:              adapters.AccountTypeOAuth2,
+				Provider:          g.ID(),
+				ProviderAccountID: cast.Ptr(idToken.Subject),
+				AccessToken:       cast.Ptr(token.AccessToken),
+				RefreshToken:      cast.Ptr(token.RefreshToken),
+				ExpiresAt:         cast.Ptr(token.Expiry),
+				IDToken:           cast.Ptr(rawIDToken),
 			},
 		},
 	}
@@ -194,9 +233,19 @@ func (g *dexProvider) CompleteAuth(ctx context.Context, adapter adapters.Adapter
 		return adapters.GothUser{}, err
 	}
 
+	providers.RecordAuthContext(params, auth)
+
 	return user, nil
 }
 
+// RefreshToken returns a new token by using the refresh token.
+// Dex only issues refresh tokens if the offline_access scope is requested.
+func (g *dexProvider) RefreshToken(ctx context.Context, refreshToken string) (*oauth2.Token, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, g.client)
+
+	return g.config.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+}
+
 func newConfig(d *dexProvider, scopes ...string) *oauth2.Config {
 	c := &oauth2.Config{
 		ClientID:     d.clientID,
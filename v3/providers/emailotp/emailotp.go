@@ -0,0 +1,354 @@
+package emailotp
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+	"github.com/katallaxie/fiber-goth/v3/providers"
+
+	"github.com/katallaxie/pkg/cast"
+	"github.com/katallaxie/pkg/utilx"
+)
+
+var (
+	ErrMissingEmail   = errors.New("goth: missing or invalid email")
+	ErrMissingCode    = errors.New("goth: missing code")
+	ErrInvalidCode    = errors.New("goth: invalid or expired code")
+	ErrLocked         = errors.New("goth: too many attempts, try again later")
+	ErrResendCooldown = errors.New("goth: a code has been sent recently, try again later")
+	ErrSignUpDisabled = errors.New("goth: sign-up is disabled")
+)
+
+const (
+	// DefaultDigits is the default number of digits of a code.
+	DefaultDigits = 6
+	// MinDigits is the minimum number of digits of a code.
+	MinDigits = 6
+	// MaxDigits is the maximum number of digits of a code.
+	MaxDigits = 8
+	// DefaultExpiry is the default expiry of a code.
+	DefaultExpiry = 10 * time.Minute
+	// DefaultMaxAttempts is the default number of attempts to enter a code.
+	DefaultMaxAttempts = 5
+	// DefaultLockout is the default duration an email is locked after too many attempts.
+	DefaultLockout = 15 * time.Minute
+	// DefaultResendCooldown is the default duration before another code can be sent.
+	DefaultResendCooldown = time.Minute
+	// DefaultSentURL is the default URL the user is redirected to after the code has been sent.
+	DefaultSentURL = "/"
+)
+
+var _ providers.Provider = (*emailOTPProvider)(nil)
+
+// SendCode sends the code to sign in to the email.
+type SendCode func(ctx context.Context, email, code string) error
+
+type emailOTPProvider struct {
+	id             string
+	name           string
+	sentURL        string
+	digits         int
+	expiry         time.Duration
+	maxAttempts    int
+	lockout        time.Duration
+	resendCooldown time.Duration
+	autoSignUp     bool
+	send           SendCode
+	providerType   providers.ProviderType
+
+	providers.UnimplementedProvider
+}
+
+// Opt is a function that configures the email one-time code provider.
+type Opt func(*emailOTPProvider)
+
+// WithDigits sets the number of digits of a code, between 6 and 8.
+func WithDigits(n int) Opt {
+	return func(p *emailOTPProvider) {
+		p.digits = min(max(n, MinDigits), MaxDigits)
+	}
+}
+
+// WithExpiry sets the duration a code can be used to sign in.
+func WithExpiry(d time.Duration) Opt {
+	return func(p *emailOTPProvider) {
+		p.expiry = d
+	}
+}
+
+// WithMaxAttempts sets the number of attempts to enter a code before the email is locked.
+func WithMaxAttempts(n int) Opt {
+	return func(p *emailOTPProvider) {
+		p.maxAttempts = n
+	}
+}
+
+// WithLockout sets the duration an email is locked after too many attempts.
+func WithLockout(d time.Duration) Opt {
+	return func(p *emailOTPProvider) {
+		p.lockout = d
+	}
+}
+
+// WithResendCooldown sets the duration before another code can be sent to an email.
+func WithResendCooldown(d time.Duration) Opt {
+	return func(p *emailOTPProvider) {
+		p.resendCooldown = d
+	}
+}
+
+// WithSentURL sets the URL the user is redirected to after the code has been sent,
+// e.g. a page with a form to enter the code.
+func WithSentURL(url string) Opt {
+	return func(p *emailOTPProvider) {
+		p.sentURL = url
+	}
+}
+
+// WithAutoSignUp creates a user for emails that are not known yet.
+// Without it codes are only sent to existing users.
+func WithAutoSignUp() Opt {
+	return func(p *emailOTPProvider) {
+		p.autoSignUp = true
+	}
+}
+
+// New creates a new email one-time code provider.
+// The code to sign in is sent with the send function.
+func New(send SendCode, opts ...Opt) providers.Provider {
+	p := &emailOTPProvider{
+		id:             "emailotp",
+		name:           "Email Code",
+		sentURL:        DefaultSentURL,
+		digits:         DefaultDigits,
+		expiry:         DefaultExpiry,
+		maxAttempts:    DefaultMaxAttempts,
+		lockout:        DefaultLockout,
+		resendCooldown: DefaultResendCooldown,
+		send:           send,
+		providerType:   providers.ProviderTypeEmail,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// ID returns the provider's ID.
+func (e *emailOTPProvider) ID() string {
+	return e.id
+}
+
+// Name returns the provider's name.
+func (e *emailOTPProvider) Name() string {
+	return e.name
+}
+
+// Type returns the provider's type.
+func (e *emailOTPProvider) Type() providers.ProviderType {
+	return e.providerType
+}
+
+type authIntent struct {
+	authURL string
+}
+
+// GetAuthURL returns the URL for the authentication end-point.
+func (a *authIntent) GetAuthURL() (string, error) {
+	if a.authURL == "" {
+		return "", providers.ErrNoAuthURL
+	}
+
+	return a.authURL, nil
+}
+
+// CodeVerifier returns the code verifier for PKCE, if applicable.
+func (a *authIntent) CodeVerifier() string {
+	return ""
+}
+
+// BeginAuth starts the authentication process.
+// It sends a code to the email of the request, which replaces previous codes.
+// The sent URL receives the email and the state to post them with the code to the complete handler.
+func (e *emailOTPProvider) BeginAuth(ctx context.Context, adapter adapters.Adapter, state string, params providers.AuthParams) (providers.AuthIntent, error) {
+	email, err := normalizeEmail(params.Get("email"))
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(e.sentURL)
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	q.Set("email", email)
+	q.Set("state", state)
+	u.RawQuery = q.Encode()
+
+	intent := &authIntent{authURL: u.String()}
+
+	if !e.autoSignUp {
+		if _, err := adapter.GetUserByEmail(ctx, email); err != nil {
+			return intent, nil
+		}
+	}
+
+	if token, err := adapter.GetVerificationToken(ctx, identifier(email)); err == nil {
+		if e.locked(token) {
+			return nil, ErrLocked
+		}
+
+		if time.Since(token.CreatedAt) < e.resendCooldown {
+			return nil, ErrResendCooldown
+		}
+	}
+
+	code, err := e.generateCode()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := adapter.DeleteVerificationTokens(ctx, identifier(email)); err != nil {
+		return nil, err
+	}
+
+	_, err = adapter.CreateVerificationToken(ctx, adapters.GothVerificationToken{
+		Token:      hashCode(email, code),
+		Identifier: identifier(email),
+		ExpiresAt:  time.Now().Add(e.expiry),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.send(ctx, email, code); err != nil {
+		return nil, err
+	}
+
+	return intent, nil
+}
+
+// CompleteAuth completes the authentication process.
+// Every attempt to enter the code is counted before it is compared,
+// the code is used once and verifies the email of the user.
+func (e *emailOTPProvider) CompleteAuth(ctx context.Context, adapter adapters.Adapter, params providers.AuthParams) (adapters.GothUser, error) {
+	code := strings.TrimSpace(params.Get("code"))
+	if utilx.Empty(code) {
+		return adapters.GothUser{}, ErrMissingCode
+	}
+
+	email, err := normalizeEmail(params.Get("email"))
+	if err != nil {
+		return adapters.GothUser{}, err
+	}
+
+	token, err := adapter.GetVerificationToken(ctx, identifier(email))
+	if err != nil {
+		return adapters.GothUser{}, ErrInvalidCode
+	}
+
+	if e.locked(token) {
+		return adapters.GothUser{}, ErrLocked
+	}
+
+	if token.HasExpired() {
+		return adapters.GothUser{}, ErrInvalidCode
+	}
+
+	token, err = adapter.IncrementVerificationAttempts(ctx, token.Identifier, token.Token)
+	if err != nil {
+		return adapters.GothUser{}, ErrInvalidCode
+	}
+
+	if token.Attempts > e.maxAttempts {
+		return adapters.GothUser{}, ErrLocked
+	}
+
+	if subtle.ConstantTimeCompare([]byte(token.Token), []byte(hashCode(email, code))) != 1 {
+		return adapters.GothUser{}, ErrInvalidCode
+	}
+
+	if _, err := adapter.UseVerficationToken(ctx, token.Identifier, token.Token); err != nil {
+		return adapters.GothUser{}, ErrInvalidCode
+	}
+
+	user, err := adapter.GetUserByEmail(ctx, email)
+	if err != nil && !e.autoSignUp {
+		return adapters.GothUser{}, ErrSignUpDisabled
+	}
+
+	if err == nil && !cast.Value(user.EmailVerified) {
+		user.EmailVerified = cast.Ptr(true)
+
+		if _, err := adapter.UpdateUser(ctx, user); err != nil {
+			return adapters.GothUser{}, err
+		}
+	}
+
+	name, _, _ := strings.Cut(email, "@")
+
+	return adapter.CreateUser(ctx, adapters.GothUser{
+		Name:          name,
+		Email:         email,
+		EmailVerified: cast.Ptr(true),
+		Accounts: []adapters.GothAccount{
+			{
+				Type:              adapters.AccountTypeEmail,
+				Provider:          e.ID(),
+				ProviderAccountID: cast.Ptr(email),
+			},
+		},
+	})
+}
+
+// locked returns true if all attempts of the token have been used within the lockout.
+func (e *emailOTPProvider) locked(token adapters.GothVerificationToken) bool {
+	return token.Attempts >= e.maxAttempts && time.Since(token.UpdatedAt) < e.lockout
+}
+
+// generateCode returns a random code of the configured number of digits.
+func (e *emailOTPProvider) generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(e.digits)), nil)) //nolint:mnd
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", e.digits, n), nil
+}
+
+// identifier returns the identifier of the codes of the email.
+func identifier(email string) string {
+	return "email-otp:" + email
+}
+
+// normalizeEmail returns the lower-case address of the email.
+func normalizeEmail(email string) (string, error) {
+	addr, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil {
+		return "", ErrMissingEmail
+	}
+
+	return strings.ToLower(addr.Address), nil
+}
+
+// hashCode returns the hash of the code of an email which is stored instead of the code.
+// The email is part of the hash as codes of different emails may be the same.
+func hashCode(email, code string) string {
+	h := sha256.Sum256([]byte(email + ":" + code))
+
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,236 @@
+package magiclink
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+	"github.com/katallaxie/fiber-goth/v3/providers"
+
+	"github.com/katallaxie/pkg/cast"
+	"github.com/katallaxie/pkg/utilx"
+)
+
+var (
+	ErrMissingEmail   = errors.New("goth: missing or invalid email")
+	ErrMissingToken   = errors.New("goth: missing token")
+	ErrSignUpDisabled = errors.New("goth: sign-up is disabled")
+)
+
+const (
+	// DefaultExpiry is the default expiry of a magic link.
+	DefaultExpiry = 15 * time.Minute
+	// DefaultSentURL is the default URL the user is redirected to after the link has been sent.
+	DefaultSentURL = "/"
+)
+
+var _ providers.Provider = (*magicLinkProvider)(nil)
+
+// SendLink sends the link to sign in to the email.
+type SendLink func(ctx context.Context, email, link string) error
+
+type magicLinkProvider struct {
+	id           string
+	name         string
+	callbackURL  string
+	sentURL      string
+	expiry       time.Duration
+	autoSignUp   bool
+	send         SendLink
+	providerType providers.ProviderType
+
+	providers.UnimplementedProvider
+}
+
+// Opt is a function that configures the magic link provider.
+type Opt func(*magicLinkProvider)
+
+// WithExpiry sets the duration a link can be used to sign in.
+func WithExpiry(d time.Duration) Opt {
+	return func(p *magicLinkProvider) {
+		p.expiry = d
+	}
+}
+
+// WithSentURL sets the URL the user is redirected to after the link has been sent,
+// e.g. a page that asks the user to check the inbox.
+func WithSentURL(url string) Opt {
+	return func(p *magicLinkProvider) {
+		p.sentURL = url
+	}
+}
+
+// WithAutoSignUp creates a user for emails that are not known yet.
+// Without it links are only sent to existing users.
+func WithAutoSignUp() Opt {
+	return func(p *magicLinkProvider) {
+		p.autoSignUp = true
+	}
+}
+
+// New creates a new magic link provider.
+// The link to sign in points to the callback URL and is sent with the send function.
+func New(callbackURL string, send SendLink, opts ...Opt) providers.Provider {
+	p := &magicLinkProvider{
+		id:           "magiclink",
+		name:         "Magic Link",
+		callbackURL:  callbackURL,
+		sentURL:      DefaultSentURL,
+		expiry:       DefaultExpiry,
+		send:         send,
+		providerType: providers.ProviderTypeEmail,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// ID returns the provider's ID.
+func (m *magicLinkProvider) ID() string {
+	return m.id
+}
+
+// Name returns the provider's name.
+func (m *magicLinkProvider) Name() string {
+	return m.name
+}
+
+// Type returns the provider's type.
+func (m *magicLinkProvider) Type() providers.ProviderType {
+	return m.providerType
+}
+
+type authIntent struct {
+	authURL string
+}
+
+// GetAuthURL returns the URL for the authentication end-point.
+func (a *authIntent) GetAuthURL() (string, error) {
+	if a.authURL == "" {
+		return "", providers.ErrNoAuthURL
+	}
+
+	return a.authURL, nil
+}
+
+// CodeVerifier returns the code verifier for PKCE, if applicable.
+func (a *authIntent) CodeVerifier() string {
+	return ""
+}
+
+// BeginAuth starts the authentication process.
+// It sends a single-use link to sign in to the email of the request.
+// Unknown emails are not told apart from known emails if auto sign-up is disabled.
+func (m *magicLinkProvider) BeginAuth(ctx context.Context, adapter adapters.Adapter, state string, params providers.AuthParams) (providers.AuthIntent, error) {
+	email, err := normalizeEmail(params.Get("email"))
+	if err != nil {
+		return nil, err
+	}
+
+	intent := &authIntent{authURL: m.sentURL}
+
+	if !m.autoSignUp {
+		if _, err := adapter.GetUserByEmail(ctx, email); err != nil {
+			return intent, nil
+		}
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	token := hex.EncodeToString(b)
+
+	_, err = adapter.CreateVerificationToken(ctx, adapters.GothVerificationToken{
+		Token:      hashToken(token),
+		Identifier: email,
+		ExpiresAt:  time.Now().Add(m.expiry),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	q := url.Values{}
+	q.Set("token", token)
+	q.Set("email", email)
+	q.Set("state", state)
+
+	if err := m.send(ctx, email, m.callbackURL+"?"+q.Encode()); err != nil {
+		return nil, err
+	}
+
+	return intent, nil
+}
+
+// CompleteAuth completes the authentication process.
+// The token of the link is used once and verifies the email of the user.
+func (m *magicLinkProvider) CompleteAuth(ctx context.Context, adapter adapters.Adapter, params providers.AuthParams) (adapters.GothUser, error) {
+	token := params.Get("token")
+	if utilx.Empty(token) {
+		return adapters.GothUser{}, ErrMissingToken
+	}
+
+	email, err := normalizeEmail(params.Get("email"))
+	if err != nil {
+		return adapters.GothUser{}, err
+	}
+
+	if _, err := adapter.UseVerficationToken(ctx, email, hashToken(token)); err != nil {
+		return adapters.GothUser{}, err
+	}
+
+	user, err := adapter.GetUserByEmail(ctx, email)
+	if err != nil && !m.autoSignUp {
+		return adapters.GothUser{}, ErrSignUpDisabled
+	}
+
+	if err == nil && !cast.Value(user.EmailVerified) {
+		user.EmailVerified = cast.Ptr(true)
+
+		if _, err := adapter.UpdateUser(ctx, user); err != nil {
+			return adapters.GothUser{}, err
+		}
+	}
+
+	name, _, _ := strings.Cut(email, "@")
+
+	return adapter.CreateUser(ctx, adapters.GothUser{
+		Name:          name,
+		Email:         email,
+		EmailVerified: cast.Ptr(true),
+		Accounts: []adapters.GothAccount{
+			{
+				Type:              adapters.AccountTypeEmail,
+				Provider:          m.ID(),
+				ProviderAccountID: cast.Ptr(email),
+			},
+		},
+	})
+}
+
+// normalizeEmail returns the lower-case address of the email.
+func normalizeEmail(email string) (string, error) {
+	addr, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil {
+		return "", ErrMissingEmail
+	}
+
+	return strings.ToLower(addr.Address), nil
+}
+
+// hashToken returns the hash of a token which is stored instead of the token.
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(h[:])
+}
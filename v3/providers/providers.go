@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/katallaxie/fiber-goth/v3/adapters"
+
+	"golang.org/x/oauth2"
 )
 
 const (
@@ -53,6 +55,50 @@ type Provider interface {
 	CompleteAuth(ctx context.Context, adapter adapters.Adapter, params AuthParams) (adapters.GothUser, error)
 }
 
+// TokenRefresher is implemented by providers that are able to refresh access tokens.
+type TokenRefresher interface {
+	// RefreshToken returns a new token by using the refresh token.
+	RefreshToken(ctx context.Context, refreshToken string) (*oauth2.Token, error)
+}
+
+// TokenRevoker is implemented by providers that are able to revoke tokens.
+type TokenRevoker interface {
+	// RevokeToken revokes the access token and the grant it belongs to.
+	RevokeToken(ctx context.Context, accessToken string) error
+}
+
+// IDToken is an ID token that a client has received from the provider, e.g. with a native sign-in SDK,
+// and the tokens that have been issued with it.
+type IDToken struct {
+	// Token is the raw ID token.
+	Token string
+	// Nonce is the nonce the client has requested the ID token with, which has been issued by BeginIDTokenAuth.
+	Nonce string
+	// AccessToken is the access token that has been issued with the ID token, if any.
+	AccessToken string
+	// RefreshToken is the refresh token that has been issued with the ID token, if any.
+	RefreshToken string
+	// ExpiresAt is the expiry time of the access token, if any.
+	ExpiresAt time.Time
+}
+
+// IDTokenNonce is a nonce that a client requests an ID token with.
+type IDTokenNonce struct {
+	// Nonce is the value of the nonce.
+	Nonce string
+	// ExpiresAt is the time the nonce expires.
+	ExpiresAt time.Time
+}
+
+// IDTokenAuthenticator is implemented by providers that sign in users with an ID token of the provider,
+// e.g. of the native sign-in SDKs of Google or Apple on mobile devices.
+type IDTokenAuthenticator interface {
+	// BeginIDTokenAuth issues a nonce that can be used once to request an ID token with.
+	BeginIDTokenAuth(ctx context.Context, adapter adapters.Adapter) (IDTokenNonce, error)
+	// CompleteIDTokenAuth verifies the ID token and its nonce, and creates or updates the user and account of the token.
+	CompleteIDTokenAuth(ctx context.Context, adapter adapters.Adapter, token IDToken) (adapters.GothUser, error)
+}
+
 // AuthParams is the type of authentication parameters.
 type AuthParams interface {
 	//  Get returns the value of a parameter by name.
@@ -69,6 +115,13 @@ type AuthIntent interface {
 	CodeVerifier() string
 }
 
+// AuthFormIntent is implemented by intents that send the user with a form instead of a redirect,
+// e.g. the HTTP-POST binding of SAML.
+type AuthFormIntent interface {
+	// GetAuthForm returns the HTML page that submits the form to the authentication end-point.
+	GetAuthForm() ([]byte, error)
+}
+
 // PrioviderType is the type of provider.
 type ProviderType string
 
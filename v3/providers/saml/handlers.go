@@ -0,0 +1,20 @@
+package saml
+
+import (
+	"github.com/gofiber/fiber/v3"
+)
+
+// MetadataHandler returns a handler that serves the metadata of the service provider,
+// it should be mounted at the metadata URL.
+func (p *Provider) MetadataHandler() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		metadata, err := p.Metadata()
+		if err != nil {
+			return fiber.ErrInternalServerError
+		}
+
+		c.Set(fiber.HeaderContentType, "application/samlmetadata+xml")
+
+		return c.Send(metadata)
+	}
+}
@@ -0,0 +1,512 @@
+package saml
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+	"github.com/katallaxie/fiber-goth/v3/providers"
+
+	"github.com/crewjam/saml"
+	"github.com/katallaxie/pkg/cast"
+	"github.com/katallaxie/pkg/utilx"
+	xrv "github.com/mattermost/xml-roundtrip-validator"
+)
+
+var (
+	ErrMissingKey           = errors.New("goth: the key pair must have an RSA private key")
+	ErrMissingSSO           = errors.New("goth: the IdP has no single sign-on service for the binding")
+	ErrMissingIDPDescriptor = errors.New("goth: no entity with an IdP descriptor found")
+	ErrMissingResponse      = errors.New("goth: missing SAML response")
+	ErrUnsolicitedResponse  = errors.New("goth: the SAML response is not for a request of the provider")
+	ErrInvalidResponse      = errors.New("goth: invalid SAML response")
+	ErrAccountNotLinked     = errors.New("goth: the email belongs to a user that is not linked to the IdP")
+)
+
+const (
+	// DefaultRequestExpiry is the default duration the IdP has to respond to a request.
+	DefaultRequestExpiry = 5 * time.Minute
+	// HTTPRedirectBinding sends the request with a redirect to the IdP.
+	HTTPRedirectBinding = saml.HTTPRedirectBinding
+	// HTTPPostBinding sends the request with a form that is posted to the IdP.
+	HTTPPostBinding = saml.HTTPPostBinding
+)
+
+// DefaultEmailAttributes are the default names of the attributes with the email of the user.
+var DefaultEmailAttributes = []string{
+	"email",
+	"mail",
+	"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+	"urn:oid:0.9.2342.19200300.100.1.3",
+}
+
+// DefaultNameAttributes are the default names of the attributes with the name of the user.
+var DefaultNameAttributes = []string{
+	"name",
+	"displayName",
+	"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name",
+	"urn:oid:2.16.840.1.113730.3.1.241",
+	"cn",
+}
+
+var (
+	_ providers.Provider        = (*Provider)(nil)
+	_ providers.Reauthenticator = (*Provider)(nil)
+)
+
+// Provider is a SAML 2.0 service provider.
+type Provider struct {
+	id              string
+	name            string
+	binding         string
+	requestExpiry   time.Duration
+	emailAttributes []string
+	nameAttributes  []string
+	domains         []string
+	providerType    providers.ProviderType
+	sp              *saml.ServiceProvider
+
+	providers.UnimplementedProvider
+}
+
+// Opt is a function that configures the SAML provider.
+type Opt func(*Provider)
+
+// WithID sets the ID of the provider, e.g. to use multiple IdPs.
+func WithID(id string) Opt {
+	return func(p *Provider) {
+		p.id = id
+	}
+}
+
+// WithName sets the name of the provider.
+func WithName(name string) Opt {
+	return func(p *Provider) {
+		p.name = name
+	}
+}
+
+// WithBinding sets the binding that sends the request to the IdP,
+// either HTTPRedirectBinding (default) or HTTPPostBinding.
+func WithBinding(binding string) Opt {
+	return func(p *Provider) {
+		p.binding = binding
+	}
+}
+
+// WithSignedRequests signs the requests to the IdP with the key of the service provider.
+func WithSignedRequests() Opt {
+	return func(p *Provider) {
+		p.sp.SignatureMethod = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	}
+}
+
+// WithRequestExpiry sets the duration the IdP has to respond to a request.
+func WithRequestExpiry(d time.Duration) Opt {
+	return func(p *Provider) {
+		p.requestExpiry = d
+	}
+}
+
+// WithEmailAttributes sets the names of the attributes with the email of the user.
+func WithEmailAttributes(names ...string) Opt {
+	return func(p *Provider) {
+		p.emailAttributes = names
+	}
+}
+
+// WithNameAttributes sets the names of the attributes with the name of the user.
+func WithNameAttributes(names ...string) Opt {
+	return func(p *Provider) {
+		p.nameAttributes = names
+	}
+}
+
+// WithDomains sets the email domains the IdP is trusted for.
+// Users with an email of the domains are linked to the IdP on their first sign-in with it,
+// users with other emails can only sign in with the IdP after they linked it.
+func WithDomains(domains ...string) Opt {
+	return func(p *Provider) {
+		p.domains = domains
+	}
+}
+
+// New creates a new SAML provider.
+//
+// The metadata URL is the entity ID of the service provider and should serve the metadata of the provider.
+// The callback URL is the assertion consumer service that receives the responses of the IdP.
+// The key pair signs the metadata and the requests and decrypts encrypted assertions.
+func New(metadataURL, callbackURL string, keyPair tls.Certificate, idpMetadata *saml.EntityDescriptor, opts ...Opt) (*Provider, error) {
+	key, ok := keyPair.PrivateKey.(*rsa.PrivateKey)
+	if !ok || len(keyPair.Certificate) == 0 {
+		return nil, ErrMissingKey
+	}
+
+	cert, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := url.Parse(metadataURL)
+	if err != nil {
+		return nil, err
+	}
+
+	acs, err := url.Parse(callbackURL)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		id:              "saml",
+		name:            "SAML",
+		binding:         HTTPRedirectBinding,
+		requestExpiry:   DefaultRequestExpiry,
+		emailAttributes: DefaultEmailAttributes,
+		nameAttributes:  DefaultNameAttributes,
+		providerType:    providers.ProviderTypeSAML,
+		sp: &saml.ServiceProvider{
+			EntityID:          metadataURL,
+			Key:               key,
+			Certificate:       cert,
+			HTTPClient:        providers.DefaultClient,
+			MetadataURL:       *metadata,
+			AcsURL:            *acs,
+			IDPMetadata:       idpMetadata,
+			AuthnNameIDFormat: saml.UnspecifiedNameIDFormat,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	if p.sp.GetSSOBindingLocation(p.binding) == "" {
+		return nil, ErrMissingSSO
+	}
+
+	return p, nil
+}
+
+// FetchMetadata fetches the metadata of the IdP from the URL.
+func FetchMetadata(ctx context.Context, metadataURL string) (*saml.EntityDescriptor, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := providers.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("goth: failed to fetch IdP metadata: %s", res.Status)
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseMetadata(data)
+}
+
+// ReadMetadata reads the metadata of the IdP from the file.
+func ReadMetadata(path string) (*saml.EntityDescriptor, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseMetadata(data)
+}
+
+// ParseMetadata parses the metadata of the IdP.
+// The metadata is either the entity of the IdP or a list of entities with the IdP.
+func ParseMetadata(data []byte) (*saml.EntityDescriptor, error) {
+	if err := xrv.Validate(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	var entities saml.EntitiesDescriptor
+	if err := xml.Unmarshal(data, &entities); err == nil {
+		for _, entity := range entities.EntityDescriptors {
+			if len(entity.IDPSSODescriptors) > 0 {
+				return &entity, nil
+			}
+		}
+
+		return nil, ErrMissingIDPDescriptor
+	}
+
+	var entity saml.EntityDescriptor
+	if err := xml.Unmarshal(data, &entity); err != nil {
+		return nil, err
+	}
+
+	if len(entity.IDPSSODescriptors) == 0 {
+		return nil, ErrMissingIDPDescriptor
+	}
+
+	return &entity, nil
+}
+
+// ID returns the provider's ID.
+func (p *Provider) ID() string {
+	return p.id
+}
+
+// Name returns the provider's name.
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// Type returns the provider's type.
+func (p *Provider) Type() providers.ProviderType {
+	return p.providerType
+}
+
+// Reauthenticates returns true, since the provider requests a new authentication with ForceAuthn.
+func (p *Provider) Reauthenticates() bool {
+	return true
+}
+
+// Metadata returns the metadata of the service provider to register it with the IdP.
+func (p *Provider) Metadata() ([]byte, error) {
+	return xml.MarshalIndent(p.sp.Metadata(), "", "  ")
+}
+
+type authIntent struct {
+	authURL  string
+	authForm []byte
+}
+
+// GetAuthURL returns the URL for the authentication end-point.
+func (a *authIntent) GetAuthURL() (string, error) {
+	if a.authURL == "" {
+		return "", providers.ErrNoAuthURL
+	}
+
+	return a.authURL, nil
+}
+
+// GetAuthForm returns the HTML page that posts the request to the IdP.
+func (a *authIntent) GetAuthForm() ([]byte, error) {
+	if a.authForm == nil {
+		return nil, providers.ErrNoAuthURL
+	}
+
+	return a.authForm, nil
+}
+
+// CodeVerifier returns the code verifier for PKCE, if applicable.
+func (a *authIntent) CodeVerifier() string {
+	return ""
+}
+
+// BeginAuth starts the authentication process.
+// The ID of the request is stored until the IdP responds, the state is sent as relay state.
+func (p *Provider) BeginAuth(ctx context.Context, adapter adapters.Adapter, state string, params providers.AuthParams) (providers.AuthIntent, error) {
+	req, err := p.sp.MakeAuthenticationRequest(p.sp.GetSSOBindingLocation(p.binding), p.binding, HTTPPostBinding)
+	if err != nil {
+		return nil, err
+	}
+
+	// A new authentication is requested with ForceAuthn, a single authentication context class reference is requested exactly.
+	if params.Get(providers.ParamPrompt) == providers.PromptLogin || params.Get(providers.ParamMaxAge) != "" {
+		req.ForceAuthn = cast.Ptr(true)
+	}
+
+	if acr := strings.Fields(params.Get(providers.ParamACRValues)); len(acr) == 1 {
+		req.RequestedAuthnContext = &saml.RequestedAuthnContext{Comparison: "exact", AuthnContextClassRef: acr[0]}
+	}
+
+	_, err = adapter.CreateVerificationToken(ctx, adapters.GothVerificationToken{
+		Token:      hashRequestID(req.ID),
+		Identifier: p.identifier(),
+		ExpiresAt:  time.Now().Add(p.requestExpiry),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if p.binding == HTTPPostBinding {
+		return &authIntent{authForm: req.Post(state)}, nil
+	}
+
+	u, err := req.Redirect(url.QueryEscape(state), p.sp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authIntent{authURL: u.String()}, nil
+}
+
+// CompleteAuth completes the authentication process.
+// The response must be for a pending request of the provider, which is used once,
+// and carry a signed assertion for the service provider that is valid now.
+func (p *Provider) CompleteAuth(ctx context.Context, adapter adapters.Adapter, params providers.AuthParams) (adapters.GothUser, error) {
+	encoded := params.Get("SAMLResponse")
+	if utilx.Empty(encoded) {
+		return adapters.GothUser{}, ErrMissingResponse
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return adapters.GothUser{}, ErrInvalidResponse
+	}
+
+	var res struct {
+		InResponseTo string `xml:"InResponseTo,attr"`
+	}
+	if err := xml.Unmarshal(raw, &res); err != nil {
+		return adapters.GothUser{}, ErrInvalidResponse
+	}
+
+	if utilx.Empty(res.InResponseTo) {
+		return adapters.GothUser{}, ErrUnsolicitedResponse
+	}
+
+	if _, err := adapter.UseVerficationToken(ctx, p.identifier(), hashRequestID(res.InResponseTo)); err != nil {
+		return adapters.GothUser{}, ErrUnsolicitedResponse
+	}
+
+	assertion, err := p.sp.ParseXMLResponse(raw, []string{res.InResponseTo})
+	if err != nil {
+		return adapters.GothUser{}, ErrInvalidResponse
+	}
+
+	if assertion.Subject == nil || assertion.Subject.NameID == nil || utilx.Empty(assertion.Subject.NameID.Value) {
+		return adapters.GothUser{}, ErrInvalidResponse
+	}
+	nameID := assertion.Subject.NameID
+
+	email := attribute(assertion, p.emailAttributes)
+	if utilx.Empty(email) && nameID.Format == string(saml.EmailAddressNameIDFormat) {
+		email = nameID.Value
+	}
+
+	if utilx.Empty(email) {
+		return adapters.GothUser{}, providers.ErrMissingPrimaryEmail
+	}
+
+	name := attribute(assertion, p.nameAttributes)
+	if utilx.Empty(name) {
+		name, _, _ = strings.Cut(email, "@")
+	}
+
+	user, err := p.user(ctx, adapter, nameID.Value, strings.ToLower(email), name)
+	if err != nil {
+		return adapters.GothUser{}, err
+	}
+
+	providers.RecordAuthContext(params, authContext(assertion))
+
+	return user, nil
+}
+
+// user returns the user of the name ID, or links the name ID to the user of the email.
+// An existing user of the email is only linked if the IdP is trusted for the domain of the email.
+func (p *Provider) user(ctx context.Context, adapter adapters.Adapter, nameID, email, name string) (adapters.GothUser, error) {
+	user, err := adapter.GetUserByAccount(ctx, p.ID(), nameID)
+	if errors.Is(err, adapters.ErrAccountAlreadyLinked) {
+		return adapters.GothUser{}, err
+	}
+
+	if err == nil {
+		return user, nil
+	}
+
+	if _, err := adapter.GetUserByEmail(ctx, email); err == nil && !p.trustedDomain(email) {
+		return adapters.GothUser{}, ErrAccountNotLinked
+	}
+
+	user, err = adapter.CreateUser(ctx, adapters.GothUser{Name: name, Email: email})
+	if err != nil {
+		return adapters.GothUser{}, err
+	}
+
+	_, err = adapter.LinkAccount(ctx, user.ID, adapters.GothAccount{
+		Type:              adapters.AccountTypeSAML,
+		Provider:          p.ID(),
+		ProviderAccountID: cast.Ptr(nameID),
+	})
+	if err != nil {
+		return adapters.GothUser{}, err
+	}
+
+	return user, nil
+}
+
+// trustedDomain returns true if the IdP is trusted for the domain of the email.
+func (p *Provider) trustedDomain(email string) bool {
+	_, domain, ok := strings.Cut(email, "@")
+
+	return ok && slices.ContainsFunc(p.domains, func(d string) bool { return strings.EqualFold(d, domain) })
+}
+
+// authContext returns the context of the authentication from the authentication statement of the assertion.
+func authContext(assertion *saml.Assertion) providers.AuthContext {
+	auth := providers.AuthContext{}
+
+	for _, statement := range assertion.AuthnStatements {
+		auth.AuthTime = statement.AuthnInstant
+
+		if ref := statement.AuthnContext.AuthnContextClassRef; ref != nil {
+			auth.ACR = ref.Value
+		}
+	}
+
+	return auth
+}
+
+// identifier returns the identifier of the pending requests of the provider.
+func (p *Provider) identifier() string {
+	return "saml-request:" + p.id
+}
+
+// attribute returns the first value of the first attribute of the assertion with one of the names.
+func attribute(assertion *saml.Assertion, names []string) string {
+	for _, name := range names {
+		for _, statement := range assertion.AttributeStatements {
+			for _, attr := range statement.Attributes {
+				if attr.Name != name && attr.FriendlyName != name {
+					continue
+				}
+
+				for _, value := range attr.Values {
+					if v := strings.TrimSpace(value.Value); v != "" {
+						return v
+					}
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// hashRequestID returns the hash of the ID of a request which is stored instead of the ID.
+func hashRequestID(id string) string {
+	h := sha256.Sum256([]byte(id))
+
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,438 @@
+package saml_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+	"github.com/katallaxie/fiber-goth/v3/providers/saml"
+
+	crewjam "github.com/crewjam/saml"
+	"github.com/google/uuid"
+)
+
+const (
+	metadataURL = "https://sp.example.com/saml/metadata"
+	callbackURL = "https://sp.example.com/auth/saml/callback"
+)
+
+func TestCompleteAuth(t *testing.T) {
+	idp, sp := newIdentityProvider(t), newKeyPair(t)
+	adapter := newAdapter()
+
+	p := newProvider(t, idp, sp)
+
+	res := respond(t, idp, beginAuth(t, p, adapter), alice())
+
+	user, err := p.CompleteAuth(context.Background(), adapter, params{"SAMLResponse": res})
+	if err != nil {
+		t.Fatalf("complete auth: %v", err)
+	}
+
+	if user.Email != "alice@example.com" {
+		t.Errorf("email = %q, want alice@example.com", user.Email)
+	}
+
+	if got := adapter.accounts["saml/alice"]; got != user.ID {
+		t.Errorf("account is linked to %s, want %s", got, user.ID)
+	}
+
+	// The name ID identifies the user, even if the email changes at the IdP.
+	session := alice()
+	session.CustomAttributes = []crewjam.Attribute{emailAttribute("alice@example.org")}
+
+	again, err := p.CompleteAuth(context.Background(), adapter, params{"SAMLResponse": respond(t, idp, beginAuth(t, p, adapter), session)})
+	if err != nil {
+		t.Fatalf("complete auth: %v", err)
+	}
+
+	if again.ID != user.ID {
+		t.Errorf("user = %s, want %s", again.ID, user.ID)
+	}
+}
+
+func TestCompleteAuthReplay(t *testing.T) {
+	idp, sp := newIdentityProvider(t), newKeyPair(t)
+	adapter := newAdapter()
+
+	p := newProvider(t, idp, sp)
+
+	res := respond(t, idp, beginAuth(t, p, adapter), alice())
+
+	if _, err := p.CompleteAuth(context.Background(), adapter, params{"SAMLResponse": res}); err != nil {
+		t.Fatalf("complete auth: %v", err)
+	}
+
+	_, err := p.CompleteAuth(context.Background(), adapter, params{"SAMLResponse": res})
+	if !errors.Is(err, saml.ErrUnsolicitedResponse) {
+		t.Errorf("replay: err = %v, want %v", err, saml.ErrUnsolicitedResponse)
+	}
+}
+
+func TestCompleteAuthUnsolicited(t *testing.T) {
+	idp, sp := newIdentityProvider(t), newKeyPair(t)
+	adapter := newAdapter()
+
+	p := newProvider(t, idp, sp)
+
+	// The IdP initiated the sign-in without a request of the provider.
+	res := respondUnsolicited(t, idp, alice())
+
+	_, err := p.CompleteAuth(context.Background(), adapter, params{"SAMLResponse": res})
+	if !errors.Is(err, saml.ErrUnsolicitedResponse) {
+		t.Errorf("idp-initiated: err = %v, want %v", err, saml.ErrUnsolicitedResponse)
+	}
+
+	// The response answers a request that the provider has not stored.
+	res = respond(t, idp, beginAuth(t, p, newAdapter()), alice())
+
+	_, err = p.CompleteAuth(context.Background(), adapter, params{"SAMLResponse": res})
+	if !errors.Is(err, saml.ErrUnsolicitedResponse) {
+		t.Errorf("unknown request: err = %v, want %v", err, saml.ErrUnsolicitedResponse)
+	}
+}
+
+func TestCompleteAuthBadSignature(t *testing.T) {
+	idp, sp := newIdentityProvider(t), newKeyPair(t)
+	adapter := newAdapter()
+
+	p := newProvider(t, idp, sp)
+
+	// The response is signed by another key than the key in the metadata of the IdP.
+	other := newIdentityProvider(t)
+	other.ServiceProviderProvider = idp.ServiceProviderProvider
+
+	res := respond(t, other, beginAuth(t, p, adapter), alice())
+
+	_, err := p.CompleteAuth(context.Background(), adapter, params{"SAMLResponse": res})
+	if !errors.Is(err, saml.ErrInvalidResponse) {
+		t.Errorf("other key: err = %v, want %v", err, saml.ErrInvalidResponse)
+	}
+
+	// The assertion is changed after it has been signed, it is sent unencrypted to change it.
+	sps := idp.ServiceProviderProvider.(*serviceProviders)
+	for i := range sps.metadata.SPSSODescriptors {
+		sps.metadata.SPSSODescriptors[i].KeyDescriptors = nil
+	}
+
+	res = respond(t, idp, beginAuth(t, p, adapter), alice())
+
+	raw, err := base64.StdEncoding.DecodeString(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(raw), "alice@example.com") {
+		t.Fatal("the assertion is not sent in plain text")
+	}
+	res = base64.StdEncoding.EncodeToString([]byte(strings.ReplaceAll(string(raw), "alice@example.com", "mallory@example.com")))
+
+	_, err = p.CompleteAuth(context.Background(), adapter, params{"SAMLResponse": res})
+	if !errors.Is(err, saml.ErrInvalidResponse) {
+		t.Errorf("tampered: err = %v, want %v", err, saml.ErrInvalidResponse)
+	}
+
+	if len(adapter.users) != 0 {
+		t.Errorf("users = %d, want 0", len(adapter.users))
+	}
+}
+
+func TestCompleteAuthExistingEmail(t *testing.T) {
+	idp, sp := newIdentityProvider(t), newKeyPair(t)
+	adapter := newAdapter()
+
+	existing, err := adapter.CreateUser(context.Background(), adapters.GothUser{Name: "Alice", Email: "alice@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The IdP is not trusted for the domain, so it must not sign in as the user of the email.
+	p := newProvider(t, idp, sp)
+
+	_, err = p.CompleteAuth(context.Background(), adapter, params{"SAMLResponse": respond(t, idp, beginAuth(t, p, adapter), alice())})
+	if !errors.Is(err, saml.ErrAccountNotLinked) {
+		t.Errorf("untrusted domain: err = %v, want %v", err, saml.ErrAccountNotLinked)
+	}
+
+	p = newProvider(t, idp, sp, saml.WithDomains("example.com"))
+
+	user, err := p.CompleteAuth(context.Background(), adapter, params{"SAMLResponse": respond(t, idp, beginAuth(t, p, adapter), alice())})
+	if err != nil {
+		t.Fatalf("trusted domain: %v", err)
+	}
+
+	if user.ID != existing.ID {
+		t.Errorf("user = %s, want %s", user.ID, existing.ID)
+	}
+
+	if got := adapter.accounts["saml/alice"]; got != existing.ID {
+		t.Errorf("account is linked to %s, want %s", got, existing.ID)
+	}
+}
+
+type params map[string]string
+
+func (p params) Get(key string) string {
+	return p[key]
+}
+
+func (p params) CodeVerifier() string {
+	return ""
+}
+
+type memAdapter struct {
+	users    map[uuid.UUID]adapters.GothUser
+	accounts map[string]uuid.UUID
+	tokens   map[string]adapters.GothVerificationToken
+
+	adapters.UnimplementedAdapter
+}
+
+func newAdapter() *memAdapter {
+	return &memAdapter{
+		users:    map[uuid.UUID]adapters.GothUser{},
+		accounts: map[string]uuid.UUID{},
+		tokens:   map[string]adapters.GothVerificationToken{},
+	}
+}
+
+func (a *memAdapter) CreateUser(_ context.Context, user adapters.GothUser) (adapters.GothUser, error) {
+	for _, u := range a.users {
+		if u.Email == user.Email {
+			return u, nil
+		}
+	}
+
+	user.ID = uuid.New()
+	a.users[user.ID] = user
+
+	return user, nil
+}
+
+func (a *memAdapter) GetUserByEmail(_ context.Context, email string) (adapters.GothUser, error) {
+	for _, u := range a.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+
+	return adapters.GothUser{}, errors.New("missing user")
+}
+
+func (a *memAdapter) GetUserByAccount(_ context.Context, provider, providerAccountID string) (adapters.GothUser, error) {
+	id, ok := a.accounts[provider+"/"+providerAccountID]
+	if !ok {
+		return adapters.GothUser{}, errors.New("missing user")
+	}
+
+	return a.users[id], nil
+}
+
+func (a *memAdapter) LinkAccount(_ context.Context, userID uuid.UUID, account adapters.GothAccount) (adapters.GothAccount, error) {
+	key := account.Provider + "/" + *account.ProviderAccountID
+	if id, ok := a.accounts[key]; ok && id != userID {
+		return adapters.GothAccount{}, adapters.ErrAccountAlreadyLinked
+	}
+
+	a.accounts[key] = userID
+
+	return account, nil
+}
+
+func (a *memAdapter) CreateVerificationToken(_ context.Context, token adapters.GothVerificationToken) (adapters.GothVerificationToken, error) {
+	a.tokens[token.Identifier+"/"+token.Token] = token
+	return token, nil
+}
+
+func (a *memAdapter) UseVerficationToken(_ context.Context, identifier, token string) (adapters.GothVerificationToken, error) {
+	t, ok := a.tokens[identifier+"/"+token]
+	if !ok || t.HasExpired() {
+		return adapters.GothVerificationToken{}, errors.New("missing token")
+	}
+
+	delete(a.tokens, identifier+"/"+token)
+
+	return t, nil
+}
+
+// serviceProviders returns the metadata of the service provider of the tests to the IdP.
+type serviceProviders struct {
+	metadata *crewjam.EntityDescriptor
+}
+
+func (s *serviceProviders) GetServiceProvider(_ *http.Request, id string) (*crewjam.EntityDescriptor, error) {
+	if s.metadata == nil || s.metadata.EntityID != id {
+		return nil, errors.New("unknown service provider")
+	}
+
+	return s.metadata, nil
+}
+
+func newKeyPair(t *testing.T) tls.Certificate {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "fiber-goth"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func newIdentityProvider(t *testing.T) *crewjam.IdentityProvider {
+	t.Helper()
+
+	keyPair := newKeyPair(t)
+
+	cert, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &crewjam.IdentityProvider{
+		Key:                     keyPair.PrivateKey,
+		Certificate:             cert,
+		MetadataURL:             url.URL{Scheme: "https", Host: "idp.example.com", Path: "/metadata"},
+		SSOURL:                  url.URL{Scheme: "https", Host: "idp.example.com", Path: "/sso"},
+		ServiceProviderProvider: &serviceProviders{},
+	}
+}
+
+// newProvider creates the provider for the IdP and registers it with the IdP.
+func newProvider(t *testing.T, idp *crewjam.IdentityProvider, keyPair tls.Certificate, opts ...saml.Opt) *saml.Provider {
+	t.Helper()
+
+	p, err := saml.New(metadataURL, callbackURL, keyPair, idp.Metadata(), opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := p.Metadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var metadata crewjam.EntityDescriptor
+	if err := xml.Unmarshal(data, &metadata); err != nil {
+		t.Fatal(err)
+	}
+
+	idp.ServiceProviderProvider = &serviceProviders{metadata: &metadata}
+
+	return p
+}
+
+func beginAuth(t *testing.T, p *saml.Provider, adapter adapters.Adapter) string {
+	t.Helper()
+
+	intent, err := p.BeginAuth(context.Background(), adapter, "state", params{})
+	if err != nil {
+		t.Fatalf("begin auth: %v", err)
+	}
+
+	authURL, err := intent.GetAuthURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return authURL
+}
+
+func alice() *crewjam.Session {
+	return &crewjam.Session{
+		ID:               "session",
+		CreateTime:       time.Now(),
+		ExpireTime:       time.Now().Add(time.Hour),
+		NameID:           "alice",
+		NameIDFormat:     string(crewjam.PersistentNameIDFormat),
+		CustomAttributes: []crewjam.Attribute{emailAttribute("alice@example.com")},
+	}
+}
+
+func emailAttribute(email string) crewjam.Attribute {
+	return crewjam.Attribute{Name: "email", Values: []crewjam.AttributeValue{{Type: "xs:string", Value: email}}}
+}
+
+// respond answers the request of the auth URL as the IdP and returns the encoded response.
+func respond(t *testing.T, idp *crewjam.IdentityProvider, authURL string, session *crewjam.Session) string {
+	t.Helper()
+
+	req, err := crewjam.NewIdpAuthnRequest(idp, httptest.NewRequest(http.MethodGet, authURL, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		t.Fatalf("validate request: %v", err)
+	}
+
+	return makeResponse(t, req, session)
+}
+
+// respondUnsolicited sends a response of the IdP that does not answer a request.
+func respondUnsolicited(t *testing.T, idp *crewjam.IdentityProvider, session *crewjam.Session) string {
+	t.Helper()
+
+	req := &crewjam.IdpAuthnRequest{
+		IDP:         idp,
+		HTTPRequest: httptest.NewRequest(http.MethodGet, idp.SSOURL.String(), nil),
+		Now:         crewjam.TimeNow(),
+	}
+
+	metadata, err := idp.ServiceProviderProvider.GetServiceProvider(req.HTTPRequest, metadataURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.ServiceProviderMetadata = metadata
+	req.SPSSODescriptor = &metadata.SPSSODescriptors[0]
+
+	for _, acs := range req.SPSSODescriptor.AssertionConsumerServices {
+		if acs.Binding == crewjam.HTTPPostBinding {
+			req.ACSEndpoint = &acs
+			break
+		}
+	}
+
+	return makeResponse(t, req, session)
+}
+
+func makeResponse(t *testing.T, req *crewjam.IdpAuthnRequest, session *crewjam.Session) string {
+	t.Helper()
+
+	if err := (crewjam.DefaultAssertionMaker{}).MakeAssertion(req, session); err != nil {
+		t.Fatal(err)
+	}
+
+	form, err := req.PostBinding()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return form.SAMLResponse
+}
@@ -0,0 +1,214 @@
+package webauthn
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	goth "github.com/katallaxie/fiber-goth/v3"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// Credential is a passkey of the signed-in user.
+type Credential struct {
+	// ID is the unique identifier of the passkey.
+	ID uuid.UUID `json:"id"`
+	// Name is the name of the passkey given by the user.
+	Name string `json:"name"`
+	// Transports are the transports the authenticator supports.
+	Transports []string `json:"transports"`
+	// AAGUID is the model identifier of the authenticator.
+	AAGUID uuid.UUID `json:"aaguid"`
+	// BackupEligible is true if the passkey can be synced.
+	BackupEligible bool `json:"backupEligible"`
+	// BackupState is true if the passkey is synced.
+	BackupState bool `json:"backupState"`
+	// LastUsedAt is the time the passkey was last used to sign in.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	// CreatedAt is the creation time of the passkey.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Mount registers the JSON endpoints of the ceremonies at the router.
+//
+//	POST   /register/options  options for navigator.credentials.create()
+//	POST   /register          verifies and stores a passkey, the "name" query parameter names it
+//	POST   /login/options     options for navigator.credentials.get()
+//	GET    /credentials       lists the passkeys
+//	DELETE /credentials/:id   deletes a passkey
+//
+// The endpoints to register and manage passkeys depend on the session attached by the Session middleware.
+// The sign-in is completed by posting the response of navigator.credentials.get()
+// as "credential" form value to the complete handler.
+func (p *Provider) Mount(router fiber.Router, adapter adapters.Adapter) {
+	router.Post("/register/options", p.registrationOptions(adapter))
+	router.Post("/register", p.register(adapter))
+	router.Post("/login/options", p.loginOptions(adapter))
+	router.Get("/credentials", p.listCredentials(adapter))
+	router.Delete("/credentials/:id", p.deleteCredential(adapter))
+}
+
+func (p *Provider) registrationOptions(adapter adapters.Adapter) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		user, err := sessionUser(c, adapter)
+		if err != nil {
+			return err
+		}
+
+		creation, err := p.BeginRegistration(c, adapter, user)
+		if err != nil {
+			return toError(err)
+		}
+
+		return c.JSON(creation)
+	}
+}
+
+func (p *Provider) register(adapter adapters.Adapter) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		user, err := sessionUser(c, adapter)
+		if err != nil {
+			return err
+		}
+
+		credential, err := p.FinishRegistration(c, adapter, user, c.Query("name"), c.Body())
+		if err != nil {
+			return toError(err)
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(toResponse(credential))
+	}
+}
+
+func (p *Provider) loginOptions(adapter adapters.Adapter) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		assertion, err := p.BeginLogin(c, adapter)
+		if err != nil {
+			return toError(err)
+		}
+
+		return c.JSON(assertion)
+	}
+}
+
+func (p *Provider) listCredentials(adapter adapters.Adapter) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		user, err := sessionUser(c, adapter)
+		if err != nil {
+			return err
+		}
+
+		store, err := credentialStore(adapter)
+		if err != nil {
+			return toError(err)
+		}
+
+		credentials, err := store.ListWebAuthnCredentials(c, user.ID)
+		if err != nil {
+			return toError(err)
+		}
+
+		res := make([]Credential, 0, len(credentials))
+		for _, credential := range credentials {
+			res = append(res, toResponse(credential))
+		}
+
+		return c.JSON(res)
+	}
+}
+
+// deleteCredential deletes a passkey of the signed-in user.
+// The WebAuthn account is unlinked with the last passkey, unless it is the last sign-in method of the user.
+func (p *Provider) deleteCredential(adapter adapters.Adapter) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		user, err := sessionUser(c, adapter)
+		if err != nil {
+			return err
+		}
+
+		id, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return toError(goth.ErrMissingCredential)
+		}
+
+		store, err := credentialStore(adapter)
+		if err != nil {
+			return toError(err)
+		}
+
+		credentials, err := store.ListWebAuthnCredentials(c, user.ID)
+		if err != nil {
+			return toError(err)
+		}
+
+		if len(credentials) == 1 && credentials[0].ID == id {
+			account, err := adapter.GetAccount(c, user.ID, p.ID())
+			if err == nil {
+				if err := adapter.UnlinkAccount(c, account.ID, user.ID); err != nil {
+					return toError(err)
+				}
+
+				return c.SendStatus(fiber.StatusNoContent)
+			}
+		}
+
+		if err := store.DeleteWebAuthnCredential(c, id, user.ID); err != nil {
+			return toError(err)
+		}
+
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+// sessionUser returns the user of the session of the request.
+func sessionUser(c fiber.Ctx, adapter adapters.Adapter) (adapters.GothUser, error) {
+	session, err := goth.SessionFromContext(c)
+	if err != nil {
+		return adapters.GothUser{}, fiber.ErrUnauthorized
+	}
+
+	user, err := adapter.GetUser(c, session.UserID)
+	if err != nil {
+		return adapters.GothUser{}, fiber.ErrUnauthorized
+	}
+
+	return user, nil
+}
+
+// toError maps the errors of the ceremonies to the errors of the endpoints.
+func toError(err error) error {
+	var ge *goth.Error
+
+	switch {
+	case errors.As(err, &ge):
+		return fiber.NewError(ge.Code, ge.Message)
+	case errors.Is(err, adapters.ErrLastAccount):
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	case errors.Is(err, ErrInvalidChallenge), errors.Is(err, ErrInvalidCredential):
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	default:
+		return fiber.ErrInternalServerError
+	}
+}
+
+// toResponse converts a stored passkey to the passkey of the response.
+func toResponse(c adapters.GothWebAuthnCredential) Credential {
+	transports := []string{}
+	if c.Transports != "" {
+		transports = strings.Split(c.Transports, ",")
+	}
+
+	return Credential{
+		ID:             c.ID,
+		Name:           c.Name,
+		Transports:     transports,
+		AAGUID:         c.AAGUID,
+		BackupEligible: c.BackupEligible,
+		BackupState:    c.BackupState,
+		LastUsedAt:     c.LastUsedAt,
+		CreatedAt:      c.CreatedAt,
+	}
+}
@@ -0,0 +1,443 @@
+package webauthn
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+	"github.com/katallaxie/fiber-goth/v3/providers"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	gowebauthn "github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"github.com/katallaxie/pkg/cast"
+	"github.com/katallaxie/pkg/utilx"
+)
+
+var (
+	ErrUnsupportedAdapter = errors.New("goth: adapter does not support webauthn credentials")
+	ErrInvalidChallenge   = errors.New("goth: invalid or expired challenge")
+	ErrInvalidCredential  = errors.New("goth: invalid credential")
+	ErrCloneWarning       = errors.New("goth: the authenticator may have been cloned")
+)
+
+const (
+	// DefaultTimeout is the default duration to complete a ceremony.
+	DefaultTimeout = 5 * time.Minute
+	// loginIdentifier is the identifier of the challenges of discoverable logins.
+	loginIdentifier = "webauthn-login"
+)
+
+var _ providers.Provider = (*Provider)(nil)
+
+// Provider is a WebAuthn (passkey) provider.
+// Passkeys are registered by signed-in users and sign in users without a username.
+type Provider struct {
+	id               string
+	name             string
+	providerType     providers.ProviderType
+	rpID             string
+	rpName           string
+	origins          []string
+	timeout          time.Duration
+	userVerification protocol.UserVerificationRequirement
+	webauthn         *gowebauthn.WebAuthn
+
+	providers.UnimplementedProvider
+}
+
+// Opt is a function that configures the WebAuthn provider.
+type Opt func(*Provider)
+
+// WithUserVerification sets the user verification requirement of the ceremonies.
+func WithUserVerification(uv protocol.UserVerificationRequirement) Opt {
+	return func(p *Provider) {
+		p.userVerification = uv
+	}
+}
+
+// WithTimeout sets the duration to complete a ceremony.
+func WithTimeout(d time.Duration) Opt {
+	return func(p *Provider) {
+		p.timeout = d
+	}
+}
+
+// New creates a new WebAuthn provider for the relying party, e.g. "example.com",
+// and the origins of the pages that run the ceremonies, e.g. "https://example.com".
+func New(rpID, rpName string, origins []string, opts ...Opt) (*Provider, error) {
+	p := &Provider{
+		id:               "webauthn",
+		name:             "Passkey",
+		providerType:     providers.ProviderTypeWebAuthn,
+		rpID:             rpID,
+		rpName:           rpName,
+		origins:          origins,
+		timeout:          DefaultTimeout,
+		userVerification: protocol.VerificationPreferred,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	w, err := gowebauthn.New(&gowebauthn.Config{
+		RPID:          p.rpID,
+		RPDisplayName: p.rpName,
+		RPOrigins:     p.origins,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:        protocol.ResidentKeyRequirementRequired,
+			RequireResidentKey: cast.Ptr(true),
+			UserVerification:   p.userVerification,
+		},
+		AttestationPreference: protocol.PreferNoAttestation,
+		Timeouts: gowebauthn.TimeoutsConfig{
+			Login:        gowebauthn.TimeoutConfig{Timeout: p.timeout, TimeoutUVD: p.timeout},
+			Registration: gowebauthn.TimeoutConfig{Timeout: p.timeout, TimeoutUVD: p.timeout},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.webauthn = w
+
+	return p, nil
+}
+
+// ID returns the provider's ID.
+func (p *Provider) ID() string {
+	return p.id
+}
+
+// Name returns the provider's name.
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// Type returns the provider's type.
+func (p *Provider) Type() providers.ProviderType {
+	return p.providerType
+}
+
+// BeginRegistration starts the registration of a passkey for the user.
+// It returns the options to pass to navigator.credentials.create() in the browser.
+func (p *Provider) BeginRegistration(ctx context.Context, adapter adapters.Adapter, user adapters.GothUser) (*protocol.CredentialCreation, error) {
+	store, err := credentialStore(adapter)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := store.ListWebAuthnCredentials(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	u := newUser(user, credentials)
+
+	creation, session, err := p.webauthn.BeginRegistration(u, gowebauthn.WithExclusions(gowebauthn.Credentials(u.WebAuthnCredentials()).CredentialDescriptors()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adapter.CreateVerificationToken(ctx, adapters.GothVerificationToken{
+		Token:      hashToken(session.Challenge),
+		Identifier: registerIdentifier(user.ID),
+		ExpiresAt:  time.Now().Add(p.timeout),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return creation, nil
+}
+
+// FinishRegistration verifies the response of navigator.credentials.create() and stores the passkey of the user.
+// The passkey is added to the WebAuthn account of the user, which is created with the first passkey.
+func (p *Provider) FinishRegistration(ctx context.Context, adapter adapters.Adapter, user adapters.GothUser, name string, body []byte) (adapters.GothWebAuthnCredential, error) {
+	store, err := credentialStore(adapter)
+	if err != nil {
+		return adapters.GothWebAuthnCredential{}, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(body)
+	if err != nil {
+		return adapters.GothWebAuthnCredential{}, ErrInvalidCredential
+	}
+
+	challenge := parsed.Response.CollectedClientData.Challenge
+	if _, err := adapter.UseVerficationToken(ctx, registerIdentifier(user.ID), hashToken(challenge)); err != nil {
+		return adapters.GothWebAuthnCredential{}, ErrInvalidChallenge
+	}
+
+	credentials, err := store.ListWebAuthnCredentials(ctx, user.ID)
+	if err != nil {
+		return adapters.GothWebAuthnCredential{}, err
+	}
+
+	u := newUser(user, credentials)
+
+	session := gowebauthn.SessionData{
+		Challenge:        challenge,
+		RelyingPartyID:   p.rpID,
+		UserID:           u.WebAuthnID(),
+		UserVerification: p.userVerification,
+		CredParams:       gowebauthn.CredentialParametersDefault(),
+	}
+
+	c, err := p.webauthn.CreateCredential(u, session, parsed)
+	if err != nil {
+		return adapters.GothWebAuthnCredential{}, ErrInvalidCredential
+	}
+
+	credential := fromCredential(c)
+	credential.UserID = user.ID
+	credential.Name = name
+
+	credential, err = store.CreateWebAuthnCredential(ctx, credential)
+	if err != nil {
+		return adapters.GothWebAuthnCredential{}, err
+	}
+
+	_, err = adapter.LinkAccount(ctx, user.ID, adapters.GothAccount{
+		Type:              adapters.AccountTypeWebAuthn,
+		Provider:          p.ID(),
+		ProviderAccountID: cast.Ptr(user.ID.String()),
+	})
+	if err != nil {
+		return adapters.GothWebAuthnCredential{}, err
+	}
+
+	return credential, nil
+}
+
+// BeginLogin starts the sign-in with a discoverable passkey.
+// It returns the options to pass to navigator.credentials.get() in the browser.
+func (p *Provider) BeginLogin(ctx context.Context, adapter adapters.Adapter) (*protocol.CredentialAssertion, error) {
+	assertion, session, err := p.webauthn.BeginDiscoverableLogin()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adapter.CreateVerificationToken(ctx, adapters.GothVerificationToken{
+		Token:      hashToken(session.Challenge),
+		Identifier: loginIdentifier,
+		ExpiresAt:  time.Now().Add(p.timeout),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return assertion, nil
+}
+
+// CompleteAuth completes the sign-in with a discoverable passkey.
+// The "credential" parameter is the JSON encoded response of navigator.credentials.get().
+func (p *Provider) CompleteAuth(ctx context.Context, adapter adapters.Adapter, params providers.AuthParams) (adapters.GothUser, error) {
+	store, err := credentialStore(adapter)
+	if err != nil {
+		return adapters.GothUser{}, err
+	}
+
+	body := params.Get("credential")
+	if utilx.Empty(body) {
+		return adapters.GothUser{}, ErrInvalidCredential
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes([]byte(body))
+	if err != nil {
+		return adapters.GothUser{}, ErrInvalidCredential
+	}
+
+	challenge := parsed.Response.CollectedClientData.Challenge
+	if _, err := adapter.UseVerficationToken(ctx, loginIdentifier, hashToken(challenge)); err != nil {
+		return adapters.GothUser{}, ErrInvalidChallenge
+	}
+
+	var (
+		user   adapters.GothUser
+		stored adapters.GothWebAuthnCredential
+	)
+
+	handler := func(rawID, userHandle []byte) (gowebauthn.User, error) {
+		credential, err := store.GetWebAuthnCredential(ctx, encodeID(rawID))
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(credential.UserID[:], userHandle) {
+			return nil, ErrInvalidCredential
+		}
+
+		u, err := adapter.GetUser(ctx, credential.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		credentials, err := store.ListWebAuthnCredentials(ctx, credential.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		user, stored = u, credential
+
+		return newUser(u, credentials), nil
+	}
+
+	session := gowebauthn.SessionData{
+		Challenge:        challenge,
+		RelyingPartyID:   p.rpID,
+		UserVerification: p.userVerification,
+	}
+
+	_, c, err := p.webauthn.ValidatePasskeyLogin(handler, session, parsed)
+	if err != nil {
+		return adapters.GothUser{}, ErrInvalidCredential
+	}
+
+	stored.SignCount = c.Authenticator.SignCount
+	stored.CloneWarning = c.Authenticator.CloneWarning
+	stored.BackupState = c.Flags.BackupState
+
+	if stored.CloneWarning {
+		_, _ = store.UpdateWebAuthnCredential(ctx, stored)
+
+		return adapters.GothUser{}, ErrCloneWarning
+	}
+
+	stored.LastUsedAt = cast.Ptr(time.Now())
+
+	if _, err := store.UpdateWebAuthnCredential(ctx, stored); err != nil {
+		return adapters.GothUser{}, err
+	}
+
+	return user, nil
+}
+
+// credentialStore returns the WebAuthn credentials of the adapter.
+func credentialStore(adapter adapters.Adapter) (adapters.WebAuthnAdapter, error) {
+	store, ok := adapter.(adapters.WebAuthnAdapter)
+	if !ok {
+		return nil, ErrUnsupportedAdapter
+	}
+
+	return store, nil
+}
+
+var _ gowebauthn.User = (*user)(nil)
+
+// user is the WebAuthn user of a user and the passkeys of the user.
+type user struct {
+	user        adapters.GothUser
+	credentials []adapters.GothWebAuthnCredential
+}
+
+func newUser(u adapters.GothUser, credentials []adapters.GothWebAuthnCredential) *user {
+	return &user{user: u, credentials: credentials}
+}
+
+// WebAuthnID returns the user handle, which is the ID of the user.
+func (u *user) WebAuthnID() []byte {
+	return u.user.ID[:]
+}
+
+// WebAuthnName returns the email of the user.
+func (u *user) WebAuthnName() string {
+	return u.user.Email
+}
+
+// WebAuthnDisplayName returns the name of the user.
+func (u *user) WebAuthnDisplayName() string {
+	if utilx.Empty(u.user.Name) {
+		return u.user.Email
+	}
+
+	return u.user.Name
+}
+
+// WebAuthnCredentials returns the passkeys of the user.
+func (u *user) WebAuthnCredentials() []gowebauthn.Credential {
+	credentials := make([]gowebauthn.Credential, 0, len(u.credentials))
+
+	for _, c := range u.credentials {
+		credentials = append(credentials, toCredential(c))
+	}
+
+	return credentials
+}
+
+// toCredential converts a stored passkey to a WebAuthn credential.
+func toCredential(c adapters.GothWebAuthnCredential) gowebauthn.Credential {
+	id, _ := base64.RawURLEncoding.DecodeString(c.CredentialID)
+
+	var transports []protocol.AuthenticatorTransport
+	for t := range strings.SplitSeq(c.Transports, ",") {
+		if utilx.NotEmpty(t) {
+			transports = append(transports, protocol.AuthenticatorTransport(t))
+		}
+	}
+
+	return gowebauthn.Credential{
+		ID:              id,
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		Transport:       transports,
+		Flags: gowebauthn.CredentialFlags{
+			BackupEligible: c.BackupEligible,
+			BackupState:    c.BackupState,
+		},
+		Authenticator: gowebauthn.Authenticator{
+			AAGUID:       c.AAGUID[:],
+			SignCount:    c.SignCount,
+			CloneWarning: c.CloneWarning,
+			Attachment:   protocol.AuthenticatorAttachment(c.Attachment),
+		},
+	}
+}
+
+// fromCredential converts a WebAuthn credential to a passkey to store.
+func fromCredential(c *gowebauthn.Credential) adapters.GothWebAuthnCredential {
+	transports := make([]string, 0, len(c.Transport))
+	for _, t := range c.Transport {
+		transports = append(transports, string(t))
+	}
+
+	aaguid, err := uuid.FromBytes(c.Authenticator.AAGUID)
+	if err != nil {
+		aaguid = uuid.Nil
+	}
+
+	return adapters.GothWebAuthnCredential{
+		CredentialID:    encodeID(c.ID),
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		Transports:      strings.Join(transports, ","),
+		AAGUID:          aaguid,
+		SignCount:       c.Authenticator.SignCount,
+		BackupEligible:  c.Flags.BackupEligible,
+		BackupState:     c.Flags.BackupState,
+		Attachment:      string(c.Authenticator.Attachment),
+	}
+}
+
+// encodeID returns the base64url encoded ID of a credential.
+func encodeID(id []byte) string {
+	return base64.RawURLEncoding.EncodeToString(id)
+}
+
+// registerIdentifier returns the identifier of the registration challenges of the user.
+func registerIdentifier(userID uuid.UUID) string {
+	return "webauthn-register:" + userID.String()
+}
+
+// hashToken returns the hash of a token which is stored instead of the token.
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(h[:])
+}
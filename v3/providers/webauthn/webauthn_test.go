@@ -0,0 +1,399 @@
+package webauthn_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+	"github.com/katallaxie/fiber-goth/v3/providers/webauthn"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/google/uuid"
+)
+
+const (
+	rpID   = "example.com"
+	origin = "https://example.com"
+)
+
+func TestRegisterAndLogin(t *testing.T) {
+	p, adapter, user := setup(t)
+	authn := newAuthenticator(t)
+
+	credential := register(t, p, adapter, user, authn)
+
+	if credential.UserID != user.ID {
+		t.Errorf("credential user = %s, want %s", credential.UserID, user.ID)
+	}
+
+	if !adapter.linked[user.ID] {
+		t.Error("the passkey account is not linked to the user")
+	}
+
+	signedIn, err := login(t, p, adapter, user, authn)
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	if signedIn.ID != user.ID {
+		t.Errorf("user = %s, want %s", signedIn.ID, user.ID)
+	}
+
+	stored := adapter.credentials[credential.CredentialID]
+	if stored.SignCount != authn.signCount || stored.LastUsedAt == nil {
+		t.Errorf("sign count = %d, last used = %v, want %d and a time", stored.SignCount, stored.LastUsedAt, authn.signCount)
+	}
+}
+
+func TestRegisterReusedChallenge(t *testing.T) {
+	p, adapter, user := setup(t)
+	authn := newAuthenticator(t)
+
+	creation, err := p.BeginRegistration(context.Background(), adapter, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := authn.create(t, creation.Response.Challenge.String())
+
+	if _, err := p.FinishRegistration(context.Background(), adapter, user, "laptop", body); err != nil {
+		t.Fatalf("finish registration: %v", err)
+	}
+
+	_, err = p.FinishRegistration(context.Background(), adapter, user, "laptop", body)
+	if !errors.Is(err, webauthn.ErrInvalidChallenge) {
+		t.Errorf("err = %v, want %v", err, webauthn.ErrInvalidChallenge)
+	}
+}
+
+func TestLoginBadSignature(t *testing.T) {
+	p, adapter, user := setup(t)
+	authn := newAuthenticator(t)
+
+	register(t, p, adapter, user, authn)
+
+	// Another key signs for the registered credential.
+	other := newAuthenticator(t)
+	other.id = authn.id
+
+	_, err := login(t, p, adapter, user, other)
+	if !errors.Is(err, webauthn.ErrInvalidCredential) {
+		t.Errorf("err = %v, want %v", err, webauthn.ErrInvalidCredential)
+	}
+}
+
+func TestLoginCloneWarning(t *testing.T) {
+	p, adapter, user := setup(t)
+	authn := newAuthenticator(t)
+
+	credential := register(t, p, adapter, user, authn)
+
+	if _, err := login(t, p, adapter, user, authn); err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	// A clone of the authenticator signs with a counter that is not greater than the stored counter.
+	clone := *authn
+	clone.signCount--
+
+	_, err := login(t, p, adapter, user, &clone)
+	if !errors.Is(err, webauthn.ErrCloneWarning) {
+		t.Fatalf("err = %v, want %v", err, webauthn.ErrCloneWarning)
+	}
+
+	if !adapter.credentials[credential.CredentialID].CloneWarning {
+		t.Error("the clone warning is not stored")
+	}
+}
+
+func setup(t *testing.T) (*webauthn.Provider, *memAdapter, adapters.GothUser) {
+	t.Helper()
+
+	p, err := webauthn.New(rpID, "Example", []string{origin})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user := adapters.GothUser{ID: uuid.New(), Name: "Alice", Email: "alice@example.com"}
+
+	adapter := newAdapter()
+	adapter.users[user.ID] = user
+
+	return p, adapter, user
+}
+
+func register(t *testing.T, p *webauthn.Provider, adapter *memAdapter, user adapters.GothUser, authn *authenticator) adapters.GothWebAuthnCredential {
+	t.Helper()
+
+	creation, err := p.BeginRegistration(context.Background(), adapter, user)
+	if err != nil {
+		t.Fatalf("begin registration: %v", err)
+	}
+
+	if !bytes.Equal(creation.Response.User.ID.(protocol.URLEncodedBase64), user.ID[:]) {
+		t.Fatalf("user handle = %v, want the ID of the user", creation.Response.User.ID)
+	}
+
+	credential, err := p.FinishRegistration(context.Background(), adapter, user, "laptop", authn.create(t, creation.Response.Challenge.String()))
+	if err != nil {
+		t.Fatalf("finish registration: %v", err)
+	}
+
+	return credential
+}
+
+func login(t *testing.T, p *webauthn.Provider, adapter *memAdapter, user adapters.GothUser, authn *authenticator) (adapters.GothUser, error) {
+	t.Helper()
+
+	assertion, err := p.BeginLogin(context.Background(), adapter)
+	if err != nil {
+		t.Fatalf("begin login: %v", err)
+	}
+
+	body := authn.get(t, assertion.Response.Challenge.String(), user.ID[:])
+
+	return p.CompleteAuth(context.Background(), adapter, params{"credential": string(body)})
+}
+
+// authenticator is a software authenticator with an ES256 key.
+type authenticator struct {
+	id        []byte
+	key       *ecdsa.PrivateKey
+	signCount uint32
+}
+
+func newAuthenticator(t *testing.T) *authenticator {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		t.Fatal(err)
+	}
+
+	return &authenticator{id: id, key: key}
+}
+
+// create returns the response of navigator.credentials.create() with the "none" attestation.
+func (a *authenticator) create(t *testing.T, challenge string) []byte {
+	t.Helper()
+
+	ecdh, err := a.key.PublicKey.ECDH()
+	if err != nil {
+		t.Fatal(err)
+	}
+	point := ecdh.Bytes()
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  1, // P-256
+		XCoord: point[1:33],
+		YCoord: point[33:],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The attested credential data has an empty AAGUID, the length of the credential ID, the ID and the key.
+	attested := make([]byte, 16, 18+len(a.id)+len(publicKey))
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(a.id)))
+	attested = append(attested, a.id...)
+	attested = append(attested, publicKey...)
+
+	authData := a.authData(0x01|0x04|0x40, attested)
+
+	attestation, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a.credential(t, map[string]any{
+		"clientDataJSON":    clientData(t, "webauthn.create", challenge),
+		"attestationObject": encode(attestation),
+	})
+}
+
+// get returns the response of navigator.credentials.get() of a discoverable credential.
+func (a *authenticator) get(t *testing.T, challenge string, userHandle []byte) []byte {
+	t.Helper()
+
+	a.signCount++
+
+	authData := a.authData(0x01|0x04, nil)
+	client := clientData(t, "webauthn.get", challenge)
+
+	raw, err := base64.RawURLEncoding.DecodeString(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash := sha256.Sum256(raw)
+	digest := sha256.Sum256(append(authData, hash[:]...))
+
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a.credential(t, map[string]any{
+		"clientDataJSON":    client,
+		"authenticatorData": encode(authData),
+		"signature":         encode(signature),
+		"userHandle":        encode(userHandle),
+	})
+}
+
+// authData returns the authenticator data with the flags, the counter and the attested credential data.
+func (a *authenticator) authData(flags byte, attested []byte) []byte {
+	rp := sha256.Sum256([]byte(rpID))
+
+	data := append(rp[:], flags)
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+
+	return append(data, attested...)
+}
+
+func (a *authenticator) credential(t *testing.T, response map[string]any) []byte {
+	t.Helper()
+
+	body, err := json.Marshal(map[string]any{
+		"id":       encode(a.id),
+		"rawId":    encode(a.id),
+		"type":     "public-key",
+		"response": response,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return body
+}
+
+func clientData(t *testing.T, typ, challenge string) string {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]string{"type": typ, "challenge": challenge, "origin": origin})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return encode(data)
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+type params map[string]string
+
+func (p params) Get(key string) string {
+	return p[key]
+}
+
+func (p params) CodeVerifier() string {
+	return ""
+}
+
+type memAdapter struct {
+	users       map[uuid.UUID]adapters.GothUser
+	linked      map[uuid.UUID]bool
+	tokens      map[string]adapters.GothVerificationToken
+	credentials map[string]adapters.GothWebAuthnCredential
+
+	adapters.UnimplementedAdapter
+	adapters.UnimplementedWebAuthnAdapter
+}
+
+func newAdapter() *memAdapter {
+	return &memAdapter{
+		users:       map[uuid.UUID]adapters.GothUser{},
+		linked:      map[uuid.UUID]bool{},
+		tokens:      map[string]adapters.GothVerificationToken{},
+		credentials: map[string]adapters.GothWebAuthnCredential{},
+	}
+}
+
+func (a *memAdapter) GetUser(_ context.Context, id uuid.UUID) (adapters.GothUser, error) {
+	user, ok := a.users[id]
+	if !ok {
+		return adapters.GothUser{}, errors.New("missing user")
+	}
+
+	return user, nil
+}
+
+func (a *memAdapter) LinkAccount(_ context.Context, userID uuid.UUID, account adapters.GothAccount) (adapters.GothAccount, error) {
+	a.linked[userID] = true
+	return account, nil
+}
+
+func (a *memAdapter) CreateVerificationToken(_ context.Context, token adapters.GothVerificationToken) (adapters.GothVerificationToken, error) {
+	a.tokens[token.Identifier+"/"+token.Token] = token
+	return token, nil
+}
+
+func (a *memAdapter) UseVerficationToken(_ context.Context, identifier, token string) (adapters.GothVerificationToken, error) {
+	t, ok := a.tokens[identifier+"/"+token]
+	if !ok || t.HasExpired() {
+		return adapters.GothVerificationToken{}, errors.New("missing token")
+	}
+
+	delete(a.tokens, identifier+"/"+token)
+
+	return t, nil
+}
+
+func (a *memAdapter) CreateWebAuthnCredential(_ context.Context, credential adapters.GothWebAuthnCredential) (adapters.GothWebAuthnCredential, error) {
+	credential.ID = uuid.New()
+	a.credentials[credential.CredentialID] = credential
+
+	return credential, nil
+}
+
+func (a *memAdapter) GetWebAuthnCredential(_ context.Context, credentialID string) (adapters.GothWebAuthnCredential, error) {
+	credential, ok := a.credentials[credentialID]
+	if !ok {
+		return adapters.GothWebAuthnCredential{}, errors.New("missing credential")
+	}
+
+	return credential, nil
+}
+
+func (a *memAdapter) UpdateWebAuthnCredential(_ context.Context, credential adapters.GothWebAuthnCredential) (adapters.GothWebAuthnCredential, error) {
+	a.credentials[credential.CredentialID] = credential
+	return credential, nil
+}
+
+func (a *memAdapter) ListWebAuthnCredentials(_ context.Context, userID uuid.UUID) ([]adapters.GothWebAuthnCredential, error) {
+	var credentials []adapters.GothWebAuthnCredential
+
+	for _, c := range a.credentials {
+		if c.UserID == userID {
+			credentials = append(credentials, c)
+		}
+	}
+
+	return credentials, nil
+}
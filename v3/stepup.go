@@ -0,0 +1,146 @@
+package goth
+
+import (
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+	"github.com/katallaxie/fiber-goth/v3/providers"
+)
+
+// stepUpLoopWindow is the age of a session that has just been created by the redirect of RequireRecentAuth.
+const stepUpLoopWindow = time.Minute
+
+// RequireRecentAuth returns a middleware that requires the user of the session to have authenticated
+// within the max age, and with one of the authentication context class references, if any are given.
+// Otherwise the user is redirected through the BeginAuthHandler of the provider of the session
+// with "prompt=login", "max_age" and "acr_values", and returns to the requested URL afterwards.
+// Users of providers that cannot authenticate them again, and users whose new session still does not
+// meet the requirement, are rejected with ErrRecentAuthRequired.
+// It depends on the session attached by the Session middleware.
+func RequireRecentAuth(maxAge time.Duration, acr ...string) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		session, err := SessionFromContext(c)
+		if err != nil {
+			return err
+		}
+
+		if recentAuth(session, maxAge, acr...) {
+			return c.Next()
+		}
+
+		// API clients are not redirected to sign in again.
+		if bearerRequest(c) {
+			return unauthorized(c, ErrRecentAuthRequired)
+		}
+
+		// Sessions that were created before the authentication was recorded cannot be authenticated again,
+		// nor can sessions of providers that do not forward the request of a new authentication, e.g. GitHub.
+		p, err := providers.GetProvider(session.AuthMethod)
+		if err != nil || !reauthenticates(p) {
+			return ErrRecentAuthRequired
+		}
+
+		// IdPs that ignore "max_age" or "acr_values" would redirect in a loop,
+		// the session of the authentication that has just been requested is not redirected again.
+		if time.Since(session.CreatedAt) < stepUpLoopWindow {
+			return ErrRecentAuthRequired
+		}
+
+		beginAuthURL, ok := c.Locals(beginAuthURLKey).(string)
+		if !ok {
+			beginAuthURL = ConfigDefault.BeginAuthURL
+		}
+
+		u, err := url.Parse(strings.Replace(beginAuthURL, ":"+provider, url.PathEscape(session.AuthMethod), 1))
+		if err != nil {
+			return err
+		}
+
+		q := u.Query()
+		q.Set("redirect_uri", c.FullURL())
+		q.Set(providers.ParamPrompt, providers.PromptLogin)
+
+		if maxAge > 0 {
+			q.Set(providers.ParamMaxAge, strconv.FormatInt(int64(maxAge.Seconds()), 10))
+		}
+
+		if len(acr) > 0 {
+			q.Set(providers.ParamACRValues, strings.Join(acr, " "))
+		}
+
+		u.RawQuery = q.Encode()
+
+		return c.Redirect().Status(fiber.StatusTemporaryRedirect).To(u.String())
+	}
+}
+
+// recentAuth returns true if the user of the session authenticated within the max age
+// and with one of the authentication context class references.
+func recentAuth(session adapters.GothSession, maxAge time.Duration, acr ...string) bool {
+	if maxAge > 0 && (session.AuthTime.IsZero() || time.Since(session.AuthTime) > maxAge) {
+		return false
+	}
+
+	return len(acr) == 0 || slices.Contains(acr, session.ACR)
+}
+
+// authMetadata returns the options of a new session that record the authentication at the provider.
+// Providers that do not record the authentication time authenticated the user now, unless they are federated:
+// the user may have signed in at the IdP long before, except if a new authentication was requested and forwarded.
+func authMetadata(provider providers.Provider, params *Params, reauth bool) []adapters.SessionOpt {
+	opts := []adapters.SessionOpt{
+		adapters.WithAuthMethod(provider.ID()),
+		adapters.WithACR(params.auth.ACR),
+		adapters.WithAMR(params.auth.AMR...),
+	}
+
+	authTime := params.auth.AuthTime
+	if authTime.IsZero() && (!federated(provider) || reauth && reauthenticates(provider)) {
+		authTime = time.Now()
+	}
+
+	if !authTime.IsZero() {
+		opts = append(opts, adapters.WithAuthTime(authTime))
+	}
+
+	return opts
+}
+
+// federated returns true if the provider signs in users with the authentication at an IdP.
+func federated(provider providers.Provider) bool {
+	switch provider.Type() {
+	case providers.ProviderTypeOAuth2, providers.ProviderTypeOIDC, providers.ProviderTypeSAML:
+		return true
+	default:
+		return false
+	}
+}
+
+// reauthenticates returns true if the provider authenticates the user again on request.
+func reauthenticates(provider providers.Provider) bool {
+	r, ok := provider.(providers.Reauthenticator)
+	return ok && r.Reauthenticates()
+}
+
+// verifiedAuthMetadata returns the options of a new session that keep the authentication of the pending session
+// and add the authentication method references of the second factor.
+func verifiedAuthMetadata(pending adapters.GothSession, amr ...string) []adapters.SessionOpt {
+	methods := pending.AuthMethods()
+	for _, m := range amr {
+		if !slices.Contains(methods, m) {
+			methods = append(methods, m)
+		}
+	}
+
+	return []adapters.SessionOpt{
+		adapters.WithAuthTime(pending.AuthTime),
+		adapters.WithAuthMethod(pending.AuthMethod),
+		adapters.WithACR(pending.ACR),
+		adapters.WithAMR(methods...),
+	}
+}
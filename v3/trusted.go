@@ -0,0 +1,116 @@
+package goth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+)
+
+// DefaultTrustedDeviceExpiry is the default duration a trusted device skips the second factor.
+const DefaultTrustedDeviceExpiry = 30 * 24 * time.Hour
+
+// trustedDeviceCookie is the value of the cookie of a trusted device,
+// which is encrypted with the Encryptor and the Secret of the config.
+type trustedDeviceCookie struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"userId"`
+	Token  string    `json:"token"`
+}
+
+// trustDevice stores the device of the request as trusted device of the user and sets its cookie.
+func (t *twoFactor) trustDevice(c fiber.Ctx, userID uuid.UUID) error {
+	store, ok := t.cfg.Adapter.(adapters.TrustedDeviceAdapter)
+	if !ok {
+		return ErrMissingAdapter
+	}
+
+	token := GenerateKey()
+	expires := time.Now().Add(t.trusted)
+
+	device, err := store.CreateTrustedDevice(c, adapters.GothTrustedDevice{
+		UserID:    userID,
+		TokenHash: hashTrustedDeviceToken(token),
+		IPAddress: c.IP(),
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+		ExpiresAt: expires,
+	})
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(trustedDeviceCookie{ID: device.ID, UserID: userID, Token: token})
+	if err != nil {
+		return err
+	}
+
+	value, err := t.cfg.Encryptor(string(b), t.cfg.Secret)
+	if err != nil {
+		return err
+	}
+
+	c.Cookie(&fiber.Cookie{
+		Name:     t.cfg.TrustedDeviceCookieName(),
+		Value:    value,
+		HTTPOnly: true,
+		SameSite: t.cfg.CookieSameSite,
+		Secure:   t.cfg.CookieSecure,
+		Expires:  expires,
+		Domain:   t.cfg.CookieDomain,
+		Path:     "/",
+	})
+
+	return nil
+}
+
+// trustedDevice returns true if the request is from an unexpired trusted device of the user.
+// The last use of the trusted device is recorded.
+func trustedDevice(c fiber.Ctx, cfg Config, userID uuid.UUID) bool {
+	store, ok := cfg.Adapter.(adapters.TrustedDeviceAdapter)
+	if !ok {
+		return false
+	}
+
+	cookie := c.Cookies(cfg.TrustedDeviceCookieName())
+	if cookie == "" {
+		return false
+	}
+
+	value, err := cfg.Decryptor(cookie, cfg.Secret)
+	if err != nil {
+		return false
+	}
+
+	var tc trustedDeviceCookie
+	if err := json.Unmarshal([]byte(value), &tc); err != nil || tc.UserID != userID {
+		return false
+	}
+
+	device, err := store.GetTrustedDevice(c, tc.ID)
+	if err != nil || device.UserID != userID || time.Now().After(device.ExpiresAt) {
+		return false
+	}
+
+	if subtle.ConstantTimeCompare([]byte(device.TokenHash), []byte(hashTrustedDeviceToken(tc.Token))) != 1 {
+		return false
+	}
+
+	now := time.Now()
+	device.LastUsedAt = &now
+
+	_, err = store.UpdateTrustedDevice(c, device)
+
+	return err == nil
+}
+
+// hashTrustedDeviceToken returns the hash of the token of a trusted device which is stored instead of the token.
+func hashTrustedDeviceToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(h[:])
+}
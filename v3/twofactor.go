@@ -0,0 +1,681 @@
+package goth
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"image/png"
+	"math/big"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	// DefaultTwoFactorIssuer is the default issuer shown by authenticator apps.
+	DefaultTwoFactorIssuer = "Goth"
+	// DefaultBackupCodes is the default number of backup codes of a user.
+	DefaultBackupCodes = 10
+	// DefaultTwoFactorMaxAttempts is the default number of attempts to enter a code.
+	DefaultTwoFactorMaxAttempts = 5
+	// DefaultTwoFactorLockout is the default duration the second factor is locked after too many attempts.
+	DefaultTwoFactorLockout = 15 * time.Minute
+	// DefaultTwoFactorTimeout is the default duration a pending session waits for the second factor.
+	DefaultTwoFactorTimeout = 10 * time.Minute
+)
+
+const (
+	totpPeriod     = 30
+	totpSkew       = 1
+	qrCodeSize     = 256
+	backupCodeLen  = 10
+	backupAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+// TwoFactor is the status of the second factor of the signed-in user.
+type TwoFactor struct {
+	// Enabled is true if the second factor is required to sign in.
+	Enabled bool `json:"enabled"`
+	// BackupCodes is the number of unused backup codes.
+	BackupCodes int64 `json:"backupCodes"`
+}
+
+// TwoFactorEnrollment is the TOTP secret to add to an authenticator app.
+type TwoFactorEnrollment struct {
+	// Secret is the base32 encoded secret to enter manually.
+	Secret string `json:"secret"`
+	// URI is the otpauth URI of the secret.
+	URI string `json:"uri"`
+	// QRCode is the otpauth URI as PNG encoded QR code data URL.
+	QRCode string `json:"qrCode"`
+}
+
+// TwoFactorBackupCodes are the backup codes of the user, they are only shown once.
+type TwoFactorBackupCodes struct {
+	// BackupCodes are the one-time backup codes.
+	BackupCodes []string `json:"backupCodes"`
+}
+
+// TwoFactorSession is the session of an API client that has verified the second factor.
+type TwoFactorSession struct {
+	// Token is the bearer token of the session, which replaces the token of the pending session.
+	Token string `json:"token"`
+	// ExpiresAt is the expiry time of the session.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// twoFactorRequest is the body of the requests with a code.
+type twoFactorRequest struct {
+	Code        string `json:"code" form:"code"`
+	BackupCode  string `json:"backupCode" form:"backupCode"`
+	TrustDevice bool   `json:"trustDevice" form:"trustDevice"`
+}
+
+type twoFactor struct {
+	cfg         Config
+	issuer      string
+	backupCodes int
+	maxAttempts int
+	lockout     time.Duration
+	trusted     time.Duration
+}
+
+// TwoFactorOpt is a function that configures the two-factor authentication.
+type TwoFactorOpt func(*twoFactor)
+
+// WithTwoFactorIssuer sets the issuer that authenticator apps show for the secret.
+func WithTwoFactorIssuer(issuer string) TwoFactorOpt {
+	return func(t *twoFactor) {
+		t.issuer = issuer
+	}
+}
+
+// WithBackupCodes sets the number of backup codes of a user.
+func WithBackupCodes(n int) TwoFactorOpt {
+	return func(t *twoFactor) {
+		t.backupCodes = n
+	}
+}
+
+// WithTwoFactorMaxAttempts sets the number of attempts to enter a code before the second factor is locked.
+func WithTwoFactorMaxAttempts(n int) TwoFactorOpt {
+	return func(t *twoFactor) {
+		t.maxAttempts = n
+	}
+}
+
+// WithTwoFactorLockout sets the duration the second factor is locked after too many attempts.
+func WithTwoFactorLockout(d time.Duration) TwoFactorOpt {
+	return func(t *twoFactor) {
+		t.lockout = d
+	}
+}
+
+// WithTrustedDeviceExpiry sets the duration a trusted device skips the second factor.
+func WithTrustedDeviceExpiry(d time.Duration) TwoFactorOpt {
+	return func(t *twoFactor) {
+		t.trusted = d
+	}
+}
+
+// MountTwoFactor registers the JSON endpoints of the two-factor authentication at the router.
+//
+//	GET  /              status of the second factor of the signed-in user
+//	POST /enroll        creates a TOTP secret and returns its otpauth URI and QR code
+//	POST /activate      enables the second factor with the first code and returns the backup codes
+//	POST /verify        verifies the code or backup code of a pending session
+//	POST /backup-codes  replaces the backup codes, requires a code
+//	POST /disable       disables the second factor, requires a code
+//
+// Users with an enabled second factor sign in with a pending session, which is treated as unauthenticated
+// and redirected to the TwoFactorURL of the config until the code is verified. The verified session replaces
+// the pending session, and the "redirect_uri" query parameter of the verification is followed if it is
+// a relative path or an URL of the TrustedOrigins of the config. The pending session is sent either as cookie
+// or as bearer token, API clients receive the token of the verified session in the response.
+// The TOTP secret is encrypted with the Encryptor and the Secret of the config,
+// the adapter has to implement the two-factor adapter.
+//
+// The verification with "trustDevice" set stores the device as trusted device, if the adapter implements
+// the trusted device adapter. Its encrypted cookie skips the second factor until the trusted device expires
+// or is revoked. Disabling the second factor revokes the trusted devices of the user.
+func MountTwoFactor(router fiber.Router, config Config, opts ...TwoFactorOpt) {
+	t := &twoFactor{
+		cfg:         configDefault(config),
+		issuer:      DefaultTwoFactorIssuer,
+		backupCodes: DefaultBackupCodes,
+		maxAttempts: DefaultTwoFactorMaxAttempts,
+		lockout:     DefaultTwoFactorLockout,
+		trusted:     DefaultTrustedDeviceExpiry,
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	router.Get("/", t.handle(t.status))
+	router.Post("/enroll", t.handle(t.enroll))
+	router.Post("/activate", t.handle(t.activate))
+	router.Post("/verify", t.handle(t.verify))
+	router.Post("/backup-codes", t.handle(t.regenerateBackupCodes))
+	router.Post("/disable", t.handle(t.disable))
+}
+
+// handle maps the errors of the handler to the errors of the endpoints.
+func (t *twoFactor) handle(handler fiber.Handler) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		err := handler(c)
+
+		var ge *Error
+		var fe *fiber.Error
+
+		switch {
+		case err == nil:
+			return nil
+		case errors.As(err, &ge):
+			return fiber.NewError(ge.Code, ge.Message)
+		case errors.As(err, &fe):
+			return fe
+		default:
+			return fiber.ErrInternalServerError
+		}
+	}
+}
+
+func (t *twoFactor) status(c fiber.Ctx) error {
+	session, store, err := t.session(c)
+	if err != nil {
+		return err
+	}
+
+	res := TwoFactor{}
+
+	tf, err := store.GetTwoFactor(c, session.UserID)
+	if err == nil && tf.Enabled {
+		res.Enabled = true
+
+		res.BackupCodes, err = store.CountBackupCodes(c, session.UserID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.JSON(res)
+}
+
+func (t *twoFactor) enroll(c fiber.Ctx) error {
+	session, store, err := t.session(c)
+	if err != nil {
+		return err
+	}
+
+	if tf, err := store.GetTwoFactor(c, session.UserID); err == nil && tf.Enabled {
+		return ErrTwoFactorEnabled
+	}
+
+	user, err := t.cfg.Adapter.GetUser(c, session.UserID)
+	if err != nil {
+		return ErrMissingUser
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      t.issuer,
+		AccountName: user.Email,
+		Period:      totpPeriod,
+		Digits:      otp.DigitsSix,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		return err
+	}
+
+	secret, err := t.cfg.Encryptor(key.Secret(), t.cfg.Secret)
+	if err != nil {
+		return err
+	}
+
+	_, err = store.CreateTwoFactor(c, adapters.GothTwoFactor{
+		UserID: session.UserID,
+		Secret: secret,
+	})
+	if err != nil {
+		return err
+	}
+
+	img, err := key.Image(qrCodeSize, qrCodeSize)
+	if err != nil {
+		return err
+	}
+
+	var qr bytes.Buffer
+	if err := png.Encode(&qr, img); err != nil {
+		return err
+	}
+
+	return c.JSON(TwoFactorEnrollment{
+		Secret: key.Secret(),
+		URI:    key.URL(),
+		QRCode: "data:image/png;base64," + base64.StdEncoding.EncodeToString(qr.Bytes()),
+	})
+}
+
+func (t *twoFactor) activate(c fiber.Ctx) error {
+	session, store, err := t.session(c)
+	if err != nil {
+		return err
+	}
+
+	var req twoFactorRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return ErrBadRequest
+	}
+
+	tf, err := store.GetTwoFactor(c, session.UserID)
+	if err != nil {
+		return err
+	}
+
+	if tf.Enabled {
+		return ErrTwoFactorEnabled
+	}
+
+	if err := t.checkCode(c, store, tf, req.Code); err != nil {
+		return err
+	}
+
+	codes, err := t.createBackupCodes(c, store, session.UserID)
+	if err != nil {
+		return err
+	}
+
+	tf.Enabled = true
+	tf.Attempts = 0
+
+	if _, err := store.UpdateTwoFactor(c, tf); err != nil {
+		return err
+	}
+
+	return c.JSON(TwoFactorBackupCodes{BackupCodes: codes})
+}
+
+// verify completes the sign-in of a pending session with the second factor.
+// The pending session is replaced by a new session to not keep the token that was issued before the verification.
+func (t *twoFactor) verify(c fiber.Ctx) error {
+	session, err := pendingSession(c, t.cfg)
+	if err != nil {
+		return ErrTwoFactorRequired
+	}
+
+	store, ok := t.cfg.Adapter.(adapters.TwoFactorAdapter)
+	if !ok {
+		return ErrMissingAdapter
+	}
+
+	var req twoFactorRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return ErrBadRequest
+	}
+
+	redirectURL := c.Query("redirect_uri")
+	if redirectURL != "" && !trustedRedirect(t.cfg, redirectURL) {
+		return ErrBadRequest
+	}
+
+	if err := t.check(c, store, session.UserID, req); err != nil {
+		return err
+	}
+
+	// The code has been used, so a device that cannot be trusted does not fail the sign-in.
+	if req.TrustDevice {
+		_ = t.trustDevice(c, session.UserID)
+	}
+
+	if err := t.cfg.Adapter.DeleteSession(c, session.SessionToken); err != nil {
+		return err
+	}
+
+	duration, err := time.ParseDuration(t.cfg.Expiry)
+	if err != nil {
+		return err
+	}
+	expires := time.Now().Add(duration)
+
+	amr := []string{"mfa"}
+	if req.BackupCode == "" {
+		amr = append(amr, "otp")
+	}
+
+	session, err = t.cfg.Adapter.CreateSession(c, session.UserID, expires, append(sessionMetadata(c), verifiedAuthMetadata(session, amr...)...)...)
+	if err != nil {
+		return ErrMissingSession
+	}
+
+	// API clients store the token of the session themselves.
+	if bearerRequest(c) {
+		return c.JSON(TwoFactorSession{Token: session.SessionToken, ExpiresAt: session.ExpiresAt})
+	}
+
+	cookieValue := &fiber.Cookie{
+		Name:     t.cfg.SessionCookieName(),
+		Value:    session.SessionToken,
+		HTTPOnly: true,
+		SameSite: t.cfg.CookieSameSite,
+		Secure:   t.cfg.CookieSecure,
+		Expires:  expires,
+		Domain:   t.cfg.CookieDomain,
+		Path:     "/",
+	}
+
+	c.Vary(fiber.HeaderCookie)
+	c.Cookie(cookieValue)
+
+	if redirectURL != "" {
+		return c.Redirect().Status(fiber.StatusSeeOther).To(redirectURL)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (t *twoFactor) regenerateBackupCodes(c fiber.Ctx) error {
+	session, store, err := t.session(c)
+	if err != nil {
+		return err
+	}
+
+	var req twoFactorRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return ErrBadRequest
+	}
+
+	if err := t.check(c, store, session.UserID, twoFactorRequest{Code: req.Code}); err != nil {
+		return err
+	}
+
+	codes, err := t.createBackupCodes(c, store, session.UserID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(TwoFactorBackupCodes{BackupCodes: codes})
+}
+
+func (t *twoFactor) disable(c fiber.Ctx) error {
+	session, store, err := t.session(c)
+	if err != nil {
+		return err
+	}
+
+	var req twoFactorRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return ErrBadRequest
+	}
+
+	if err := t.check(c, store, session.UserID, req); err != nil {
+		return err
+	}
+
+	if err := store.DeleteTwoFactor(c, session.UserID); err != nil {
+		return err
+	}
+
+	if devices, ok := t.cfg.Adapter.(adapters.TrustedDeviceAdapter); ok {
+		if err := devices.DeleteTrustedDevices(c, session.UserID); err != nil {
+			return err
+		}
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// session returns the session of the signed-in user and the two-factor adapter.
+func (t *twoFactor) session(c fiber.Ctx) (adapters.GothSession, adapters.TwoFactorAdapter, error) {
+	store, ok := t.cfg.Adapter.(adapters.TwoFactorAdapter)
+	if !ok {
+		return adapters.GothSession{}, nil, ErrMissingAdapter
+	}
+
+	session, err := currentSession(c, t.cfg)
+	if err != nil {
+		return adapters.GothSession{}, nil, fiber.ErrUnauthorized
+	}
+
+	return session, store, nil
+}
+
+// check verifies the code or the backup code of the enabled second factor of a user.
+func (t *twoFactor) check(ctx context.Context, store adapters.TwoFactorAdapter, userID uuid.UUID, req twoFactorRequest) error {
+	tf, err := store.GetTwoFactor(ctx, userID)
+	if err != nil || !tf.Enabled {
+		return ErrMissingTwoFactor
+	}
+
+	if req.BackupCode == "" {
+		return t.checkCode(ctx, store, tf, req.Code)
+	}
+
+	tf, err = t.countAttempt(ctx, store, tf)
+	if err != nil {
+		return err
+	}
+
+	if err := store.UseBackupCode(ctx, userID, hashBackupCode(userID, normalizeBackupCode(req.BackupCode))); err != nil {
+		return ErrBadTwoFactorCode
+	}
+
+	tf.Attempts = 0
+	_, err = store.UpdateTwoFactor(ctx, tf)
+
+	return err
+}
+
+// checkCode verifies a TOTP code of the second factor, each code is used once.
+func (t *twoFactor) checkCode(ctx context.Context, store adapters.TwoFactorAdapter, tf adapters.GothTwoFactor, code string) error {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if code == "" {
+		return ErrBadTwoFactorCode
+	}
+
+	tf, err := t.countAttempt(ctx, store, tf)
+	if err != nil {
+		return err
+	}
+
+	secret, err := t.cfg.Decryptor(tf.Secret, t.cfg.Secret)
+	if err != nil {
+		return err
+	}
+
+	step, ok := validateTOTP(secret, code, time.Now())
+	if !ok {
+		return ErrBadTwoFactorCode
+	}
+
+	return store.UseTwoFactorStep(ctx, tf.UserID, step)
+}
+
+// countAttempt counts an attempt to enter a code before it is compared.
+// The attempts are reset once the lockout after too many attempts has passed.
+func (t *twoFactor) countAttempt(ctx context.Context, store adapters.TwoFactorAdapter, tf adapters.GothTwoFactor) (adapters.GothTwoFactor, error) {
+	if tf.Attempts >= t.maxAttempts {
+		if time.Since(tf.UpdatedAt) < t.lockout {
+			return adapters.GothTwoFactor{}, ErrTwoFactorLocked
+		}
+
+		tf.Attempts = 0
+
+		if _, err := store.UpdateTwoFactor(ctx, tf); err != nil {
+			return adapters.GothTwoFactor{}, err
+		}
+	}
+
+	tf, err := store.IncrementTwoFactorAttempts(ctx, tf.UserID)
+	if err != nil {
+		return adapters.GothTwoFactor{}, err
+	}
+
+	if tf.Attempts > t.maxAttempts {
+		return adapters.GothTwoFactor{}, ErrTwoFactorLocked
+	}
+
+	return tf, nil
+}
+
+// createBackupCodes creates new backup codes of a user, which replace the previous backup codes.
+func (t *twoFactor) createBackupCodes(ctx context.Context, store adapters.TwoFactorAdapter, userID uuid.UUID) ([]string, error) {
+	codes := make([]string, 0, t.backupCodes)
+	hashes := make([]string, 0, t.backupCodes)
+
+	for range t.backupCodes {
+		code, err := generateBackupCode()
+		if err != nil {
+			return nil, err
+		}
+
+		codes = append(codes, code)
+		hashes = append(hashes, hashBackupCode(userID, normalizeBackupCode(code)))
+	}
+
+	if err := store.CreateBackupCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// requiresTwoFactor returns true if the user has enabled the second factor
+// and the request is not from a trusted device of the user.
+func requiresTwoFactor(c fiber.Ctx, cfg Config, userID uuid.UUID) bool {
+	store, ok := cfg.Adapter.(adapters.TwoFactorAdapter)
+	if !ok {
+		return false
+	}
+
+	tf, err := store.GetTwoFactor(c, userID)
+	if err != nil || !tf.Enabled {
+		return false
+	}
+
+	return !trustedDevice(c, cfg, userID)
+}
+
+// redirectToTwoFactor redirects to the URL to enter the second factor,
+// which continues to the redirect URL after the verification if the redirect URL is trusted.
+func redirectToTwoFactor(c fiber.Ctx, cfg Config, redirectURL string) error {
+	u, err := url.Parse(cfg.TwoFactorURL)
+	if err != nil {
+		return err
+	}
+
+	if redirectURL != "" && trustedRedirect(cfg, redirectURL) {
+		q := u.Query()
+		q.Set("redirect_uri", redirectURL)
+		u.RawQuery = q.Encode()
+	}
+
+	return c.Redirect().Status(fiber.StatusTemporaryRedirect).To(u.String())
+}
+
+// trustedRedirect returns true if the URL is a relative path or an URL of a trusted origin.
+func trustedRedirect(cfg Config, redirectURL string) bool {
+	// Browsers treat backslashes as slashes, e.g. "/\example.com" as "//example.com".
+	if strings.Contains(redirectURL, "\\") {
+		return false
+	}
+
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		return false
+	}
+
+	if u.Scheme == "" && u.Host == "" {
+		return strings.HasPrefix(u.Path, "/")
+	}
+
+	return slices.Contains(cfg.TrustedOrigins, u.Scheme+"://"+u.Host)
+}
+
+// pendingSession returns the valid session of the request that waits for the second factor.
+// The token of the session is either the bearer token of API clients or the session cookie.
+func pendingSession(c fiber.Ctx, cfg Config) (adapters.GothSession, error) {
+	token, err := TokenFromChain(TokenFromAuthorizationHeader(), cfg.Extractor)(c)
+	if err != nil {
+		return adapters.GothSession{}, err
+	}
+
+	session, err := cfg.Adapter.GetSession(c, token)
+	if err != nil {
+		return adapters.GothSession{}, err
+	}
+
+	if !session.IsValid() || !session.TwoFactorPending {
+		return adapters.GothSession{}, ErrBadSession
+	}
+
+	return session, nil
+}
+
+// validateTOTP returns the time step of the code if it is valid at the time,
+// codes of the adjacent time steps are accepted to allow for clock skew.
+func validateTOTP(secret, code string, t time.Time) (int64, bool) {
+	current := t.Unix() / totpPeriod
+
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		expected, err := totp.GenerateCodeCustom(secret, time.Unix(step*totpPeriod, 0), totp.ValidateOpts{
+			Period:    totpPeriod,
+			Digits:    otp.DigitsSix,
+			Algorithm: otp.AlgorithmSHA1,
+		})
+		if err != nil {
+			return 0, false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// generateBackupCode returns a random backup code, e.g. "k7m2p-x9q4r".
+func generateBackupCode() (string, error) {
+	var s strings.Builder
+
+	for i := range backupCodeLen {
+		if i == backupCodeLen/2 {
+			s.WriteByte('-')
+		}
+
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(backupAlphabet))))
+		if err != nil {
+			return "", err
+		}
+
+		s.WriteByte(backupAlphabet[n.Int64()])
+	}
+
+	return s.String(), nil
+}
+
+// normalizeBackupCode returns the backup code without separators in lower case.
+func normalizeBackupCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(code)))
+}
+
+// hashBackupCode returns the hash of a backup code of a user which is stored instead of the code.
+func hashBackupCode(userID uuid.UUID, code string) string {
+	h := sha256.Sum256([]byte(userID.String() + ":" + code))
+
+	return hex.EncodeToString(h[:])
+}
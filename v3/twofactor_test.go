@@ -0,0 +1,143 @@
+package goth_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	goth "github.com/katallaxie/fiber-goth/v3"
+	"github.com/katallaxie/fiber-goth/v3/adapters"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/pquerna/otp/totp"
+)
+
+const totpSecret = "JBSWY3DPEHPK3PXP"
+
+func TestTwoFactorVerify(t *testing.T) {
+	app, adapter, user := setupTwoFactor(t)
+	pending := pendingSession(t, adapter, user)
+
+	code, err := totp.GenerateCode(totpSecret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, body := request(t, app, fiber.MethodPost, "/two-factor/verify", pending.SessionToken, `{"code":"`+code+`"}`)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", res.StatusCode, http.StatusOK, body)
+	}
+
+	var verified goth.TwoFactorSession
+	if err := json.Unmarshal([]byte(body), &verified); err != nil {
+		t.Fatal(err)
+	}
+
+	session, ok := adapter.sessions[verified.Token]
+	if !ok || session.TwoFactorPending {
+		t.Errorf("session = %+v, want a verified session", session)
+	}
+
+	if _, ok := adapter.sessions[pending.SessionToken]; ok {
+		t.Error("the pending session has not been replaced")
+	}
+}
+
+func TestTwoFactorVerifyReplay(t *testing.T) {
+	app, adapter, user := setupTwoFactor(t)
+
+	code, err := totp.GenerateCode(totpSecret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, body := request(t, app, fiber.MethodPost, "/two-factor/verify", pendingSession(t, adapter, user).SessionToken, `{"code":"`+code+`"}`)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", res.StatusCode, http.StatusOK, body)
+	}
+
+	pending := pendingSession(t, adapter, user)
+
+	res, _ = request(t, app, fiber.MethodPost, "/two-factor/verify", pending.SessionToken, `{"code":"`+code+`"}`)
+	if res.StatusCode != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d for a used code", res.StatusCode, http.StatusBadRequest)
+	}
+
+	if session := adapter.sessions[pending.SessionToken]; !session.TwoFactorPending {
+		t.Error("the session of the used code is no longer pending")
+	}
+}
+
+func TestTwoFactorVerifyLockout(t *testing.T) {
+	app, adapter, user := setupTwoFactor(t, goth.WithTwoFactorMaxAttempts(3))
+	pending := pendingSession(t, adapter, user)
+
+	code, err := totp.GenerateCode(totpSecret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+
+	for range 3 {
+		res, _ := request(t, app, fiber.MethodPost, "/two-factor/verify", pending.SessionToken, `{"code":"`+wrong+`"}`)
+		if res.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want %d for a wrong code", res.StatusCode, http.StatusBadRequest)
+		}
+	}
+
+	res, _ := request(t, app, fiber.MethodPost, "/two-factor/verify", pending.SessionToken, `{"code":"`+code+`"}`)
+	if res.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("status = %d, want %d for a locked second factor", res.StatusCode, http.StatusTooManyRequests)
+	}
+
+	if session := adapter.sessions[pending.SessionToken]; !session.TwoFactorPending {
+		t.Error("the session has been verified with a locked second factor")
+	}
+}
+
+func TestTwoFactorPendingSession(t *testing.T) {
+	app, adapter, user := setupTwoFactor(t)
+	pending := pendingSession(t, adapter, user)
+
+	res, _ := request(t, app, fiber.MethodGet, "/two-factor/", pending.SessionToken, "")
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d for a pending session", res.StatusCode, http.StatusUnauthorized)
+	}
+}
+
+func setupTwoFactor(t *testing.T, opts ...goth.TwoFactorOpt) (*fiber.App, *memAdapter, adapters.GothUser) {
+	t.Helper()
+
+	adapter := newAdapter()
+	cfg := adapter.config()
+	cfg.Extractor = goth.TokenFromAuthorizationHeader()
+	user := adapter.createUser()
+
+	secret, err := goth.EncryptCookie(totpSecret, cfg.Secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	adapter.twoFactors[user.ID] = adapters.GothTwoFactor{UserID: user.ID, Secret: secret, Enabled: true}
+
+	app := fiber.New()
+	goth.MountTwoFactor(app.Group("/two-factor"), cfg, opts...)
+
+	return app, adapter, user
+}
+
+func pendingSession(t *testing.T, adapter *memAdapter, user adapters.GothUser) adapters.GothSession {
+	t.Helper()
+
+	session, err := adapter.CreateSession(t.Context(), user.ID, time.Now().Add(goth.DefaultTwoFactorTimeout), adapters.WithTwoFactorPending())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return session
+}